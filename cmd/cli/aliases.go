@@ -0,0 +1,302 @@
+package cli
+
+import (
+	"bytes"
+	"path/filepath"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	"github.com/spf13/cobra"
+)
+
+func newRegistriesCommand(opts *options) *cobra.Command {
+	var (
+		prefix         string
+		description    string
+		owner          string
+		allowedTenants []string
+		file           string
+		dryRun         bool
+		limit, page    uint64
+	)
+
+	registriesCmd := &cobra.Command{
+		Use:   "registries",
+		Short: "Manage the alias registries",
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the registries",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			registries, err := c.ListRegistries(cmd.Context(), prefix, limit, page)
+			if err != nil {
+				return err
+			}
+
+			t := registriesTable()
+			for i := range registries {
+				t.rows = append(t.rows, registriesTable(&registries[i]).rows...)
+			}
+
+			return p.print(registries, t)
+		}),
+	}
+	listCmd.Flags().StringVar(&prefix, "prefix", "", "Only list the registries whose name starts with the prefix")
+	listCmd.Flags().Uint64Var(&limit, "limit", 0, "Maximum number of registries returned")
+	listCmd.Flags().Uint64Var(&page, "page", 0, "Page to return")
+
+	createCmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			registry, err := c.CreateRegistry(cmd.Context(), types.RegistryName(args[0]), &types.CreateRegistryRequest{
+				Description:    description,
+				Owner:          owner,
+				AllowedTenants: allowedTenants,
+			})
+			if err != nil {
+				return err
+			}
+
+			return p.print(registry, registriesTable(registry))
+		}),
+	}
+	createCmd.Flags().StringVar(&description, "description", "", "Description of the registry")
+	createCmd.Flags().StringVar(&owner, "owner", "", "Tenant owning the registry, the tenant of the user by default")
+	createCmd.Flags().StringArrayVar(&allowedTenants, "allowed-tenant", nil, "Tenant allowed to read the registry, can be repeated")
+
+	getCmd := &cobra.Command{
+		Use:   "get <name>",
+		Short: "Get a registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			registry, err := c.GetRegistry(cmd.Context(), types.RegistryName(args[0]))
+			if err != nil {
+				return err
+			}
+
+			return p.print(registry, registriesTable(registry))
+		}),
+	}
+
+	updateCmd := &cobra.Command{
+		Use:   "update <name>",
+		Short: "Update the description, owner or allowed tenants of a registry",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			req := &types.UpdateRegistryRequest{}
+			if cmd.Flags().Changed("description") {
+				req.Description = &description
+			}
+			if cmd.Flags().Changed("owner") {
+				req.Owner = &owner
+			}
+			if cmd.Flags().Changed("allowed-tenant") {
+				req.AllowedTenants = &allowedTenants
+			}
+
+			registry, err := c.UpdateRegistry(cmd.Context(), types.RegistryName(args[0]), req)
+			if err != nil {
+				return err
+			}
+
+			return p.print(registry, registriesTable(registry))
+		}),
+	}
+	updateCmd.Flags().StringVar(&description, "description", "", "Description of the registry")
+	updateCmd.Flags().StringVar(&owner, "owner", "", "Tenant owning the registry, empty to make it accessible to all tenants")
+	updateCmd.Flags().StringArrayVar(&allowedTenants, "allowed-tenant", nil, "Tenant allowed to read the registry, can be repeated")
+
+	importCmd := &cobra.Command{
+		Use:   "import <name>",
+		Short: "Replace the aliases of a registry by the aliases of a JSON or CSV file",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			req, err := readImportFile(cmd, file)
+			if err != nil {
+				return err
+			}
+
+			res, err := c.ImportAliases(cmd.Context(), types.RegistryName(args[0]), req, dryRun)
+			if err != nil {
+				return err
+			}
+
+			return p.print(res, importAliasesTable(res))
+		}),
+	}
+	importCmd.Flags().StringVarP(&file, "file", "f", "", "JSON or CSV file holding the aliases, - to read JSON from the standard input")
+	importCmd.Flags().BoolVar(&dryRun, "dry-run", false, "Only show the changes the import would make")
+	_ = importCmd.MarkFlagRequired("file")
+
+	registriesCmd.AddCommand(
+		listCmd,
+		createCmd,
+		getCmd,
+		updateCmd,
+		importCmd,
+		newLifecycleCommand(opts, "delete", "Delete a registry and its aliases", "deleted", func(cmd *cobra.Command, c *client.HTTPClient, name string) error {
+			return c.DeleteRegistry(cmd.Context(), types.RegistryName(name))
+		}),
+	)
+
+	return registriesCmd
+}
+
+func newAliasesCommand(opts *options) *cobra.Command {
+	var (
+		registry string
+		kind     string
+		values   []string
+		tags     []string
+		version  int
+	)
+
+	aliasesCmd := &cobra.Command{
+		Use:   "aliases",
+		Short: "Manage the aliases of a registry",
+	}
+	aliasesCmd.PersistentFlags().StringVar(&registry, "registry", "", "Name of the registry")
+	_ = aliasesCmd.MarkPersistentFlagRequired("registry")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the aliases",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			als, err := c.ListAliases(cmd.Context(), types.RegistryName(registry))
+			if err != nil {
+				return err
+			}
+
+			return p.print(als, aliasesTable(als))
+		}),
+	}
+
+	getCmd := &cobra.Command{
+		Use:   "get <key>",
+		Short: "Get an alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			var al *types.AliasResponse
+			var err error
+			if version != 0 {
+				al, err = c.GetAliasVersion(cmd.Context(), types.RegistryName(registry), types.AliasKey(args[0]), version)
+			} else {
+				al, err = c.GetAlias(cmd.Context(), types.RegistryName(registry), types.AliasKey(args[0]))
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(al, aliasResponsesTable(al))
+		}),
+	}
+	getCmd.Flags().IntVar(&version, "version", 0, "Version of the alias, the latest by default")
+
+	versionsCmd := &cobra.Command{
+		Use:   "versions <key>",
+		Short: "List the versions of an alias",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			als, err := c.ListAliasVersions(cmd.Context(), types.RegistryName(registry), types.AliasKey(args[0]))
+			if err != nil {
+				return err
+			}
+
+			t := aliasResponsesTable()
+			for i := range als {
+				t.rows = append(t.rows, aliasResponsesTable(&als[i]).rows...)
+			}
+
+			return p.print(als, t)
+		}),
+	}
+
+	setAlias := func(update bool) func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+		return func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			aliasTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			req := types.AliasRequest{
+				Value: types.AliasValue{Kind: kind, Values: values},
+				Tags:  aliasTags,
+			}
+
+			var al *types.AliasResponse
+			if update {
+				al, err = c.UpdateAlias(cmd.Context(), types.RegistryName(registry), types.AliasKey(args[0]), req)
+			} else {
+				al, err = c.CreateAlias(cmd.Context(), types.RegistryName(registry), types.AliasKey(args[0]), req)
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(al, aliasResponsesTable(al))
+		}
+	}
+
+	createCmd := &cobra.Command{
+		Use:   "create <key>",
+		Short: "Create an alias",
+		Args:  cobra.ExactArgs(1),
+		RunE:  opts.run(setAlias(false)),
+	}
+	updateCmd := &cobra.Command{
+		Use:   "update <key>",
+		Short: "Update the value and tags of an alias",
+		Args:  cobra.ExactArgs(1),
+		RunE:  opts.run(setAlias(true)),
+	}
+	for _, cmd := range []*cobra.Command{createCmd, updateCmd} {
+		cmd.Flags().StringVar(&kind, "kind", "tessera", "Kind of the values: tessera, ethereum, key or privacy_group")
+		cmd.Flags().StringArrayVar(&values, "value", nil, "Value of the alias, can be repeated")
+		cmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the alias as key=value, can be repeated")
+		_ = cmd.MarkFlagRequired("value")
+	}
+
+	aliasesCmd.AddCommand(
+		listCmd,
+		getCmd,
+		versionsCmd,
+		createCmd,
+		updateCmd,
+		newLifecycleCommand(opts, "delete", "Delete an alias", "deleted", func(cmd *cobra.Command, c *client.HTTPClient, key string) error {
+			return c.DeleteAlias(cmd.Context(), types.RegistryName(registry), types.AliasKey(key))
+		}),
+	)
+
+	return aliasesCmd
+}
+
+// readImportFile reads the aliases to import from a CSV file if its extension is .csv, from a JSON file otherwise
+func readImportFile(cmd *cobra.Command, path string) (*types.ImportAliasesRequest, error) {
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		content, err := readFile(cmd, path)
+		if err != nil {
+			return nil, err
+		}
+
+		als, err := types.ParseAliasesCSV(bytes.NewReader(content))
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.ImportAliasesRequest{Aliases: als}, nil
+	}
+
+	req := &types.ImportAliasesRequest{}
+	err := readJSONFile(cmd, path, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,224 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/spf13/cobra"
+)
+
+// options are the connection and output settings shared by all the client commands
+type options struct {
+	configFile         string
+	profile            string
+	url                string
+	apiKey             string
+	token              string
+	tlsCert            string
+	tlsKey             string
+	tlsCA              string
+	insecureSkipVerify bool
+	timeout            time.Duration
+	output             string
+}
+
+// NewCommands creates the commands calling the Quorum Key Manager API
+func NewCommands() []*cobra.Command {
+	opts := &options{}
+
+	cmds := []*cobra.Command{
+		newKeysCommand(opts),
+		newSecretsCommand(opts),
+		newEthereumCommand(opts),
+		newRegistriesCommand(opts),
+		newAliasesCommand(opts),
+	}
+	for _, cmd := range cmds {
+		opts.addFlags(cmd)
+	}
+
+	return cmds
+}
+
+// NewUtilsCommands creates the commands calling the utility endpoints of the Quorum Key Manager API
+func NewUtilsCommands() []*cobra.Command {
+	opts := &options{}
+
+	cmds := newUtilsCommands(opts)
+	for _, cmd := range cmds {
+		opts.addFlags(cmd)
+	}
+
+	return cmds
+}
+
+func (opts *options) addFlags(cmd *cobra.Command) {
+	flgs := cmd.PersistentFlags()
+	flgs.StringVar(&opts.configFile, "config", "", fmt.Sprintf("Configuration file holding the profiles (default $%s or $HOME/.key-manager/config.yaml)", configFileEnv))
+	flgs.StringVar(&opts.profile, "profile", os.Getenv(profileEnv), fmt.Sprintf("Profile of the configuration file to use (environment variable %s)", profileEnv))
+	flgs.StringVar(&opts.url, "url", os.Getenv("KEY_MANAGER_URL"), "URL of the Quorum Key Manager, overrides the profile (environment variable KEY_MANAGER_URL)")
+	flgs.StringVar(&opts.apiKey, "api-key", os.Getenv("KEY_MANAGER_API_KEY"), "API key, overrides the profile (environment variable KEY_MANAGER_API_KEY)")
+	flgs.StringVar(&opts.token, "token", os.Getenv("KEY_MANAGER_TOKEN"), "Bearer token, overrides the profile (environment variable KEY_MANAGER_TOKEN)")
+	flgs.StringVar(&opts.tlsCert, "tls-cert", "", "Client certificate file for mTLS authentication, overrides the profile")
+	flgs.StringVar(&opts.tlsKey, "tls-key", "", "Client private key file for mTLS authentication, overrides the profile")
+	flgs.StringVar(&opts.tlsCA, "tls-ca", "", "CA certificate file used to verify the server, overrides the profile")
+	flgs.BoolVar(&opts.insecureSkipVerify, "insecure-skip-verify", false, "Skip the verification of the server certificate")
+	flgs.DurationVar(&opts.timeout, "timeout", 30*time.Second, "Timeout of the requests")
+	flgs.StringVarP(&opts.output, "output", "o", tableOutput, fmt.Sprintf("Output format: %s or %s", tableOutput, jsonOutput))
+}
+
+// loadProfile merges the selected profile of the configuration file with the command flags
+func (opts *options) loadProfile() (*Profile, error) {
+	profile := &Profile{}
+
+	configFile := opts.configFile
+	if configFile == "" {
+		configFile = defaultConfigFile()
+	}
+
+	cfg, err := LoadConfig(configFile)
+	switch {
+	case err == nil:
+		profile, err = cfg.Profile(opts.profile)
+		if err != nil {
+			return nil, err
+		}
+	case !os.IsNotExist(err) || opts.configFile != "" || opts.profile != "":
+		return nil, err
+	}
+
+	p := *profile
+	if opts.url != "" {
+		p.URL = opts.url
+	}
+	if opts.apiKey != "" {
+		p.APIKey = opts.apiKey
+		p.Token = ""
+	}
+	if opts.token != "" {
+		p.Token = opts.token
+		p.APIKey = ""
+	}
+	if opts.tlsCert != "" {
+		p.TLSCert = opts.tlsCert
+	}
+	if opts.tlsKey != "" {
+		p.TLSKey = opts.tlsKey
+	}
+	if opts.tlsCA != "" {
+		p.TLSCA = opts.tlsCA
+	}
+	if opts.insecureSkipVerify {
+		p.InsecureSkipVerify = true
+	}
+
+	if p.URL == "" {
+		return nil, fmt.Errorf("no Quorum Key Manager URL, use --url or a profile")
+	}
+	p.URL = strings.TrimSuffix(p.URL, "/")
+
+	return &p, nil
+}
+
+func (opts *options) client() (*client.HTTPClient, error) {
+	profile, err := opts.loadProfile()
+	if err != nil {
+		return nil, err
+	}
+
+	trnsprt, err := newTransport(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.NewHTTPClient(&http.Client{Transport: trnsprt, Timeout: opts.timeout}, client.NewConfig(profile.URL)), nil
+}
+
+func (opts *options) printer(cmd *cobra.Command) (*printer, error) {
+	return newPrinter(cmd.OutOrStdout(), opts.output)
+}
+
+// run prepares the client and the printer of a command before calling f
+func (opts *options) run(f func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		p, err := opts.printer(cmd)
+		if err != nil {
+			return err
+		}
+
+		c, err := opts.client()
+		if err != nil {
+			return err
+		}
+
+		return f(cmd, args, c, p)
+	}
+}
+
+// parseTags parses tags given as key=value
+func parseTags(tags []string) (map[string]string, error) {
+	if len(tags) == 0 {
+		return nil, nil
+	}
+
+	res := make(map[string]string, len(tags))
+	for _, tag := range tags {
+		kv := strings.SplitN(tag, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("invalid tag %q, expected key=value", tag)
+		}
+		res[kv[0]] = kv[1]
+	}
+
+	return res, nil
+}
+
+// readFile reads the file at path, the standard input if path is "-"
+func readFile(cmd *cobra.Command, path string) ([]byte, error) {
+	if path == "-" {
+		return ioutil.ReadAll(cmd.InOrStdin())
+	}
+
+	return ioutil.ReadFile(path)
+}
+
+// readPassphrase reads a passphrase from the file at path, ignoring the trailing end of line
+func readPassphrase(cmd *cobra.Command, path string) (string, error) {
+	content, err := readFile(cmd, path)
+	if err != nil {
+		return "", err
+	}
+
+	passphrase := strings.TrimRight(string(content), "\r\n")
+	if passphrase == "" {
+		return "", fmt.Errorf("passphrase in %s is empty", path)
+	}
+
+	return passphrase, nil
+}
+
+// readJSONFile decodes the JSON content of the file at path into v
+func readJSONFile(cmd *cobra.Command, path string, v interface{}) error {
+	content, err := readFile(cmd, path)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(content, v)
+	if err != nil {
+		return fmt.Errorf("invalid JSON in %s: %v", path, err)
+	}
+
+	return nil
+}
+
+func printDone(out io.Writer, format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(out, format+"\n", args...)
+}
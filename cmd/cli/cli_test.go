@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const configContent = `
+current-profile: dev
+profiles:
+  dev:
+    url: http://localhost:8080/
+    api-key: admin-user
+  prod:
+    url: https://key-manager.example.com
+    token: my-token
+    insecure-skip-verify: true
+`
+
+func writeConfig(t *testing.T) string {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, ioutil.WriteFile(path, []byte(configContent), 0600))
+
+	return path
+}
+
+func setEnv(t *testing.T, key, value string) {
+	prev, ok := os.LookupEnv(key)
+	require.NoError(t, os.Setenv(key, value))
+	t.Cleanup(func() {
+		if ok {
+			_ = os.Setenv(key, prev)
+		} else {
+			_ = os.Unsetenv(key)
+		}
+	})
+}
+
+func TestLoadProfile(t *testing.T) {
+	configFile := writeConfig(t)
+
+	t.Run("should load the current profile", func(t *testing.T) {
+		opts := &options{configFile: configFile}
+
+		profile, err := opts.loadProfile()
+
+		require.NoError(t, err)
+		assert.Equal(t, "http://localhost:8080", profile.URL)
+		assert.Equal(t, "admin-user", profile.APIKey)
+	})
+
+	t.Run("should load the selected profile and override it with flags", func(t *testing.T) {
+		opts := &options{configFile: configFile, profile: "prod", apiKey: "other-user"}
+
+		profile, err := opts.loadProfile()
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://key-manager.example.com", profile.URL)
+		assert.Equal(t, "other-user", profile.APIKey)
+		assert.Empty(t, profile.Token)
+		assert.True(t, profile.InsecureSkipVerify)
+	})
+
+	t.Run("should fail if the profile does not exist", func(t *testing.T) {
+		opts := &options{configFile: configFile, profile: "staging"}
+
+		_, err := opts.loadProfile()
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should only use the flags without configuration file", func(t *testing.T) {
+		setEnv(t, configFileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+		opts := &options{url: "http://localhost:8080", token: "my-token"}
+
+		profile, err := opts.loadProfile()
+
+		require.NoError(t, err)
+		assert.Equal(t, &Profile{URL: "http://localhost:8080", Token: "my-token"}, profile)
+	})
+
+	t.Run("should fail without URL", func(t *testing.T) {
+		setEnv(t, configFileEnv, filepath.Join(t.TempDir(), "missing.yaml"))
+		opts := &options{}
+
+		_, err := opts.loadProfile()
+
+		assert.Error(t, err)
+	})
+}
+
+func TestAuthTransport(t *testing.T) {
+	var authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		authorization = req.Header.Get("Authorization")
+	}))
+	defer server.Close()
+
+	t.Run("should send the api key", func(t *testing.T) {
+		trnsprt, err := newTransport(&Profile{APIKey: "admin-user"})
+		require.NoError(t, err)
+
+		_, err = (&http.Client{Transport: trnsprt}).Get(server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Basic "+base64.StdEncoding.EncodeToString([]byte("admin-user")), authorization)
+	})
+
+	t.Run("should send the bearer token", func(t *testing.T) {
+		trnsprt, err := newTransport(&Profile{Token: "my-token"})
+		require.NoError(t, err)
+
+		_, err = (&http.Client{Transport: trnsprt}).Get(server.URL)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Bearer my-token", authorization)
+	})
+
+	t.Run("should fail if both api key and token are set", func(t *testing.T) {
+		_, err := newTransport(&Profile{APIKey: "admin-user", Token: "my-token"})
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail if the tls key is missing", func(t *testing.T) {
+		_, err := newTransport(&Profile{TLSCert: "cert.pem"})
+
+		assert.Error(t, err)
+	})
+}
+
+func TestPrinter(t *testing.T) {
+	key := &types.KeyResponse{
+		ID:               "my-key",
+		Curve:            "secp256k1",
+		SigningAlgorithm: "ecdsa",
+		PublicKey:        "BJ1T",
+		Tags:             map[string]string{"b": "2", "a": "1"},
+	}
+
+	t.Run("should print a table", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		p, err := newPrinter(out, tableOutput)
+		require.NoError(t, err)
+
+		require.NoError(t, p.print(key, keysTable(key)))
+
+		assert.Equal(t, "ID      CURVE      ALGORITHM  PUBLIC KEY  TAGS     DISABLED  CREATED AT\n"+
+			"my-key  secp256k1  ecdsa      BJ1T        a=1,b=2  false     \n", out.String())
+	})
+
+	t.Run("should print JSON", func(t *testing.T) {
+		out := &bytes.Buffer{}
+		p, err := newPrinter(out, jsonOutput)
+		require.NoError(t, err)
+
+		require.NoError(t, p.print(key, keysTable(key)))
+
+		res := &types.KeyResponse{}
+		require.NoError(t, json.Unmarshal(out.Bytes(), res))
+		assert.Equal(t, key.ID, res.ID)
+		assert.Equal(t, key.Tags, res.Tags)
+	})
+
+	t.Run("should fail on unknown format", func(t *testing.T) {
+		_, err := newPrinter(&bytes.Buffer{}, "yaml")
+
+		assert.Error(t, err)
+	})
+}
+
+func TestKeysCommand(t *testing.T) {
+	var path, authorization string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		path = req.URL.Path
+		authorization = req.Header.Get("Authorization")
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(&types.KeyResponse{ID: "my-key", Curve: "secp256k1"})
+	}))
+	defer server.Close()
+
+	var keysCmd *cobra.Command
+	for _, cmd := range NewCommands() {
+		if cmd.Name() == "keys" {
+			keysCmd = cmd
+		}
+	}
+	require.NotNil(t, keysCmd)
+
+	out := &bytes.Buffer{}
+	keysCmd.SetOut(out)
+	keysCmd.SetArgs([]string{"get", "my-key", "--store", "my-store", "--url", server.URL, "--token", "my-token", "-o", "json"})
+
+	require.NoError(t, keysCmd.Execute())
+
+	assert.Equal(t, "/stores/my-store/keys/my-key", path)
+	assert.Equal(t, "Bearer my-token", authorization)
+	res := &types.KeyResponse{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), res))
+	assert.Equal(t, "my-key", res.ID)
+}
+
+func TestImportKeystoresCommand(t *testing.T) {
+	var passwords []string
+	server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		importReq := &types.ImportEthAccountRequest{}
+		_ = json.NewDecoder(req.Body).Decode(importReq)
+		passwords = append(passwords, importReq.Password)
+
+		rw.Header().Set("Content-Type", "application/json")
+		if string(importReq.Keystore) != `{"valid":true}` {
+			rw.WriteHeader(http.StatusBadRequest)
+			_, _ = rw.Write([]byte(`{"message":"failed to decrypt keystore"}`))
+			return
+		}
+		_ = json.NewEncoder(rw).Encode(&types.EthAccountResponse{KeyID: "my-key"})
+	}))
+	defer server.Close()
+
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "valid.json"), []byte(`{"valid":true}`), 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"valid":false}`), 0600))
+	passwordFile := filepath.Join(t.TempDir(), "password")
+	require.NoError(t, ioutil.WriteFile(passwordFile, []byte("my-password\n"), 0600))
+
+	var ethCmd *cobra.Command
+	for _, cmd := range NewCommands() {
+		if cmd.Name() == "ethereum" {
+			ethCmd = cmd
+		}
+	}
+	require.NotNil(t, ethCmd)
+
+	out := &bytes.Buffer{}
+	ethCmd.SetOut(out)
+	ethCmd.SetErr(&bytes.Buffer{})
+	ethCmd.SetArgs([]string{"import-keystores", dir, "--password-file", passwordFile, "--store", "my-store", "--url", server.URL, "-o", "json"})
+
+	err := ethCmd.Execute()
+
+	assert.EqualError(t, err, "failed to import 1 of 2 keystores")
+	assert.Equal(t, []string{"my-password", "my-password"}, passwords)
+	var results []*importKeystoreResult
+	require.NoError(t, json.NewDecoder(out).Decode(&results))
+	require.Len(t, results, 2)
+	assert.Equal(t, "invalid.json", results[0].File)
+	assert.NotEmpty(t, results[0].Error)
+	assert.Equal(t, "valid.json", results[1].File)
+	assert.Empty(t, results[1].Error)
+}
@@ -0,0 +1,79 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	configFileEnv  = "KEY_MANAGER_CLI_CONFIG"
+	profileEnv     = "KEY_MANAGER_PROFILE"
+	defaultProfile = "default"
+)
+
+// Config is the content of the CLI configuration file, a set of named profiles
+type Config struct {
+	CurrentProfile string              `yaml:"current-profile,omitempty"`
+	Profiles       map[string]*Profile `yaml:"profiles"`
+}
+
+// Profile holds the location of a Quorum Key Manager and the credentials used to reach it
+type Profile struct {
+	URL                string `yaml:"url"`
+	APIKey             string `yaml:"api-key,omitempty"`
+	Token              string `yaml:"token,omitempty"`
+	TLSCert            string `yaml:"tls-cert,omitempty"`
+	TLSKey             string `yaml:"tls-key,omitempty"`
+	TLSCA              string `yaml:"tls-ca,omitempty"`
+	InsecureSkipVerify bool   `yaml:"insecure-skip-verify,omitempty"`
+}
+
+func defaultConfigFile() string {
+	if path := os.Getenv(configFileEnv); path != "" {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+
+	return filepath.Join(home, ".key-manager", "config.yaml")
+}
+
+// LoadConfig reads the configuration file at the given path
+func LoadConfig(path string) (*Config, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &Config{}
+	err = yaml.UnmarshalStrict(content, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("invalid configuration file %s: %v", path, err)
+	}
+
+	return cfg, nil
+}
+
+// Profile returns the profile with the given name, the current profile of the configuration if name is empty
+func (cfg *Config) Profile(name string) (*Profile, error) {
+	if name == "" {
+		name = cfg.CurrentProfile
+	}
+	if name == "" {
+		name = defaultProfile
+	}
+
+	profile, ok := cfg.Profiles[name]
+	if !ok || profile == nil {
+		return nil, fmt.Errorf("profile %s not found", name)
+	}
+
+	return profile, nil
+}
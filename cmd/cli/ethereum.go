@@ -0,0 +1,388 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+func newEthereumCommand(opts *options) *cobra.Command {
+	var (
+		store          string
+		keyID          string
+		privateKey     string
+		message        string
+		file           string
+		tags           []string
+		shared         bool
+		exportable     bool
+		passphraseFile string
+		keystoreFile   string
+		passwordFile   string
+		mnemonicFile   string
+		derivationPath string
+		deleted        bool
+		limit, page    uint64
+	)
+
+	ethCmd := &cobra.Command{
+		Use:     "ethereum",
+		Aliases: []string{"eth"},
+		Short:   "Manage the Ethereum accounts of a store",
+	}
+	ethCmd.PersistentFlags().StringVar(&store, "store", "", "Name of the store")
+	_ = ethCmd.MarkPersistentFlagRequired("store")
+
+	createCmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an Ethereum account",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			accTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			acc, err := c.CreateEthAccount(cmd.Context(), store, &types.CreateEthAccountRequest{
+				KeyID:      keyID,
+				Tags:       accTags,
+				Shared:     shared,
+				Exportable: exportable,
+			})
+			if err != nil {
+				return err
+			}
+
+			return p.print(acc, ethAccountsTable(acc))
+		}),
+	}
+	createCmd.Flags().StringVar(&keyID, "key-id", "", "ID of the underlying key, generated by default")
+	createCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the account as key=value, can be repeated")
+	createCmd.Flags().BoolVar(&shared, "shared", false, "Share the account with all the tenants")
+	createCmd.Flags().BoolVar(&exportable, "exportable", false, "Allow the account to be exported")
+
+	importCmd := &cobra.Command{
+		Use:   "import",
+		Short: "Import an Ethereum account from its private key, a keystore v3 JSON file or a BIP-39 mnemonic",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			accTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			req := &types.ImportEthAccountRequest{
+				KeyID:      keyID,
+				Tags:       accTags,
+				Shared:     shared,
+				Exportable: exportable,
+			}
+
+			switch {
+			case privateKey != "" && keystoreFile == "" && mnemonicFile == "":
+				req.PrivateKey, err = hexutil.Decode(privateKey)
+				if err != nil {
+					return fmt.Errorf("invalid private key: %v", err)
+				}
+			case keystoreFile != "" && privateKey == "" && mnemonicFile == "":
+				req.Keystore, err = readFile(cmd, keystoreFile)
+				if err != nil {
+					return err
+				}
+
+				req.Password, err = readPassphrase(cmd, passwordFile)
+				if err != nil {
+					return err
+				}
+			case mnemonicFile != "" && privateKey == "" && keystoreFile == "":
+				req.Mnemonic, err = readPassphrase(cmd, mnemonicFile)
+				if err != nil {
+					return err
+				}
+				req.DerivationPath = derivationPath
+
+				if passphraseFile != "" {
+					req.MnemonicPassphrase, err = readPassphrase(cmd, passphraseFile)
+					if err != nil {
+						return err
+					}
+				}
+			default:
+				return fmt.Errorf("exactly one of --private-key, --keystore-file or --mnemonic-file must be set")
+			}
+
+			acc, err := c.ImportEthAccount(cmd.Context(), store, req)
+			if err != nil {
+				return err
+			}
+
+			return p.print(acc, ethAccountsTable(acc))
+		}),
+	}
+	importCmd.Flags().StringVar(&keyID, "key-id", "", "ID of the underlying key, generated by default")
+	importCmd.Flags().StringVar(&privateKey, "private-key", "", "Private key in hexadecimal prefixed by 0x")
+	importCmd.Flags().StringVar(&keystoreFile, "keystore-file", "", "Keystore v3 JSON file, - reads the standard input")
+	importCmd.Flags().StringVar(&passwordFile, "password-file", "", "File holding the password of the keystore")
+	importCmd.Flags().StringVar(&mnemonicFile, "mnemonic-file", "", "File holding the BIP-39 mnemonic, - reads the standard input")
+	importCmd.Flags().StringVar(&passphraseFile, "mnemonic-passphrase-file", "", "File holding the optional passphrase of the mnemonic")
+	importCmd.Flags().StringVar(&derivationPath, "derivation-path", "", fmt.Sprintf("BIP-32 derivation path of the account in the mnemonic, %s by default", pkgcrypto.DefaultDerivationPath))
+	importCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the account as key=value, can be repeated")
+	importCmd.Flags().BoolVar(&shared, "shared", false, "Share the account with all the tenants")
+	importCmd.Flags().BoolVar(&exportable, "exportable", false, "Allow the account to be exported")
+
+	importKeystoresCmd := &cobra.Command{
+		Use:   "import-keystores <directory>",
+		Short: "Import all the keystore v3 JSON files of a directory encrypted with the same password",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			accTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			password, err := readPassphrase(cmd, passwordFile)
+			if err != nil {
+				return err
+			}
+
+			files, err := ioutil.ReadDir(args[0])
+			if err != nil {
+				return err
+			}
+
+			var results []*importKeystoreResult
+			failed := 0
+			for _, f := range files {
+				if f.IsDir() {
+					continue
+				}
+
+				result := &importKeystoreResult{File: f.Name()}
+				results = append(results, result)
+
+				acc, err := importKeystore(cmd, c, store, filepath.Join(args[0], f.Name()), &types.ImportEthAccountRequest{
+					Password:   password,
+					Tags:       accTags,
+					Shared:     shared,
+					Exportable: exportable,
+				})
+				if err != nil {
+					result.Error = err.Error()
+					failed++
+					continue
+				}
+				result.Address = acc.Address.Hex()
+			}
+
+			err = p.print(results, importKeystoresTable(results))
+			if err != nil {
+				return err
+			}
+
+			if failed > 0 {
+				return fmt.Errorf("failed to import %d of %d keystores", failed, len(results))
+			}
+
+			return nil
+		}),
+	}
+	importKeystoresCmd.Flags().StringVar(&passwordFile, "password-file", "", "File holding the password of the keystores")
+	importKeystoresCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the accounts as key=value, can be repeated")
+	importKeystoresCmd.Flags().BoolVar(&shared, "shared", false, "Share the accounts with all the tenants")
+	importKeystoresCmd.Flags().BoolVar(&exportable, "exportable", false, "Allow the accounts to be exported")
+	_ = importKeystoresCmd.MarkFlagRequired("password-file")
+
+	getCmd := &cobra.Command{
+		Use:   "get <address>",
+		Short: "Get an Ethereum account",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			acc, err := c.GetEthAccount(cmd.Context(), store, args[0])
+			if err != nil {
+				return err
+			}
+
+			return p.print(acc, ethAccountsTable(acc))
+		}),
+	}
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the addresses of the Ethereum accounts",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			var addresses []string
+			var err error
+			if deleted {
+				addresses, err = c.ListDeletedEthAccounts(cmd.Context(), store, limit, page)
+			} else {
+				addresses, err = c.ListEthAccounts(cmd.Context(), store, limit, page)
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(addresses, idsTable("ADDRESS", addresses))
+		}),
+	}
+	listCmd.Flags().BoolVar(&deleted, "deleted", false, "List the deleted accounts")
+	listCmd.Flags().Uint64Var(&limit, "limit", 0, "Maximum number of accounts returned")
+	listCmd.Flags().Uint64Var(&page, "page", 0, "Page to return")
+
+	updateCmd := &cobra.Command{
+		Use:   "update <address>",
+		Short: "Replace the tags of an Ethereum account",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			accTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			acc, err := c.UpdateEthAccount(cmd.Context(), store, args[0], &types.UpdateEthAccountRequest{Tags: accTags})
+			if err != nil {
+				return err
+			}
+
+			return p.print(acc, ethAccountsTable(acc))
+		}),
+	}
+	updateCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the account as key=value, can be repeated")
+
+	signMessageCmd := &cobra.Command{
+		Use:   "sign-message <address>",
+		Short: "Sign a message following EIP-191",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			msg, err := hexutil.Decode(message)
+			if err != nil {
+				return fmt.Errorf("invalid message: %v", err)
+			}
+
+			signature, err := c.SignMessage(cmd.Context(), store, args[0], &types.SignMessageRequest{Message: msg})
+			if err != nil {
+				return err
+			}
+
+			return p.print(signature, valueTable("SIGNATURE", signature))
+		}),
+	}
+	signMessageCmd.Flags().StringVar(&message, "message", "", "Message in hexadecimal prefixed by 0x")
+	_ = signMessageCmd.MarkFlagRequired("message")
+
+	exportCmd := &cobra.Command{
+		Use:   "export <address>",
+		Short: "Export an exportable Ethereum account as an encrypted keystore v3 JSON",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, _ *printer) error {
+			passphrase, err := readPassphrase(cmd, passphraseFile)
+			if err != nil {
+				return err
+			}
+
+			keyJSON, err := c.ExportEthAccount(cmd.Context(), store, args[0], &types.ExportEthAccountRequest{Passphrase: passphrase})
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), keyJSON)
+			return err
+		}),
+	}
+	exportCmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "File holding the passphrase encrypting the keystore, - reads the standard input")
+	_ = exportCmd.MarkFlagRequired("passphrase-file")
+
+	ethCmd.AddCommand(
+		createCmd,
+		importCmd,
+		importKeystoresCmd,
+		getCmd,
+		listCmd,
+		updateCmd,
+		signMessageCmd,
+		exportCmd,
+		newSignRequestCommand(opts, &file, "sign-typed-data", "Sign typed data following EIP-712", func(cmd *cobra.Command, c *client.HTTPClient, address string) (string, error) {
+			req := &types.SignTypedDataRequest{}
+			if err := readJSONFile(cmd, file, req); err != nil {
+				return "", err
+			}
+			return c.SignTypedData(cmd.Context(), store, address, req)
+		}),
+		newSignRequestCommand(opts, &file, "sign-transaction", "Sign an Ethereum transaction", func(cmd *cobra.Command, c *client.HTTPClient, address string) (string, error) {
+			req := &types.SignETHTransactionRequest{}
+			if err := readJSONFile(cmd, file, req); err != nil {
+				return "", err
+			}
+			return c.SignTransaction(cmd.Context(), store, address, req)
+		}),
+		newSignRequestCommand(opts, &file, "sign-quorum-private-transaction", "Sign a Quorum private transaction", func(cmd *cobra.Command, c *client.HTTPClient, address string) (string, error) {
+			req := &types.SignQuorumPrivateTransactionRequest{}
+			if err := readJSONFile(cmd, file, req); err != nil {
+				return "", err
+			}
+			return c.SignQuorumPrivateTransaction(cmd.Context(), store, address, req)
+		}),
+		newSignRequestCommand(opts, &file, "sign-eea-transaction", "Sign an EEA private transaction", func(cmd *cobra.Command, c *client.HTTPClient, address string) (string, error) {
+			req := &types.SignEEATransactionRequest{}
+			if err := readJSONFile(cmd, file, req); err != nil {
+				return "", err
+			}
+			return c.SignEEATransaction(cmd.Context(), store, address, req)
+		}),
+		newLifecycleCommand(opts, "delete", "Delete an Ethereum account", "deleted", func(cmd *cobra.Command, c *client.HTTPClient, address string) error {
+			return c.DeleteEthAccount(cmd.Context(), store, address)
+		}),
+		newLifecycleCommand(opts, "destroy", "Permanently destroy a deleted Ethereum account", "destroyed", func(cmd *cobra.Command, c *client.HTTPClient, address string) error {
+			return c.DestroyEthAccount(cmd.Context(), store, address)
+		}),
+		newLifecycleCommand(opts, "restore", "Restore a deleted Ethereum account", "restored", func(cmd *cobra.Command, c *client.HTTPClient, address string) error {
+			return c.RestoreEthAccount(cmd.Context(), store, address)
+		}),
+	)
+
+	return ethCmd
+}
+
+// newSignRequestCommand creates a command signing with the account given as argument the JSON request read from --file
+func newSignRequestCommand(opts *options, file *string, use, short string, sign func(cmd *cobra.Command, c *client.HTTPClient, address string) (string, error)) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   use + " <address>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			signature, err := sign(cmd, c, args[0])
+			if err != nil {
+				return err
+			}
+
+			return p.print(signature, valueTable("SIGNATURE", signature))
+		}),
+	}
+	cmd.Flags().StringVarP(file, "file", "f", "", "JSON file holding the request body of the API, - to read the standard input")
+	_ = cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+type importKeystoreResult struct {
+	File    string `json:"file"`
+	Address string `json:"address,omitempty"`
+	Error   string `json:"error,omitempty"`
+}
+
+// importKeystore imports as Ethereum account the keystore v3 JSON file at path, the key ID is generated by the server
+func importKeystore(cmd *cobra.Command, c *client.HTTPClient, store, path string, req *types.ImportEthAccountRequest) (*types.EthAccountResponse, error) {
+	keyJSON, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	req.Keystore = keyJSON
+
+	return c.ImportEthAccount(cmd.Context(), store, req)
+}
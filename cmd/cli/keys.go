@@ -0,0 +1,324 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/spf13/cobra"
+)
+
+func newKeysCommand(opts *options) *cobra.Command {
+	var (
+		store            string
+		curve            string
+		signingAlgorithm string
+		tags             []string
+		shared           bool
+		exportable       bool
+		passphraseFile   string
+		pemFile          string
+		privateKey       []byte
+		data             []byte
+		hashAlgorithm    string
+		encoding         string
+		lowS             bool
+		claimsFile       string
+		headerFile       string
+		detached         bool
+		deleted          bool
+		limit, page      uint64
+	)
+
+	keysCmd := &cobra.Command{
+		Use:   "keys",
+		Short: "Manage the keys of a store",
+	}
+	keysCmd.PersistentFlags().StringVar(&store, "store", "", "Name of the store")
+	_ = keysCmd.MarkPersistentFlagRequired("store")
+
+	createCmd := &cobra.Command{
+		Use:   "create <id>",
+		Short: "Create a key",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			keyTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			key, err := c.CreateKey(cmd.Context(), store, args[0], &types.CreateKeyRequest{
+				Curve:            curve,
+				SigningAlgorithm: signingAlgorithm,
+				Tags:             keyTags,
+				Shared:           shared,
+				Exportable:       exportable,
+			})
+			if err != nil {
+				return err
+			}
+
+			return p.print(key, keysTable(key))
+		}),
+	}
+	createCmd.Flags().StringVar(&curve, "curve", "secp256k1", "Elliptic curve of the key: secp256k1 or babyjubjub")
+	createCmd.Flags().StringVar(&signingAlgorithm, "signing-algorithm", "ecdsa", "Signing algorithm of the key: ecdsa or eddsa")
+	createCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the key as key=value, can be repeated")
+	createCmd.Flags().BoolVar(&shared, "shared", false, "Share the key with all the tenants")
+	createCmd.Flags().BoolVar(&exportable, "exportable", false, "Allow the private key to be exported")
+
+	importCmd := &cobra.Command{
+		Use:   "import <id>",
+		Short: "Import a private key, given in base64 or as PEM file",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			keyTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			req := &types.ImportKeyRequest{
+				Curve:            curve,
+				SigningAlgorithm: signingAlgorithm,
+				PrivateKey:       privateKey,
+				Tags:             keyTags,
+				Shared:           shared,
+				Exportable:       exportable,
+			}
+
+			switch {
+			case pemFile != "" && len(privateKey) > 0:
+				return fmt.Errorf("only one of --private-key or --pem-file must be set")
+			case pemFile != "":
+				var pemBlock []byte
+				pemBlock, err = readFile(cmd, pemFile)
+				if err != nil {
+					return err
+				}
+				req.PEM = string(pemBlock)
+
+				if passphraseFile != "" {
+					req.Passphrase, err = readPassphrase(cmd, passphraseFile)
+					if err != nil {
+						return err
+					}
+				}
+			case len(privateKey) == 0:
+				return fmt.Errorf("one of --private-key or --pem-file must be set")
+			}
+
+			key, err := c.ImportKey(cmd.Context(), store, args[0], req)
+			if err != nil {
+				return err
+			}
+
+			return p.print(key, keysTable(key))
+		}),
+	}
+	importCmd.Flags().StringVar(&curve, "curve", "secp256k1", "Elliptic curve of the key: secp256k1 or babyjubjub")
+	importCmd.Flags().StringVar(&signingAlgorithm, "signing-algorithm", "ecdsa", "Signing algorithm of the key: ecdsa or eddsa")
+	importCmd.Flags().BytesBase64Var(&privateKey, "private-key", nil, "Private key encoded in base64")
+	importCmd.Flags().StringVar(&pemFile, "pem-file", "", "PEM file holding a PKCS#8, encrypted PKCS#8 or SEC 1 secp256k1 private key, - reads the standard input")
+	importCmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "File holding the passphrase of an encrypted PEM file")
+	importCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the key as key=value, can be repeated")
+	importCmd.Flags().BoolVar(&shared, "shared", false, "Share the key with all the tenants")
+	importCmd.Flags().BoolVar(&exportable, "exportable", false, "Allow the private key to be exported")
+
+	getCmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a key",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			var key *types.KeyResponse
+			var err error
+			if deleted {
+				key, err = c.GetDeletedKey(cmd.Context(), store, args[0])
+			} else {
+				key, err = c.GetKey(cmd.Context(), store, args[0])
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(key, keysTable(key))
+		}),
+	}
+	getCmd.Flags().BoolVar(&deleted, "deleted", false, "Get a deleted key")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the IDs of the keys",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			var ids []string
+			var err error
+			if deleted {
+				ids, err = c.ListDeletedKeys(cmd.Context(), store, limit, page)
+			} else {
+				ids, err = c.ListKeys(cmd.Context(), store, limit, page)
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(ids, idsTable("ID", ids))
+		}),
+	}
+	listCmd.Flags().BoolVar(&deleted, "deleted", false, "List the deleted keys")
+	listCmd.Flags().Uint64Var(&limit, "limit", 0, "Maximum number of keys returned")
+	listCmd.Flags().Uint64Var(&page, "page", 0, "Page to return")
+
+	updateCmd := &cobra.Command{
+		Use:   "update <id>",
+		Short: "Replace the tags of a key",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			keyTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			key, err := c.UpdateKey(cmd.Context(), store, args[0], &types.UpdateKeyRequest{Tags: keyTags})
+			if err != nil {
+				return err
+			}
+
+			return p.print(key, keysTable(key))
+		}),
+	}
+	updateCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the key as key=value, can be repeated")
+
+	signCmd := &cobra.Command{
+		Use:   "sign <id>",
+		Short: "Sign a payload with a key",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			signature, err := c.SignKey(cmd.Context(), store, args[0], &types.SignBase64PayloadRequest{
+				Data:          data,
+				HashAlgorithm: hashAlgorithm,
+				Encoding:      encoding,
+				LowS:          lowS,
+			})
+			if err != nil {
+				return err
+			}
+
+			return p.print(signature, valueTable("SIGNATURE", signature))
+		}),
+	}
+	signCmd.Flags().BytesBase64Var(&data, "data", nil, "Payload to sign encoded in base64")
+	signCmd.Flags().StringVar(&hashAlgorithm, "hash", "", "Hash function applied to the payload before signing: none, sha256, keccak256 or sha512")
+	signCmd.Flags().StringVar(&encoding, "encoding", "", "Encoding of the signature: raw, rsv, der or jws")
+	signCmd.Flags().BoolVar(&lowS, "low-s", false, "Normalize S to the lower half of the curve order")
+	_ = signCmd.MarkFlagRequired("data")
+
+	signJWTCmd := &cobra.Command{
+		Use:   "sign-jwt <id>",
+		Short: "Sign JWT claims or a JWS payload with a key and print the compact serialization",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			req := &types.SignJWTRequest{Payload: data, Detached: detached}
+			if claimsFile != "" {
+				if err := readJSONFile(cmd, claimsFile, &req.Claims); err != nil {
+					return err
+				}
+			}
+			if headerFile != "" {
+				if err := readJSONFile(cmd, headerFile, &req.Header); err != nil {
+					return err
+				}
+			}
+			if (len(req.Claims) > 0) == (len(req.Payload) > 0) {
+				return fmt.Errorf("exactly one of --claims-file or --payload must be set")
+			}
+
+			token, err := c.SignJWT(cmd.Context(), store, args[0], req)
+			if err != nil {
+				return err
+			}
+
+			return p.print(token, valueTable("TOKEN", token))
+		}),
+	}
+	signJWTCmd.Flags().StringVar(&claimsFile, "claims-file", "", "JSON file holding the claims of the JWT, - reads the standard input")
+	signJWTCmd.Flags().BytesBase64Var(&data, "payload", nil, "JWS payload encoded in base64, instead of JWT claims")
+	signJWTCmd.Flags().StringVar(&headerFile, "header-file", "", "JSON file holding additional protected header parameters")
+	signJWTCmd.Flags().BoolVar(&detached, "detached", false, "Omit the payload from the serialization")
+
+	jwksCmd := &cobra.Command{
+		Use:   "jwks",
+		Short: "Get the JSON Web Key Set of the keys usable to verify JWS",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			keySet, err := c.GetJWKS(cmd.Context(), store)
+			if err != nil {
+				return err
+			}
+
+			return p.print(keySet, jwksTable(keySet))
+		}),
+	}
+
+	exportCmd := &cobra.Command{
+		Use:   "export <id>",
+		Short: "Export the private key of an exportable key as an encrypted PKCS#8 PEM block",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, _ *printer) error {
+			passphrase, err := readPassphrase(cmd, passphraseFile)
+			if err != nil {
+				return err
+			}
+
+			pemBlock, err := c.ExportKey(cmd.Context(), store, args[0], &types.ExportKeyRequest{Passphrase: passphrase})
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprint(cmd.OutOrStdout(), pemBlock)
+			return err
+		}),
+	}
+	exportCmd.Flags().StringVar(&passphraseFile, "passphrase-file", "", "File holding the passphrase encrypting the exported key, - reads the standard input")
+	_ = exportCmd.MarkFlagRequired("passphrase-file")
+
+	keysCmd.AddCommand(
+		createCmd,
+		importCmd,
+		getCmd,
+		listCmd,
+		updateCmd,
+		signCmd,
+		signJWTCmd,
+		jwksCmd,
+		exportCmd,
+		newLifecycleCommand(opts, "delete", "Delete a key", "deleted", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.DeleteKey(cmd.Context(), store, id)
+		}),
+		newLifecycleCommand(opts, "destroy", "Permanently destroy a deleted key", "destroyed", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.DestroyKey(cmd.Context(), store, id)
+		}),
+		newLifecycleCommand(opts, "restore", "Restore a deleted key", "restored", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.RestoreKey(cmd.Context(), store, id)
+		}),
+	)
+
+	return keysCmd
+}
+
+// newLifecycleCommand creates a command deleting, destroying or restoring the resource given as argument
+func newLifecycleCommand(opts *options, use, short, done string, f func(cmd *cobra.Command, c *client.HTTPClient, id string) error) *cobra.Command {
+	return &cobra.Command{
+		Use:   use + " <id>",
+		Short: short,
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, _ *printer) error {
+			err := f(cmd, c, args[0])
+			if err != nil {
+				return err
+			}
+
+			printDone(cmd.ErrOrStderr(), "%s %s", args[0], done)
+			return nil
+		}),
+	}
+}
@@ -0,0 +1,185 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/jwt"
+	aliastypes "github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	storetypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+)
+
+const (
+	jsonOutput  = "json"
+	tableOutput = "table"
+)
+
+type table struct {
+	headers []string
+	rows    [][]string
+}
+
+type printer struct {
+	out    io.Writer
+	format string
+}
+
+func newPrinter(out io.Writer, format string) (*printer, error) {
+	if format != jsonOutput && format != tableOutput {
+		return nil, fmt.Errorf("invalid output format %q, expected %s or %s", format, jsonOutput, tableOutput)
+	}
+
+	return &printer{out: out, format: format}, nil
+}
+
+// print writes v as indented JSON or t as a table depending on the output format
+func (p *printer) print(v interface{}, t *table) error {
+	if p.format == jsonOutput {
+		enc := json.NewEncoder(p.out)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	}
+
+	w := tabwriter.NewWriter(p.out, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, strings.Join(t.headers, "\t"))
+	for _, row := range t.rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+
+	return w.Flush()
+}
+
+func valueTable(header, value string) *table {
+	return &table{headers: []string{header}, rows: [][]string{{value}}}
+}
+
+func idsTable(header string, ids []string) *table {
+	t := &table{headers: []string{header}}
+	for _, id := range ids {
+		t.rows = append(t.rows, []string{id})
+	}
+
+	return t
+}
+
+func keysTable(keys ...*storetypes.KeyResponse) *table {
+	t := &table{headers: []string{"ID", "CURVE", "ALGORITHM", "PUBLIC KEY", "TAGS", "DISABLED", "CREATED AT"}}
+	for _, key := range keys {
+		t.rows = append(t.rows, []string{
+			key.ID, key.Curve, key.SigningAlgorithm, key.PublicKey, formatTags(key.Tags), fmt.Sprint(key.Disabled), formatTime(key.CreatedAt),
+		})
+	}
+
+	return t
+}
+
+func jwksTable(keySet *jwt.JWKsResponse) *table {
+	t := &table{headers: []string{"KID", "ALGORITHM", "KEY TYPE", "CURVE", "X", "Y"}}
+	for _, key := range keySet.Keys {
+		t.rows = append(t.rows, []string{key.Kid, key.Alg, key.Kty, key.Crv, key.X, key.Y})
+	}
+
+	return t
+}
+
+func secretsTable(secrets ...*storetypes.SecretResponse) *table {
+	t := &table{headers: []string{"ID", "VERSION", "VALUE", "TAGS", "DISABLED", "CREATED AT"}}
+	for _, secret := range secrets {
+		t.rows = append(t.rows, []string{
+			secret.ID, secret.Version, secret.Value, formatTags(secret.Tags), fmt.Sprint(secret.Disabled), formatTime(secret.CreatedAt),
+		})
+	}
+
+	return t
+}
+
+func ethAccountsTable(accounts ...*storetypes.EthAccountResponse) *table {
+	t := &table{headers: []string{"ADDRESS", "KEY ID", "PUBLIC KEY", "TAGS", "DISABLED", "CREATED AT"}}
+	for _, acc := range accounts {
+		t.rows = append(t.rows, []string{
+			acc.Address.Hex(), acc.KeyID, acc.PublicKey.String(), formatTags(acc.Tags), fmt.Sprint(acc.Disabled), formatTime(acc.CreatedAt),
+		})
+	}
+
+	return t
+}
+
+func importKeystoresTable(results []*importKeystoreResult) *table {
+	t := &table{headers: []string{"FILE", "ADDRESS", "ERROR"}}
+	for _, result := range results {
+		t.rows = append(t.rows, []string{result.File, result.Address, result.Error})
+	}
+
+	return t
+}
+
+func aliasesTable(als []aliastypes.Alias) *table {
+	t := &table{headers: []string{"KEY", "KIND", "VALUES", "TAGS", "VERSION", "UPDATED AT"}}
+	for i := range als {
+		t.rows = append(t.rows, []string{
+			string(als[i].Key), als[i].Value.Kind, strings.Join(als[i].Value.Values, ","), formatTags(als[i].Tags), fmt.Sprint(als[i].Version), formatTime(als[i].UpdatedAt),
+		})
+	}
+
+	return t
+}
+
+func aliasResponsesTable(als ...*aliastypes.AliasResponse) *table {
+	t := &table{headers: []string{"VERSION", "KIND", "VALUES", "TAGS", "UPDATED BY", "UPDATED AT"}}
+	for _, al := range als {
+		t.rows = append(t.rows, []string{
+			fmt.Sprint(al.Version), al.Value.Kind, strings.Join(al.Value.Values, ","), formatTags(al.Tags), al.UpdatedBy, formatTime(al.UpdatedAt),
+		})
+	}
+
+	return t
+}
+
+func registriesTable(registries ...*aliastypes.RegistryResponse) *table {
+	t := &table{headers: []string{"NAME", "DESCRIPTION", "OWNER", "ALLOWED TENANTS", "CREATED BY", "CREATED AT"}}
+	for _, registry := range registries {
+		t.rows = append(t.rows, []string{
+			string(registry.Name), registry.Description, registry.Owner, strings.Join(registry.AllowedTenants, ","), registry.CreatedBy, formatTime(registry.CreatedAt),
+		})
+	}
+
+	return t
+}
+
+func importAliasesTable(res *aliastypes.ImportAliasesResponse) *table {
+	t := &table{headers: []string{"CHANGE", "KEY", "KIND", "VALUES"}}
+	appendRows := func(change string, als []aliastypes.ImportAlias) {
+		for _, al := range als {
+			t.rows = append(t.rows, []string{change, string(al.Key), al.Value.Kind, strings.Join(al.Value.Values, ",")})
+		}
+	}
+	appendRows("created", res.Created)
+	appendRows("updated", res.Updated)
+	appendRows("deleted", res.Deleted)
+	t.rows = append(t.rows, []string{"unchanged", fmt.Sprint(res.Unchanged), "", ""})
+
+	return t
+}
+
+func formatTags(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(pairs)
+
+	return strings.Join(pairs, ",")
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/spf13/cobra"
+)
+
+func newSecretsCommand(opts *options) *cobra.Command {
+	var (
+		store       string
+		value       string
+		version     string
+		tags        []string
+		shared      bool
+		deleted     bool
+		limit, page uint64
+	)
+
+	secretsCmd := &cobra.Command{
+		Use:   "secrets",
+		Short: "Manage the secrets of a store",
+	}
+	secretsCmd.PersistentFlags().StringVar(&store, "store", "", "Name of the store")
+	_ = secretsCmd.MarkPersistentFlagRequired("store")
+
+	setCmd := &cobra.Command{
+		Use:   "set <id>",
+		Short: "Set a new version of a secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			secretTags, err := parseTags(tags)
+			if err != nil {
+				return err
+			}
+
+			secret, err := c.SetSecret(cmd.Context(), store, args[0], &types.SetSecretRequest{
+				Value:  value,
+				Tags:   secretTags,
+				Shared: shared,
+			})
+			if err != nil {
+				return err
+			}
+
+			return p.print(secret, secretsTable(secret))
+		}),
+	}
+	setCmd.Flags().StringVar(&value, "value", "", "Value of the secret")
+	setCmd.Flags().StringArrayVar(&tags, "tag", nil, "Tag of the secret as key=value, can be repeated")
+	setCmd.Flags().BoolVar(&shared, "shared", false, "Share the secret with all the tenants")
+	_ = setCmd.MarkFlagRequired("value")
+
+	getCmd := &cobra.Command{
+		Use:   "get <id>",
+		Short: "Get a secret",
+		Args:  cobra.ExactArgs(1),
+		RunE: opts.run(func(cmd *cobra.Command, args []string, c *client.HTTPClient, p *printer) error {
+			var secret *types.SecretResponse
+			var err error
+			if deleted {
+				secret, err = c.GetDeletedSecret(cmd.Context(), store, args[0])
+			} else {
+				secret, err = c.GetSecret(cmd.Context(), store, args[0], version)
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(secret, secretsTable(secret))
+		}),
+	}
+	getCmd.Flags().StringVar(&version, "version", "", "Version of the secret, the latest by default")
+	getCmd.Flags().BoolVar(&deleted, "deleted", false, "Get a deleted secret")
+
+	listCmd := &cobra.Command{
+		Use:   "list",
+		Short: "List the IDs of the secrets",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			var ids []string
+			var err error
+			if deleted {
+				ids, err = c.ListDeletedSecrets(cmd.Context(), store, limit, page)
+			} else {
+				ids, err = c.ListSecrets(cmd.Context(), store, limit, page)
+			}
+			if err != nil {
+				return err
+			}
+
+			return p.print(ids, idsTable("ID", ids))
+		}),
+	}
+	listCmd.Flags().BoolVar(&deleted, "deleted", false, "List the deleted secrets")
+	listCmd.Flags().Uint64Var(&limit, "limit", 0, "Maximum number of secrets returned")
+	listCmd.Flags().Uint64Var(&page, "page", 0, "Page to return")
+
+	secretsCmd.AddCommand(
+		setCmd,
+		getCmd,
+		listCmd,
+		newLifecycleCommand(opts, "delete", "Delete a secret", "deleted", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.DeleteSecret(cmd.Context(), store, id)
+		}),
+		newLifecycleCommand(opts, "destroy", "Permanently destroy a deleted secret", "destroyed", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.DestroySecret(cmd.Context(), store, id)
+		}),
+		newLifecycleCommand(opts, "restore", "Restore a deleted secret", "restored", func(cmd *cobra.Command, c *client.HTTPClient, id string) error {
+			return c.RestoreSecret(cmd.Context(), store, id)
+		}),
+	)
+
+	return secretsCmd
+}
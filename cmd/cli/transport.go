@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// authTransport authenticates the requests sent to the Quorum Key Manager with an API key or a bearer token
+type authTransport struct {
+	apiKey string
+	token  string
+	next   http.RoundTripper
+}
+
+func (t *authTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	switch {
+	case t.token != "":
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", t.token))
+	case t.apiKey != "":
+		req = req.Clone(req.Context())
+		req.Header.Set("Authorization", fmt.Sprintf("Basic %s", base64.StdEncoding.EncodeToString([]byte(t.apiKey))))
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+func newTransport(profile *Profile) (http.RoundTripper, error) {
+	if profile.APIKey != "" && profile.Token != "" {
+		return nil, fmt.Errorf("api key and token cannot be used together")
+	}
+
+	tlsConfig, err := newTLSConfig(profile)
+	if err != nil {
+		return nil, err
+	}
+
+	base := http.DefaultTransport.(*http.Transport).Clone()
+	base.TLSClientConfig = tlsConfig
+
+	return &authTransport{
+		apiKey: profile.APIKey,
+		token:  profile.Token,
+		next:   base,
+	}, nil
+}
+
+func newTLSConfig(profile *Profile) (*tls.Config, error) {
+	cfg := &tls.Config{
+		// nolint
+		InsecureSkipVerify: profile.InsecureSkipVerify,
+	}
+
+	if profile.TLSCert != "" || profile.TLSKey != "" {
+		if profile.TLSCert == "" || profile.TLSKey == "" {
+			return nil, fmt.Errorf("both tls certificate and key must be provided")
+		}
+
+		cert, err := tls.LoadX509KeyPair(profile.TLSCert, profile.TLSKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load tls certificate: %v", err)
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+
+	if profile.TLSCA != "" {
+		caCert, err := ioutil.ReadFile(profile.TLSCA)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read tls ca: %v", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("invalid tls ca %s", profile.TLSCA)
+		}
+		cfg.RootCAs = pool
+	}
+
+	return cfg, nil
+}
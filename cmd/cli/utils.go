@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+)
+
+type verifyResponse struct {
+	Verified bool `json:"verified"`
+}
+
+func newUtilsCommands(opts *options) []*cobra.Command {
+	var (
+		data             string
+		signature        string
+		address          string
+		file             string
+		curve            string
+		signingAlgorithm string
+		payload          []byte
+		b64Signature     []byte
+		publicKey        []byte
+	)
+
+	printVerified := func(p *printer) error {
+		return p.print(&verifyResponse{Verified: true}, valueTable("VERIFIED", "true"))
+	}
+
+	verifySignatureCmd := &cobra.Command{
+		Use:   "verify-signature",
+		Short: "Verify the signature of a payload",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			err := c.VerifyKeySignature(cmd.Context(), &types.VerifyKeySignatureRequest{
+				Data:             payload,
+				Signature:        b64Signature,
+				Curve:            curve,
+				SigningAlgorithm: signingAlgorithm,
+				PublicKey:        publicKey,
+			})
+			if err != nil {
+				return err
+			}
+
+			return printVerified(p)
+		}),
+	}
+	verifySignatureCmd.Flags().BytesBase64Var(&payload, "data", nil, "Signed payload encoded in base64")
+	verifySignatureCmd.Flags().BytesBase64Var(&b64Signature, "signature", nil, "Signature encoded in base64")
+	verifySignatureCmd.Flags().BytesBase64Var(&publicKey, "public-key", nil, "Public key encoded in base64")
+	verifySignatureCmd.Flags().StringVar(&curve, "curve", "secp256k1", "Elliptic curve of the key: secp256k1 or babyjubjub")
+	verifySignatureCmd.Flags().StringVar(&signingAlgorithm, "signing-algorithm", "ecdsa", "Signing algorithm of the key: ecdsa or eddsa")
+	for _, name := range []string{"data", "signature", "public-key"} {
+		_ = verifySignatureCmd.MarkFlagRequired(name)
+	}
+
+	ecRecoverCmd := &cobra.Command{
+		Use:   "ec-recover",
+		Short: "Recover the address that signed a payload",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			payloadBytes, sig, err := decodeHexPayload(data, signature)
+			if err != nil {
+				return err
+			}
+
+			addr, err := c.ECRecover(cmd.Context(), &types.ECRecoverRequest{Data: payloadBytes, Signature: sig})
+			if err != nil {
+				return err
+			}
+
+			return p.print(addr, valueTable("ADDRESS", addr))
+		}),
+	}
+
+	verifyMessageCmd := &cobra.Command{
+		Use:   "verify-message",
+		Short: "Verify the EIP-191 signature of a message",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			payloadBytes, sig, err := decodeHexPayload(data, signature)
+			if err != nil {
+				return err
+			}
+			if !common.IsHexAddress(address) {
+				return fmt.Errorf("invalid address %s", address)
+			}
+
+			err = c.VerifyMessage(cmd.Context(), &types.VerifyRequest{
+				Data:      payloadBytes,
+				Signature: sig,
+				Address:   common.HexToAddress(address),
+			})
+			if err != nil {
+				return err
+			}
+
+			return printVerified(p)
+		}),
+	}
+
+	for _, cmd := range []*cobra.Command{ecRecoverCmd, verifyMessageCmd} {
+		cmd.Flags().StringVar(&data, "data", "", "Signed payload in hexadecimal prefixed by 0x")
+		cmd.Flags().StringVar(&signature, "signature", "", "Signature in hexadecimal prefixed by 0x")
+		_ = cmd.MarkFlagRequired("data")
+		_ = cmd.MarkFlagRequired("signature")
+	}
+	verifyMessageCmd.Flags().StringVar(&address, "address", "", "Address of the expected signer")
+	_ = verifyMessageCmd.MarkFlagRequired("address")
+
+	verifyTypedDataCmd := &cobra.Command{
+		Use:   "verify-typed-data",
+		Short: "Verify the EIP-712 signature of typed data",
+		Args:  cobra.NoArgs,
+		RunE: opts.run(func(cmd *cobra.Command, _ []string, c *client.HTTPClient, p *printer) error {
+			req := &types.VerifyTypedDataRequest{}
+			err := readJSONFile(cmd, file, req)
+			if err != nil {
+				return err
+			}
+
+			err = c.VerifyTypedData(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return printVerified(p)
+		}),
+	}
+	verifyTypedDataCmd.Flags().StringVarP(&file, "file", "f", "", "JSON file holding the typed data, the signature and the address, - to read the standard input")
+	_ = verifyTypedDataCmd.MarkFlagRequired("file")
+
+	return []*cobra.Command{verifySignatureCmd, ecRecoverCmd, verifyMessageCmd, verifyTypedDataCmd}
+}
+
+func decodeHexPayload(data, signature string) (payload, sig []byte, err error) {
+	payload, err = hexutil.Decode(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid data: %v", err)
+	}
+
+	sig, err = hexutil.Decode(signature)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid signature: %v", err)
+	}
+
+	return payload, sig, nil
+}
@@ -32,5 +32,9 @@ func NewAppConfig(vipr *viper.Viper) (*app.Config, error) {
 		Manifests: manifestCfg,
 		Auth:      authCfg,
 		Postgres:  postgresCfg,
+		RateLimit: newRateLimitConfig(vipr),
+		Token:     newAuthTokenConfig(vipr),
+		Nonce:     newNonceConfig(vipr),
+		Txs:       newTransactionsConfig(vipr),
 	}, nil
 }
@@ -18,7 +18,6 @@ import (
 	"github.com/consensys/quorum-key-manager/src/auth"
 	apikey "github.com/consensys/quorum-key-manager/src/auth/authenticator/api-key"
 	"github.com/consensys/quorum-key-manager/src/auth/authenticator/oidc"
-	authtls "github.com/consensys/quorum-key-manager/src/auth/authenticator/tls"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
 )
@@ -136,6 +135,7 @@ func AuthFlags(f *pflag.FlagSet) {
 	AuthOIDCClaimPermissions(f)
 	AuthOIDCClaimRoles(f)
 	authTLSCertFile(f)
+	authTLSFlags(f)
 	authAPIKeyFile(f)
 }
 
@@ -219,14 +219,11 @@ func NewAuthConfig(vipr *viper.Viper) (*auth.Config, error) {
 	}
 
 	// TLS
-	var tlsCfg *authtls.Config
-	tlsAuthCAs, err := tlsAuthCerts(vipr)
+	tlsCfg, err := newTLSAuthConfig(vipr)
 	if err != nil {
 		return nil, err
 	}
 
-	tlsCfg = authtls.NewConfig(tlsAuthCAs)
-
 	return &auth.Config{
 		OIDC:   oidcCfg,
 		APIKEY: apiKeyCfg,
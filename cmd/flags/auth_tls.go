@@ -0,0 +1,165 @@
+package flags
+
+import (
+	"fmt"
+
+	authtls "github.com/consensys/quorum-key-manager/src/auth/authenticator/tls"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	_ = viper.BindEnv(authTLSCRLFileViperKey, authTLSCRLFileEnv)
+	viper.SetDefault(authTLSCRLReloadIntervalViperKey, authTLSCRLReloadIntervalDefault)
+	_ = viper.BindEnv(authTLSCRLReloadIntervalViperKey, authTLSCRLReloadIntervalEnv)
+	_ = viper.BindEnv(authTLSOCSPViperKey, authTLSOCSPEnv)
+
+	viper.SetDefault(authTLSClaimUsernameViperKey, authTLSClaimUsernameDefault)
+	_ = viper.BindEnv(authTLSClaimUsernameViperKey, authTLSClaimUsernameEnv)
+	_ = viper.BindEnv(authTLSClaimTenantViperKey, authTLSClaimTenantEnv)
+	viper.SetDefault(authTLSClaimRolesViperKey, authTLSClaimRolesDefault)
+	_ = viper.BindEnv(authTLSClaimRolesViperKey, authTLSClaimRolesEnv)
+	viper.SetDefault(authTLSClaimPermissionsViperKey, authTLSClaimPermissionsDefault)
+	_ = viper.BindEnv(authTLSClaimPermissionsViperKey, authTLSClaimPermissionsEnv)
+}
+
+const (
+	authTLSCRLFileFlag     = "auth-tls-crl"
+	authTLSCRLFileViperKey = "auth.tls.crl"
+	authTLSCRLFileDefault  = ""
+	authTLSCRLFileEnv      = "AUTH_TLS_CRL"
+)
+
+const (
+	authTLSCRLReloadIntervalFlag     = "auth-tls-crl-reload-interval"
+	authTLSCRLReloadIntervalViperKey = "auth.tls.crl.reload.interval"
+	authTLSCRLReloadIntervalDefault  = authtls.DefaultCRLReloadInterval
+	authTLSCRLReloadIntervalEnv      = "AUTH_TLS_CRL_RELOAD_INTERVAL"
+)
+
+const (
+	authTLSOCSPFlag     = "auth-tls-ocsp"
+	authTLSOCSPViperKey = "auth.tls.ocsp"
+	authTLSOCSPDefault  = string(authtls.OCSPDisabled)
+	authTLSOCSPEnv      = "AUTH_TLS_OCSP"
+)
+
+const (
+	authTLSClaimUsernameFlag     = "auth-tls-claim-username"
+	authTLSClaimUsernameViperKey = "auth.tls.claim.username"
+	authTLSClaimUsernameDefault  = authtls.CommonNameSource
+	authTLSClaimUsernameEnv      = "AUTH_TLS_CLAIM_USERNAME"
+)
+
+const (
+	authTLSClaimTenantFlag     = "auth-tls-claim-tenant"
+	authTLSClaimTenantViperKey = "auth.tls.claim.tenant"
+	authTLSClaimTenantDefault  = ""
+	authTLSClaimTenantEnv      = "AUTH_TLS_CLAIM_TENANT"
+)
+
+const (
+	authTLSClaimRolesFlag     = "auth-tls-claim-roles"
+	authTLSClaimRolesViperKey = "auth.tls.claim.roles"
+	authTLSClaimRolesDefault  = authtls.OrganizationSource
+	authTLSClaimRolesEnv      = "AUTH_TLS_CLAIM_ROLES"
+)
+
+const (
+	authTLSClaimPermissionsFlag     = "auth-tls-claim-permissions"
+	authTLSClaimPermissionsViperKey = "auth.tls.claim.permissions"
+	authTLSClaimPermissionsDefault  = authtls.OrganizationalUnitSource
+	authTLSClaimPermissionsEnv      = "AUTH_TLS_CLAIM_PERMISSIONS"
+)
+
+const authTLSClaimFormat = `Format is "<field>[=<pattern>]" where field is one of "cn", "o", "ou", "uri-san", "dns-san", "email-san" or "oid:<OID>", and pattern is an optional regular expression whose first capture group is extracted.`
+
+func authTLSCRLFile(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator certificate revocation list filepath (PEM or DER), reloaded when modified.
+Environment variable: %q`, authTLSCRLFileEnv)
+	f.String(authTLSCRLFileFlag, authTLSCRLFileDefault, desc)
+	_ = viper.BindPFlag(authTLSCRLFileViperKey, f.Lookup(authTLSCRLFileFlag))
+}
+
+func authTLSCRLReloadInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator minimum interval between two checks of the certificate revocation list modification.
+Environment variable: %q`, authTLSCRLReloadIntervalEnv)
+	f.Duration(authTLSCRLReloadIntervalFlag, authTLSCRLReloadIntervalDefault, desc)
+	_ = viper.BindPFlag(authTLSCRLReloadIntervalViperKey, f.Lookup(authTLSCRLReloadIntervalFlag))
+}
+
+func authTLSOCSP(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator OCSP check of the client certificates (one of %q). Revoked certificates are rejected in both modes, %q also rejects certificates whose status cannot be retrieved.
+Environment variable: %q`, []string{string(authtls.OCSPOptional), string(authtls.OCSPRequired)}, authtls.OCSPRequired, authTLSOCSPEnv)
+	f.String(authTLSOCSPFlag, authTLSOCSPDefault, desc)
+	_ = viper.BindPFlag(authTLSOCSPViperKey, f.Lookup(authTLSOCSPFlag))
+}
+
+func authTLSClaimUsername(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator certificate field for username. %s
+Environment variable: %q`, authTLSClaimFormat, authTLSClaimUsernameEnv)
+	f.String(authTLSClaimUsernameFlag, authTLSClaimUsernameDefault, desc)
+	_ = viper.BindPFlag(authTLSClaimUsernameViperKey, f.Lookup(authTLSClaimUsernameFlag))
+}
+
+func authTLSClaimTenant(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator certificate field for tenant. If empty, the tenant is extracted from the username ("tenant|username"). %s
+Environment variable: %q`, authTLSClaimFormat, authTLSClaimTenantEnv)
+	f.String(authTLSClaimTenantFlag, authTLSClaimTenantDefault, desc)
+	_ = viper.BindPFlag(authTLSClaimTenantViperKey, f.Lookup(authTLSClaimTenantFlag))
+}
+
+func authTLSClaimRoles(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator certificate field for roles. %s
+Environment variable: %q`, authTLSClaimFormat, authTLSClaimRolesEnv)
+	f.String(authTLSClaimRolesFlag, authTLSClaimRolesDefault, desc)
+	_ = viper.BindPFlag(authTLSClaimRolesViperKey, f.Lookup(authTLSClaimRolesFlag))
+}
+
+func authTLSClaimPermissions(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`TLS Authenticator certificate field for permissions. %s
+Environment variable: %q`, authTLSClaimFormat, authTLSClaimPermissionsEnv)
+	f.String(authTLSClaimPermissionsFlag, authTLSClaimPermissionsDefault, desc)
+	_ = viper.BindPFlag(authTLSClaimPermissionsViperKey, f.Lookup(authTLSClaimPermissionsFlag))
+}
+
+func authTLSFlags(f *pflag.FlagSet) {
+	authTLSCRLFile(f)
+	authTLSCRLReloadInterval(f)
+	authTLSOCSP(f)
+	authTLSClaimUsername(f)
+	authTLSClaimTenant(f)
+	authTLSClaimRoles(f)
+	authTLSClaimPermissions(f)
+}
+
+func newTLSAuthConfig(vipr *viper.Viper) (*authtls.Config, error) {
+	cas, err := tlsAuthCerts(vipr)
+	if err != nil {
+		return nil, err
+	}
+
+	mapping := &authtls.Mapping{}
+	for _, field := range []struct {
+		target   **authtls.Field
+		viperKey string
+	}{
+		{&mapping.Username, authTLSClaimUsernameViperKey},
+		{&mapping.Tenant, authTLSClaimTenantViperKey},
+		{&mapping.Roles, authTLSClaimRolesViperKey},
+		{&mapping.Permissions, authTLSClaimPermissionsViperKey},
+	} {
+		*field.target, err = authtls.ParseField(vipr.GetString(field.viperKey))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cfg := authtls.NewConfig(cas)
+	cfg.CRLFile = vipr.GetString(authTLSCRLFileViperKey)
+	cfg.CRLReloadInterval = vipr.GetDuration(authTLSCRLReloadIntervalViperKey)
+	cfg.OCSP = authtls.OCSPMode(vipr.GetString(authTLSOCSPViperKey))
+	cfg.Mapping = mapping
+
+	return cfg, nil
+}
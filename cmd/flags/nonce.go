@@ -0,0 +1,39 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(nonceBackendViperKey, nonceBackendDefault)
+	_ = viper.BindEnv(nonceBackendViperKey, nonceBackendEnv)
+}
+
+// NonceFlags register flags for the nonces of the transactions sent through the nodes
+func NonceFlags(f *pflag.FlagSet) {
+	nonceBackend(f)
+}
+
+const (
+	nonceBackendFlag     = "nonce-backend"
+	nonceBackendViperKey = "nonce.backend"
+	nonceBackendDefault  = nonce.MemoryBackend
+	nonceBackendEnv      = "NONCE_BACKEND"
+)
+
+func nonceBackend(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Storage of the nonces allocated to the transactions sent through the nodes (one of %q). Use %q to share the nonces between instances.
+Environment variable: %q`, []string{nonce.MemoryBackend, nonce.PostgresBackend}, nonce.PostgresBackend, nonceBackendEnv)
+	f.String(nonceBackendFlag, nonceBackendDefault, desc)
+	_ = viper.BindPFlag(nonceBackendViperKey, f.Lookup(nonceBackendFlag))
+}
+
+func newNonceConfig(vipr *viper.Viper) *nonce.Config {
+	return &nonce.Config{
+		Backend: vipr.GetString(nonceBackendViperKey),
+	}
+}
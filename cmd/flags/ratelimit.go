@@ -0,0 +1,182 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(rateLimitBackendViperKey, rateLimitBackendDefault)
+	_ = viper.BindEnv(rateLimitBackendViperKey, rateLimitBackendEnv)
+	viper.SetDefault(rateLimitStoreConcurrencyViperKey, rateLimitStoreConcurrencyDefault)
+	_ = viper.BindEnv(rateLimitStoreConcurrencyViperKey, rateLimitStoreConcurrencyEnv)
+	viper.SetDefault(rateLimitUserRateViperKey, rateLimitRateDefault)
+	_ = viper.BindEnv(rateLimitUserRateViperKey, rateLimitUserRateEnv)
+	viper.SetDefault(rateLimitUserBurstViperKey, rateLimitBurstDefault)
+	_ = viper.BindEnv(rateLimitUserBurstViperKey, rateLimitUserBurstEnv)
+	viper.SetDefault(rateLimitTenantRateViperKey, rateLimitRateDefault)
+	_ = viper.BindEnv(rateLimitTenantRateViperKey, rateLimitTenantRateEnv)
+	viper.SetDefault(rateLimitTenantBurstViperKey, rateLimitBurstDefault)
+	_ = viper.BindEnv(rateLimitTenantBurstViperKey, rateLimitTenantBurstEnv)
+	viper.SetDefault(rateLimitStoreRateViperKey, rateLimitRateDefault)
+	_ = viper.BindEnv(rateLimitStoreRateViperKey, rateLimitStoreRateEnv)
+	viper.SetDefault(rateLimitStoreBurstViperKey, rateLimitBurstDefault)
+	_ = viper.BindEnv(rateLimitStoreBurstViperKey, rateLimitStoreBurstEnv)
+	viper.SetDefault(rateLimitKeyRateViperKey, rateLimitRateDefault)
+	_ = viper.BindEnv(rateLimitKeyRateViperKey, rateLimitKeyRateEnv)
+	viper.SetDefault(rateLimitKeyBurstViperKey, rateLimitBurstDefault)
+	_ = viper.BindEnv(rateLimitKeyBurstViperKey, rateLimitKeyBurstEnv)
+}
+
+// RateLimitFlags register flags for the rate limits of the stores operations
+func RateLimitFlags(f *pflag.FlagSet) {
+	rateLimitBackend(f)
+	rateLimitStoreConcurrency(f)
+	rateLimitUser(f)
+	rateLimitTenant(f)
+	rateLimitStore(f)
+	rateLimitKey(f)
+}
+
+const (
+	rateLimitRateDefault  = 0.0
+	rateLimitBurstDefault = 1
+)
+
+const (
+	rateLimitBackendFlag     = "rate-limit-backend"
+	rateLimitBackendViperKey = "rate-limit.backend"
+	rateLimitBackendDefault  = limiter.MemoryBackend
+	rateLimitBackendEnv      = "RATE_LIMIT_BACKEND"
+)
+
+func rateLimitBackend(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Storage of the rate limit counters (one of %q). Use %q to share the counters between instances.
+Environment variable: %q`, []string{limiter.MemoryBackend, limiter.PostgresBackend}, limiter.PostgresBackend, rateLimitBackendEnv)
+	f.String(rateLimitBackendFlag, rateLimitBackendDefault, desc)
+	_ = viper.BindPFlag(rateLimitBackendViperKey, f.Lookup(rateLimitBackendFlag))
+}
+
+const (
+	rateLimitStoreConcurrencyFlag     = "rate-limit-store-concurrency"
+	rateLimitStoreConcurrencyViperKey = "rate-limit.store.concurrency"
+	rateLimitStoreConcurrencyDefault  = 0
+	rateLimitStoreConcurrencyEnv      = "RATE_LIMIT_STORE_CONCURRENCY"
+)
+
+func rateLimitStoreConcurrency(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum number of concurrent operations performed on the backend of a store (0 for unlimited).
+Environment variable: %q`, rateLimitStoreConcurrencyEnv)
+	f.Int(rateLimitStoreConcurrencyFlag, rateLimitStoreConcurrencyDefault, desc)
+	_ = viper.BindPFlag(rateLimitStoreConcurrencyViperKey, f.Lookup(rateLimitStoreConcurrencyFlag))
+}
+
+const (
+	rateLimitUserRateFlag      = "rate-limit-user-rate"
+	rateLimitUserRateViperKey  = "rate-limit.user.rate"
+	rateLimitUserRateEnv       = "RATE_LIMIT_USER_RATE"
+	rateLimitUserBurstFlag     = "rate-limit-user-burst"
+	rateLimitUserBurstViperKey = "rate-limit.user.burst"
+	rateLimitUserBurstEnv      = "RATE_LIMIT_USER_BURST"
+)
+
+func rateLimitUser(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of operations per second allowed on a user (0 for unlimited).
+Environment variable: %q`, rateLimitUserRateEnv)
+	f.Float64(rateLimitUserRateFlag, rateLimitRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitUserRateViperKey, f.Lookup(rateLimitUserRateFlag))
+
+	desc = fmt.Sprintf(`Number of operations allowed at once on a user.
+Environment variable: %q`, rateLimitUserBurstEnv)
+	f.Int(rateLimitUserBurstFlag, rateLimitBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitUserBurstViperKey, f.Lookup(rateLimitUserBurstFlag))
+}
+
+const (
+	rateLimitTenantRateFlag      = "rate-limit-tenant-rate"
+	rateLimitTenantRateViperKey  = "rate-limit.tenant.rate"
+	rateLimitTenantRateEnv       = "RATE_LIMIT_TENANT_RATE"
+	rateLimitTenantBurstFlag     = "rate-limit-tenant-burst"
+	rateLimitTenantBurstViperKey = "rate-limit.tenant.burst"
+	rateLimitTenantBurstEnv      = "RATE_LIMIT_TENANT_BURST"
+)
+
+func rateLimitTenant(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of operations per second allowed on a tenant (0 for unlimited).
+Environment variable: %q`, rateLimitTenantRateEnv)
+	f.Float64(rateLimitTenantRateFlag, rateLimitRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitTenantRateViperKey, f.Lookup(rateLimitTenantRateFlag))
+
+	desc = fmt.Sprintf(`Number of operations allowed at once on a tenant.
+Environment variable: %q`, rateLimitTenantBurstEnv)
+	f.Int(rateLimitTenantBurstFlag, rateLimitBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitTenantBurstViperKey, f.Lookup(rateLimitTenantBurstFlag))
+}
+
+const (
+	rateLimitStoreRateFlag      = "rate-limit-store-rate"
+	rateLimitStoreRateViperKey  = "rate-limit.store.rate"
+	rateLimitStoreRateEnv       = "RATE_LIMIT_STORE_RATE"
+	rateLimitStoreBurstFlag     = "rate-limit-store-burst"
+	rateLimitStoreBurstViperKey = "rate-limit.store.burst"
+	rateLimitStoreBurstEnv      = "RATE_LIMIT_STORE_BURST"
+)
+
+func rateLimitStore(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of operations per second allowed on a store (0 for unlimited).
+Environment variable: %q`, rateLimitStoreRateEnv)
+	f.Float64(rateLimitStoreRateFlag, rateLimitRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitStoreRateViperKey, f.Lookup(rateLimitStoreRateFlag))
+
+	desc = fmt.Sprintf(`Number of operations allowed at once on a store.
+Environment variable: %q`, rateLimitStoreBurstEnv)
+	f.Int(rateLimitStoreBurstFlag, rateLimitBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitStoreBurstViperKey, f.Lookup(rateLimitStoreBurstFlag))
+}
+
+const (
+	rateLimitKeyRateFlag      = "rate-limit-key-rate"
+	rateLimitKeyRateViperKey  = "rate-limit.key.rate"
+	rateLimitKeyRateEnv       = "RATE_LIMIT_KEY_RATE"
+	rateLimitKeyBurstFlag     = "rate-limit-key-burst"
+	rateLimitKeyBurstViperKey = "rate-limit.key.burst"
+	rateLimitKeyBurstEnv      = "RATE_LIMIT_KEY_BURST"
+)
+
+func rateLimitKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Number of operations per second allowed on a key or an account (0 for unlimited).
+Environment variable: %q`, rateLimitKeyRateEnv)
+	f.Float64(rateLimitKeyRateFlag, rateLimitRateDefault, desc)
+	_ = viper.BindPFlag(rateLimitKeyRateViperKey, f.Lookup(rateLimitKeyRateFlag))
+
+	desc = fmt.Sprintf(`Number of operations allowed at once on a key or an account.
+Environment variable: %q`, rateLimitKeyBurstEnv)
+	f.Int(rateLimitKeyBurstFlag, rateLimitBurstDefault, desc)
+	_ = viper.BindPFlag(rateLimitKeyBurstViperKey, f.Lookup(rateLimitKeyBurstFlag))
+}
+
+func newRateLimitConfig(vipr *viper.Viper) *limiter.Config {
+	return &limiter.Config{
+		Backend:          vipr.GetString(rateLimitBackendViperKey),
+		StoreConcurrency: vipr.GetInt(rateLimitStoreConcurrencyViperKey),
+		User: limiter.Limit{
+			Rate:  vipr.GetFloat64(rateLimitUserRateViperKey),
+			Burst: vipr.GetInt(rateLimitUserBurstViperKey),
+		},
+		Tenant: limiter.Limit{
+			Rate:  vipr.GetFloat64(rateLimitTenantRateViperKey),
+			Burst: vipr.GetInt(rateLimitTenantBurstViperKey),
+		},
+		Store: limiter.Limit{
+			Rate:  vipr.GetFloat64(rateLimitStoreRateViperKey),
+			Burst: vipr.GetInt(rateLimitStoreBurstViperKey),
+		},
+		Key: limiter.Limit{
+			Rate:  vipr.GetFloat64(rateLimitKeyRateViperKey),
+			Burst: vipr.GetInt(rateLimitKeyBurstViperKey),
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	_ = viper.BindEnv(authTokenStoreViperKey, authTokenStoreEnv)
+	_ = viper.BindEnv(authTokenKeyViperKey, authTokenKeyEnv)
+	viper.SetDefault(authTokenTTLViperKey, authTokenTTLDefault)
+	_ = viper.BindEnv(authTokenTTLViperKey, authTokenTTLEnv)
+	viper.SetDefault(authTokenMaxTTLViperKey, authTokenMaxTTLDefault)
+	_ = viper.BindEnv(authTokenMaxTTLViperKey, authTokenMaxTTLEnv)
+}
+
+// AuthTokenFlags register flags for the tokens issued by the key manager on /auth/token
+func AuthTokenFlags(f *pflag.FlagSet) {
+	authTokenStore(f)
+	authTokenKey(f)
+	authTokenTTL(f)
+	authTokenMaxTTL(f)
+}
+
+const (
+	authTokenStoreFlag     = "auth-token-store"
+	authTokenStoreViperKey = "auth.token.store"
+	authTokenStoreDefault  = ""
+	authTokenStoreEnv      = "AUTH_TOKEN_STORE"
+)
+
+func authTokenStore(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Name of the key store holding the key signing the issued tokens. Token issuance is disabled if empty.
+Environment variable: %q`, authTokenStoreEnv)
+	f.String(authTokenStoreFlag, authTokenStoreDefault, desc)
+	_ = viper.BindPFlag(authTokenStoreViperKey, f.Lookup(authTokenStoreFlag))
+}
+
+const (
+	authTokenKeyFlag     = "auth-token-key"
+	authTokenKeyViperKey = "auth.token.key"
+	authTokenKeyDefault  = ""
+	authTokenKeyEnv      = "AUTH_TOKEN_KEY"
+)
+
+func authTokenKey(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Identifier of the secp256k1 ECDSA key signing the issued tokens.
+Environment variable: %q`, authTokenKeyEnv)
+	f.String(authTokenKeyFlag, authTokenKeyDefault, desc)
+	_ = viper.BindPFlag(authTokenKeyViperKey, f.Lookup(authTokenKeyFlag))
+}
+
+const (
+	authTokenTTLFlag     = "auth-token-ttl"
+	authTokenTTLViperKey = "auth.token.ttl"
+	authTokenTTLDefault  = token.DefaultTTL
+	authTokenTTLEnv      = "AUTH_TOKEN_TTL"
+)
+
+func authTokenTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Lifetime of the issued tokens when none is requested.
+Environment variable: %q`, authTokenTTLEnv)
+	f.Duration(authTokenTTLFlag, authTokenTTLDefault, desc)
+	_ = viper.BindPFlag(authTokenTTLViperKey, f.Lookup(authTokenTTLFlag))
+}
+
+const (
+	authTokenMaxTTLFlag     = "auth-token-max-ttl"
+	authTokenMaxTTLViperKey = "auth.token.max.ttl"
+	authTokenMaxTTLDefault  = token.DefaultMaxTTL
+	authTokenMaxTTLEnv      = "AUTH_TOKEN_MAX_TTL"
+)
+
+func authTokenMaxTTL(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Maximum lifetime that can be requested for the issued tokens.
+Environment variable: %q`, authTokenMaxTTLEnv)
+	f.Duration(authTokenMaxTTLFlag, authTokenMaxTTLDefault, desc)
+	_ = viper.BindPFlag(authTokenMaxTTLViperKey, f.Lookup(authTokenMaxTTLFlag))
+}
+
+func newAuthTokenConfig(vipr *viper.Viper) *token.Config {
+	return &token.Config{
+		StoreName:  vipr.GetString(authTokenStoreViperKey),
+		KeyID:      vipr.GetString(authTokenKeyViperKey),
+		DefaultTTL: vipr.GetDuration(authTokenTTLViperKey),
+		MaxTTL:     vipr.GetDuration(authTokenMaxTTLViperKey),
+	}
+}
@@ -0,0 +1,77 @@
+package flags
+
+import (
+	"fmt"
+
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func init() {
+	viper.SetDefault(txBackendViperKey, txBackendDefault)
+	_ = viper.BindEnv(txBackendViperKey, txBackendEnv)
+
+	viper.SetDefault(txWatchIntervalViperKey, txWatchIntervalDefault)
+	_ = viper.BindEnv(txWatchIntervalViperKey, txWatchIntervalEnv)
+
+	viper.SetDefault(txDropTimeoutViperKey, txDropTimeoutDefault)
+	_ = viper.BindEnv(txDropTimeoutViperKey, txDropTimeoutEnv)
+}
+
+// TransactionsFlags register flags for the tracking of the transactions sent through the nodes
+func TransactionsFlags(f *pflag.FlagSet) {
+	txBackend(f)
+	txWatchInterval(f)
+	txDropTimeout(f)
+}
+
+const (
+	txBackendFlag     = "tx-backend"
+	txBackendViperKey = "tx.backend"
+	txBackendDefault  = transactions.MemoryBackend
+	txBackendEnv      = "TX_BACKEND"
+)
+
+func txBackend(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Storage of the transactions sent through the nodes (one of %q). Use %q to keep the transactions across restarts and share them between instances.
+Environment variable: %q`, []string{transactions.MemoryBackend, transactions.PostgresBackend}, transactions.PostgresBackend, txBackendEnv)
+	f.String(txBackendFlag, txBackendDefault, desc)
+	_ = viper.BindPFlag(txBackendViperKey, f.Lookup(txBackendFlag))
+}
+
+const (
+	txWatchIntervalFlag     = "tx-watch-interval"
+	txWatchIntervalViperKey = "tx.watch.interval"
+	txWatchIntervalDefault  = transactions.DefaultWatchInterval
+	txWatchIntervalEnv      = "TX_WATCH_INTERVAL"
+)
+
+func txWatchInterval(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Interval between two polls of the receipts of the pending transactions.
+Environment variable: %q`, txWatchIntervalEnv)
+	f.Duration(txWatchIntervalFlag, txWatchIntervalDefault, desc)
+	_ = viper.BindPFlag(txWatchIntervalViperKey, f.Lookup(txWatchIntervalFlag))
+}
+
+const (
+	txDropTimeoutFlag     = "tx-drop-timeout"
+	txDropTimeoutViperKey = "tx.drop.timeout"
+	txDropTimeoutDefault  = transactions.DefaultDropTimeout
+	txDropTimeoutEnv      = "TX_DROP_TIMEOUT"
+)
+
+func txDropTimeout(f *pflag.FlagSet) {
+	desc := fmt.Sprintf(`Time after which a pending transaction unknown to its node is considered dropped.
+Environment variable: %q`, txDropTimeoutEnv)
+	f.Duration(txDropTimeoutFlag, txDropTimeoutDefault, desc)
+	_ = viper.BindPFlag(txDropTimeoutViperKey, f.Lookup(txDropTimeoutFlag))
+}
+
+func newTransactionsConfig(vipr *viper.Viper) *transactions.Config {
+	return &transactions.Config{
+		Backend:       vipr.GetString(txBackendViperKey),
+		WatchInterval: vipr.GetDuration(txWatchIntervalViperKey),
+		DropTimeout:   vipr.GetDuration(txDropTimeoutViperKey),
+	}
+}
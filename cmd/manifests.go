@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/cmd/flags"
+	"github.com/consensys/quorum-key-manager/src/infra/log/zap"
+	"github.com/consensys/quorum-key-manager/src/manifests/validator"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	checkConnectivity   bool
+	connectivityTimeout time.Duration
+)
+
+func newManifestsCommand() *cobra.Command {
+	manifestsCmd := &cobra.Command{
+		Use:   "manifests",
+		Short: "Manage manifests",
+	}
+
+	validateCmd := &cobra.Command{
+		Use:   "validate <path>",
+		Short: "Validate the manifests of a file or folder",
+		Long: `Validate the manifests of a YAML file or of all the YAML files of a folder.
+Specs are checked against their kind, unknown and missing required fields are reported as well as duplicate names.
+Exits with a non-zero status if a manifest is invalid.`,
+		Args:         cobra.ExactArgs(1),
+		RunE:         runValidateManifests,
+		SilenceUsage: true,
+	}
+
+	flags.LoggerFlags(validateCmd.Flags())
+	validateCmd.Flags().BoolVar(&checkConnectivity, "check-connectivity", false, "connect to the vaults and nodes of the valid manifests")
+	validateCmd.Flags().DurationVar(&connectivityTimeout, "timeout", 30*time.Second, "timeout of the connectivity checks")
+
+	manifestsCmd.AddCommand(validateCmd)
+
+	return manifestsCmd
+}
+
+func runValidateManifests(cmd *cobra.Command, args []string) error {
+	items, issues, err := validator.Load(args[0])
+	if err != nil {
+		return err
+	}
+
+	issues = append(issues, validator.Validate(items)...)
+
+	if checkConnectivity && len(issues) == 0 {
+		logger, err := zap.NewLogger(flags.NewLoggerConfig(viper.GetViper()))
+		if err != nil {
+			return err
+		}
+		defer syncZapLogger(logger)
+
+		ctx, cancel := context.WithTimeout(context.Background(), connectivityTimeout)
+		defer cancel()
+
+		issues = append(issues, validator.CheckConnectivity(ctx, items, logger)...)
+	}
+
+	for _, issue := range issues {
+		_, _ = fmt.Fprintln(cmd.ErrOrStderr(), issue.Error())
+	}
+
+	if len(issues) > 0 {
+		return fmt.Errorf("%d issue(s) found in the manifests of %s", len(issues), args[0])
+	}
+
+	_, _ = fmt.Fprintf(cmd.OutOrStdout(), "%d manifest(s) of %s are valid\n", len(items), args[0])
+	return nil
+}
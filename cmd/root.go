@@ -3,6 +3,7 @@ package cmd
 import (
 	"strings"
 
+	"github.com/consensys/quorum-key-manager/cmd/cli"
 	"github.com/consensys/quorum-key-manager/src/infra/log/zap"
 
 	"github.com/spf13/cobra"
@@ -20,6 +21,8 @@ func NewCommand() *cobra.Command {
 	rootCmd.AddCommand(newRunCommand())
 	rootCmd.AddCommand(newMigrateCommand())
 	rootCmd.AddCommand(newUtilCommand())
+	rootCmd.AddCommand(newManifestsCommand())
+	rootCmd.AddCommand(cli.NewCommands()...)
 
 	return rootCmd
 }
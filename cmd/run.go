@@ -30,6 +30,10 @@ func newRunCommand() *cobra.Command {
 	flags.LoggerFlags(runCmd.Flags())
 	flags.AuthFlags(runCmd.Flags())
 	flags.PGFlags(runCmd.Flags())
+	flags.RateLimitFlags(runCmd.Flags())
+	flags.AuthTokenFlags(runCmd.Flags())
+	flags.NonceFlags(runCmd.Flags())
+	flags.TransactionsFlags(runCmd.Flags())
 
 	return runCmd
 }
@@ -12,6 +12,7 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/consensys/quorum-key-manager/pkg/tls/certificate"
 
+	"github.com/consensys/quorum-key-manager/cmd/cli"
 	"github.com/consensys/quorum-key-manager/cmd/flags"
 	"github.com/consensys/quorum-key-manager/src/infra/log/zap"
 	"github.com/spf13/cobra"
@@ -53,6 +54,7 @@ func newUtilCommand() *cobra.Command {
 	generateJWTCmd.Flags().DurationVar(&expiration, "expiration", time.Hour, "token expiration time")
 
 	utilCmd.AddCommand(generateJWTCmd)
+	utilCmd.AddCommand(cli.NewUtilsCommands()...)
 
 	return utilCmd
 }
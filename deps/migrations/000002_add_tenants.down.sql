@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE secrets
+    DROP COLUMN tenant,
+    DROP COLUMN shared;
+
+ALTER TABLE keys
+    DROP COLUMN tenant,
+    DROP COLUMN shared;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN tenant,
+    DROP COLUMN shared;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+ALTER TABLE secrets
+    ADD COLUMN tenant TEXT,
+    ADD COLUMN shared BOOLEAN default false;
+
+ALTER TABLE keys
+    ADD COLUMN tenant TEXT,
+    ADD COLUMN shared BOOLEAN default false;
+
+ALTER TABLE eth_accounts
+    ADD COLUMN tenant TEXT,
+    ADD COLUMN shared BOOLEAN default false;
+
+COMMIT;
@@ -0,0 +1,5 @@
+CREATE TABLE IF NOT EXISTS rate_limits (
+    key TEXT PRIMARY KEY,
+    tokens DOUBLE PRECISION NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
@@ -0,0 +1,24 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS transactions (
+    node TEXT NOT NULL,
+    hash TEXT NOT NULL,
+    kind TEXT NOT NULL,
+    from_address TEXT NOT NULL,
+    to_address TEXT,
+    nonce BIGINT NOT NULL,
+    raw BYTEA,
+    tenant TEXT,
+    username TEXT,
+    status TEXT NOT NULL,
+    block_number BIGINT,
+    replaced_by TEXT,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (node, hash)
+);
+
+CREATE INDEX IF NOT EXISTS transactions_status_idx ON transactions (status);
+CREATE INDEX IF NOT EXISTS transactions_from_nonce_idx ON transactions (node, from_address, nonce);
+
+COMMIT;
@@ -0,0 +1,12 @@
+BEGIN;
+
+ALTER TABLE aliases
+    DROP CONSTRAINT aliases_registry_name_fkey,
+    DROP COLUMN created_by,
+    DROP COLUMN updated_by,
+    DROP COLUMN created_at,
+    DROP COLUMN updated_at;
+
+DROP TABLE IF EXISTS registries;
+
+COMMIT;
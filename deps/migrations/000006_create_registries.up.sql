@@ -0,0 +1,21 @@
+BEGIN;
+
+CREATE TABLE IF NOT EXISTS registries (
+    name TEXT PRIMARY KEY,
+    tenant TEXT,
+    allowed_tenants TEXT[],
+    created_by TEXT,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL
+);
+
+-- Registries of the existing aliases have no owner and remain accessible to all tenants
+INSERT INTO registries (name) SELECT DISTINCT registry_name FROM aliases ON CONFLICT DO NOTHING;
+
+ALTER TABLE aliases
+    ADD COLUMN created_by TEXT,
+    ADD COLUMN updated_by TEXT,
+    ADD COLUMN created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    ADD COLUMN updated_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    ADD CONSTRAINT aliases_registry_name_fkey FOREIGN KEY (registry_name) REFERENCES registries (name) ON DELETE CASCADE;
+
+COMMIT;
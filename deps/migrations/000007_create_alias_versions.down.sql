@@ -0,0 +1,9 @@
+BEGIN;
+
+DROP TABLE IF EXISTS alias_versions;
+
+ALTER TABLE aliases
+    DROP COLUMN version,
+    DROP COLUMN tags;
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+ALTER TABLE aliases
+    ADD COLUMN version INTEGER DEFAULT 1 NOT NULL,
+    ADD COLUMN tags JSONB;
+
+CREATE TABLE IF NOT EXISTS alias_versions (
+    key TEXT NOT NULL,
+    registry_name TEXT NOT NULL,
+    version INTEGER NOT NULL,
+    value TEXT NOT NULL,
+    tags JSONB,
+    created_by TEXT,
+    created_at TIMESTAMPTZ DEFAULT (now() at time zone 'utc') NOT NULL,
+    PRIMARY KEY (key, registry_name, version),
+    FOREIGN KEY (key, registry_name) REFERENCES aliases (key, registry_name) ON DELETE CASCADE
+);
+
+-- The current value of the existing aliases is their first version
+INSERT INTO alias_versions (key, registry_name, version, value, created_by, created_at)
+    SELECT key, registry_name, version, value, updated_by, updated_at FROM aliases;
+
+COMMIT;
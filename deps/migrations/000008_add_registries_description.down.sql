@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP INDEX IF EXISTS aliases_registry_name_key_idx;
+
+ALTER TABLE registries
+    DROP COLUMN description;
+
+COMMIT;
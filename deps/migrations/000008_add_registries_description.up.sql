@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE registries
+    ADD COLUMN description TEXT;
+
+CREATE INDEX IF NOT EXISTS aliases_registry_name_key_idx ON aliases (registry_name, key text_pattern_ops);
+
+COMMIT;
@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE keys
+    DROP COLUMN exportable;
+
+ALTER TABLE eth_accounts
+    DROP COLUMN exportable;
+
+COMMIT;
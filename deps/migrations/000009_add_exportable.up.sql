@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE keys
+    ADD COLUMN exportable BOOLEAN default false;
+
+ALTER TABLE eth_accounts
+    ADD COLUMN exportable BOOLEAN default false;
+
+COMMIT;
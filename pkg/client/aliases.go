@@ -47,6 +47,40 @@ func (c *HTTPClient) GetAlias(ctx context.Context, registry types.RegistryName,
 	return &a, nil
 }
 
+// GetAliasVersion gets a version of an alias from the registry.
+func (c *HTTPClient) GetAliasVersion(ctx context.Context, registry types.RegistryName, aliasKey types.AliasKey, version int) (*types.AliasResponse, error) {
+	url := fmt.Sprintf(aliasPathf+"?version=%d", c.config.URL, registry, aliasKey, version)
+	resp, err := getRequest(ctx, c.client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var a types.AliasResponse
+	err = parseResponse(resp, &a)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// ListAliasVersions lists all the versions of an alias from the registry.
+func (c *HTTPClient) ListAliasVersions(ctx context.Context, registry types.RegistryName, aliasKey types.AliasKey) ([]types.AliasResponse, error) {
+	url := fmt.Sprintf(aliasPathf+"/versions", c.config.URL, registry, aliasKey)
+	resp, err := getRequest(ctx, c.client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var a []types.AliasResponse
+	err = parseResponse(resp, &a)
+	if err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
 // UpdateAlias updates an alias in the registry.
 func (c *HTTPClient) UpdateAlias(ctx context.Context, registry types.RegistryName, aliasKey types.AliasKey, req types.AliasRequest) (*types.AliasResponse, error) {
 	url := fmt.Sprintf(aliasPathf, c.config.URL, registry, aliasKey)
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 )
 
@@ -24,6 +25,9 @@ type KeysClient interface {
 	CreateKey(ctx context.Context, storeName, id string, request *types.CreateKeyRequest) (*types.KeyResponse, error)
 	ImportKey(ctx context.Context, storeName, id string, request *types.ImportKeyRequest) (*types.KeyResponse, error)
 	SignKey(ctx context.Context, storeName, id string, request *types.SignBase64PayloadRequest) (string, error)
+	ExportKey(ctx context.Context, storeName, id string, request *types.ExportKeyRequest) (string, error)
+	SignJWT(ctx context.Context, storeName, id string, request *types.SignJWTRequest) (string, error)
+	GetJWKS(ctx context.Context, storeName string) (*jwt.JWKsResponse, error)
 	GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error)
 	ListKeys(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	DeleteKey(ctx context.Context, storeName, id string) error
@@ -42,6 +46,7 @@ type EthClient interface {
 	SignTransaction(ctx context.Context, storeName, address string, request *types.SignETHTransactionRequest) (string, error)
 	SignQuorumPrivateTransaction(ctx context.Context, storeName, address string, request *types.SignQuorumPrivateTransactionRequest) (string, error)
 	SignEEATransaction(ctx context.Context, storeName, address string, request *types.SignEEATransactionRequest) (string, error)
+	ExportEthAccount(ctx context.Context, storeName, address string, request *types.ExportEthAccountRequest) (string, error)
 	GetEthAccount(ctx context.Context, storeName, address string) (*types.EthAccountResponse, error)
 	ListEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
 	ListDeletedEthAccounts(ctx context.Context, storeName string, limit, page uint64) ([]string, error)
@@ -1,7 +1,10 @@
 package client
 
 import (
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
 )
 
 // ResponseError is the error returned from the client when Key Manager responds with an error or
@@ -28,3 +31,46 @@ type ErrorResponse struct {
 func (r *ResponseError) Error() string {
 	return fmt.Sprintf("Error making API request.\nCode: %s. %s:\nStatus: %d.", r.ErrorCode, r.Message, r.StatusCode)
 }
+
+// StatusCode returns the HTTP status code of a response error, 0 if err is not a response error
+func StatusCode(err error) int {
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		return respErr.StatusCode
+	}
+
+	return 0
+}
+
+// IsNotFoundError indicates whether the key manager could not find the requested resource
+func IsNotFoundError(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+// IsUnauthorizedError indicates whether the request was not authenticated
+func IsUnauthorizedError(err error) bool {
+	return StatusCode(err) == http.StatusUnauthorized
+}
+
+// IsForbiddenError indicates whether the user is not allowed to perform the request
+func IsForbiddenError(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+// IsRetryableError indicates whether a request may succeed if sent again,
+// on rate limiting, unavailability of the key manager or its dependencies and connection errors
+func IsRetryableError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	switch StatusCode(err) {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	case 0:
+		var netErr net.Error
+		return errors.As(err, &netErr)
+	default:
+		return false
+	}
+}
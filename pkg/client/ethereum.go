@@ -71,6 +71,17 @@ func (c *HTTPClient) SignMessage(ctx context.Context, storeName, address string,
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) ExportEthAccount(ctx context.Context, storeName, address string, req *types.ExportEthAccountRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/export", withURLStore(c.config.URL, storeName), ethPath, address)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
 func (c *HTTPClient) SignTypedData(ctx context.Context, storeName, address string, req *types.SignTypedDataRequest) (string, error) {
 	reqURL := fmt.Sprintf("%s/%s/%s/sign-typed-data", withURLStore(c.config.URL, storeName), ethPath, address)
 	response, err := postRequest(ctx, c.client, reqURL, req)
@@ -0,0 +1,52 @@
+package ethsigner
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+)
+
+const (
+	DefaultMaxAttempts   = 3
+	DefaultRetryInterval = 500 * time.Millisecond
+)
+
+// RetryConfig configures the retries of the requests to the key manager failing with a retryable error
+type RetryConfig struct {
+	// MaxAttempts is the maximum number of times a request is sent, requests are not retried if 1
+	MaxAttempts int
+
+	// Interval is the delay before the first retry, doubled on each retry
+	Interval time.Duration
+}
+
+func (cfg *RetryConfig) SetDefault() *RetryConfig {
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultRetryInterval
+	}
+
+	return cfg
+}
+
+// retry calls f until it succeeds, fails with a non retryable error or the maximum number of attempts is reached
+func retry(ctx context.Context, cfg *RetryConfig, f func() error) error {
+	interval := cfg.Interval
+	for attempt := 1; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= cfg.MaxAttempts || !client.IsRetryableError(err) {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(interval):
+		}
+		interval *= 2
+	}
+}
@@ -0,0 +1,157 @@
+package ethsigner
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	apitypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrUnsupportedTxType is returned when signing a transaction of a type the key manager does not support
+	ErrUnsupportedTxType = errors.New("unsupported transaction type")
+
+	// ErrMissingChainID is returned when signing a transaction with a signer created without chain ID
+	ErrMissingChainID = errors.New("missing chain ID")
+
+	// ErrInvalidSignedTx is returned when the key manager responds with a transaction that was not signed by the account
+	ErrInvalidSignedTx = errors.New("invalid signed transaction")
+)
+
+// Signer signs transactions with an Ethereum account of a key manager store.
+// It is a types.Signer for its chain so it can be used wherever go-ethereum expects one
+type Signer struct {
+	types.Signer
+
+	client    client.EthClient
+	storeName string
+	address   common.Address
+	retry     *RetryConfig
+}
+
+var _ types.Signer = &Signer{}
+
+// NewSigner creates a Signer for the account with the given address of a store
+func NewSigner(c client.EthClient, storeName string, address common.Address, chainID *big.Int) *Signer {
+	return &Signer{
+		Signer:    types.LatestSignerForChainID(chainID),
+		client:    c,
+		storeName: storeName,
+		address:   address,
+		retry:     new(RetryConfig).SetDefault(),
+	}
+}
+
+// WithRetry sets the retries of the signing requests
+func (s *Signer) WithRetry(cfg *RetryConfig) *Signer {
+	s.retry = cfg.SetDefault()
+	return s
+}
+
+// Address returns the address of the account
+func (s *Signer) Address() common.Address {
+	return s.address
+}
+
+// SignTx signs a transaction through the key manager and returns the signed transaction
+func (s *Signer) SignTx(ctx context.Context, tx *types.Transaction) (*types.Transaction, error) {
+	req, err := s.signRequest(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	var raw string
+	err = retry(ctx, s.retry, func() error {
+		var signErr error
+		raw, signErr = s.client.SignTransaction(ctx, s.storeName, s.address.Hex(), req)
+		return signErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := hexutil.Decode(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignedTx, err)
+	}
+
+	signedTx := new(types.Transaction)
+	err = signedTx.UnmarshalBinary(b)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignedTx, err)
+	}
+
+	// The key manager must have signed the very same transaction with the account
+	sender, err := types.Sender(s.Signer, signedTx)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSignedTx, err)
+	}
+
+	if sender != s.address || s.Signer.Hash(signedTx) != s.Signer.Hash(tx) {
+		return nil, ErrInvalidSignedTx
+	}
+
+	return signedTx, nil
+}
+
+// SignerFn returns a bind.SignerFn signing transactions of the account through the key manager
+func (s *Signer) SignerFn(ctx context.Context) bind.SignerFn {
+	return func(address common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if address != s.address {
+			return nil, bind.ErrNotAuthorized
+		}
+
+		return s.SignTx(ctx, tx)
+	}
+}
+
+func (s *Signer) signRequest(tx *types.Transaction) (*apitypes.SignETHTransactionRequest, error) {
+	// The key manager only signs replay-protected transactions
+	if s.Signer.ChainID() == nil {
+		return nil, ErrMissingChainID
+	}
+
+	req := &apitypes.SignETHTransactionRequest{
+		Nonce:    hexutil.Uint64(tx.Nonce()),
+		To:       tx.To(),
+		Value:    hexutil.Big(*tx.Value()),
+		GasLimit: hexutil.Uint64(tx.Gas()),
+		Data:     tx.Data(),
+		ChainID:  hexutil.Big(*s.Signer.ChainID()),
+	}
+
+	switch tx.Type() {
+	case types.LegacyTxType:
+		req.TransactionType = apitypes.LegacyTxType
+		req.GasPrice = hexutil.Big(*tx.GasPrice())
+	case types.AccessListTxType:
+		req.TransactionType = apitypes.AccessListTxType
+		req.GasPrice = hexutil.Big(*tx.GasPrice())
+		req.AccessList = tx.AccessList()
+	case types.DynamicFeeTxType:
+		req.TransactionType = apitypes.DynamicFeeTxType
+		req.GasFeeCap = (*hexutil.Big)(tx.GasFeeCap())
+		req.GasTipCap = (*hexutil.Big)(tx.GasTipCap())
+		req.AccessList = tx.AccessList()
+	default:
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedTxType, tx.Type())
+	}
+
+	return req, nil
+}
+
+// NewTransactor creates the options to transact with contract bindings generated by abigen, signing through the key manager
+func NewTransactor(ctx context.Context, s *Signer) *bind.TransactOpts {
+	return &bind.TransactOpts{
+		From:    s.address,
+		Signer:  s.SignerFn(ctx),
+		Context: ctx,
+	}
+}
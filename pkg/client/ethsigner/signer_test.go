@@ -0,0 +1,155 @@
+package ethsigner
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/pkg/client/mock"
+	apitypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const storeName = "eth-store"
+
+var chainID = big.NewInt(1337)
+
+// signLocally returns the raw transaction the key manager would return when signing tx with key
+func signLocally(t *testing.T, tx *types.Transaction, key string) string {
+	privKey, err := crypto.HexToECDSA(key)
+	require.NoError(t, err)
+
+	signedTx, err := types.SignTx(tx, types.LatestSignerForChainID(chainID), privKey)
+	require.NoError(t, err)
+
+	b, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+
+	return hexutil.Encode(b)
+}
+
+const (
+	accountKey = "db337ca3295e4050586793f252e641f3b3a83739018fa4cce01b1e4c5a6f7e95"
+	otherKey   = "56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e2e"
+)
+
+func accountAddress(t *testing.T) common.Address {
+	privKey, err := crypto.HexToECDSA(accountKey)
+	require.NoError(t, err)
+	return crypto.PubkeyToAddress(privKey.PublicKey)
+}
+
+func TestSigner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ethClient := mock.NewMockEthClient(ctrl)
+	address := accountAddress(t)
+	signer := NewSigner(ethClient, storeName, address, chainID).WithRetry(&RetryConfig{MaxAttempts: 2, Interval: time.Millisecond})
+	ctx := context.Background()
+	to := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+
+	t.Run("should sign a dynamic fee transaction", func(t *testing.T) {
+		tx := types.NewTx(&types.DynamicFeeTx{
+			ChainID:   chainID,
+			Nonce:     1,
+			GasTipCap: big.NewInt(1),
+			GasFeeCap: big.NewInt(10),
+			Gas:       21000,
+			To:        &to,
+			Value:     big.NewInt(5),
+		})
+
+		ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), &apitypes.SignETHTransactionRequest{
+			TransactionType: apitypes.DynamicFeeTxType,
+			Nonce:           1,
+			To:              &to,
+			Value:           hexutil.Big(*big.NewInt(5)),
+			GasLimit:        21000,
+			ChainID:         hexutil.Big(*chainID),
+			GasFeeCap:       (*hexutil.Big)(big.NewInt(10)),
+			GasTipCap:       (*hexutil.Big)(big.NewInt(1)),
+			AccessList:      types.AccessList{},
+		}).Return(signLocally(t, tx, accountKey), nil)
+
+		signedTx, err := signer.SignTx(ctx, tx)
+		require.NoError(t, err)
+
+		sender, err := types.Sender(signer, signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+		assert.Equal(t, signer.Hash(tx), signer.Hash(signedTx))
+	})
+
+	t.Run("should sign a legacy transaction through a bind.SignerFn", func(t *testing.T) {
+		tx := types.NewTransaction(2, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, req *apitypes.SignETHTransactionRequest) (string, error) {
+				assert.Equal(t, apitypes.LegacyTxType, req.TransactionType)
+				assert.Equal(t, big.NewInt(100), req.GasPrice.ToInt())
+				return signLocally(t, tx, accountKey), nil
+			})
+
+		opts := NewTransactor(ctx, signer)
+		assert.Equal(t, address, opts.From)
+
+		signedTx, err := opts.Signer(address, tx)
+		require.NoError(t, err)
+		assert.Equal(t, types.LegacyTxType, int(signedTx.Type()))
+	})
+
+	t.Run("should not sign for another address", func(t *testing.T) {
+		tx := types.NewTransaction(2, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		_, err := signer.SignerFn(ctx)(to, tx)
+		assert.Equal(t, bind.ErrNotAuthorized, err)
+	})
+
+	t.Run("should fail without chain ID", func(t *testing.T) {
+		tx := types.NewTransaction(2, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		_, err := NewSigner(ethClient, storeName, address, nil).SignTx(ctx, tx)
+		assert.ErrorIs(t, err, ErrMissingChainID)
+	})
+
+	t.Run("should retry retryable errors", func(t *testing.T) {
+		tx := types.NewTransaction(3, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		gomock.InOrder(
+			ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), gomock.Any()).Return("", &client.ResponseError{StatusCode: http.StatusTooManyRequests}),
+			ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), gomock.Any()).Return(signLocally(t, tx, accountKey), nil),
+		)
+
+		_, err := signer.SignTx(ctx, tx)
+		require.NoError(t, err)
+	})
+
+	t.Run("should return the response error without retrying other errors", func(t *testing.T) {
+		tx := types.NewTransaction(4, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), gomock.Any()).Return("", &client.ResponseError{StatusCode: http.StatusNotFound})
+
+		_, err := signer.SignTx(ctx, tx)
+		assert.True(t, client.IsNotFoundError(err))
+	})
+
+	t.Run("should fail if the transaction is signed by another account", func(t *testing.T) {
+		tx := types.NewTransaction(5, to, big.NewInt(0), 21000, big.NewInt(100), nil)
+
+		ethClient.EXPECT().SignTransaction(ctx, storeName, address.Hex(), gomock.Any()).Return(signLocally(t, tx, otherKey), nil)
+
+		_, err := signer.SignTx(ctx, tx)
+		assert.ErrorIs(t, err, ErrInvalidSignedTx)
+	})
+}
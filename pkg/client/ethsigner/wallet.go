@@ -0,0 +1,153 @@
+package ethsigner
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	apitypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// WalletScheme is the URL scheme of the key manager wallets
+const WalletScheme = "qkm"
+
+// accountsPageSize is the number of accounts fetched per request when listing the accounts of a wallet
+const accountsPageSize = 100
+
+// Wallet is an accounts.Wallet holding the Ethereum accounts of a key manager store.
+// Accounts are always unlocked as signing is authorized by the key manager, passphrases are ignored
+type Wallet struct {
+	client    client.EthClient
+	storeName string
+	retry     *RetryConfig
+}
+
+var _ accounts.Wallet = &Wallet{}
+
+// NewWallet creates a Wallet for a store
+func NewWallet(c client.EthClient, storeName string) *Wallet {
+	return &Wallet{
+		client:    c,
+		storeName: storeName,
+		retry:     new(RetryConfig).SetDefault(),
+	}
+}
+
+// WithRetry sets the retries of the requests of the wallet
+func (w *Wallet) WithRetry(cfg *RetryConfig) *Wallet {
+	w.retry = cfg.SetDefault()
+	return w
+}
+
+func (w *Wallet) URL() accounts.URL {
+	return accounts.URL{Scheme: WalletScheme, Path: w.storeName}
+}
+
+func (w *Wallet) Status() (string, error) {
+	return "Unlocked", nil
+}
+
+func (w *Wallet) Open(string) error {
+	return nil
+}
+
+func (w *Wallet) Close() error {
+	return nil
+}
+
+// Accounts lists the accounts of the store, the accounts listed before an error are returned if listing fails
+func (w *Wallet) Accounts() []accounts.Account {
+	ctx := context.Background()
+
+	var accs []accounts.Account
+	for page := uint64(0); ; page++ {
+		var addresses []string
+		err := retry(ctx, w.retry, func() error {
+			var listErr error
+			addresses, listErr = w.client.ListEthAccounts(ctx, w.storeName, accountsPageSize, page)
+			return listErr
+		})
+		if err != nil {
+			return accs
+		}
+
+		for _, addr := range addresses {
+			accs = append(accs, w.account(common.HexToAddress(addr)))
+		}
+
+		if len(addresses) < accountsPageSize {
+			return accs
+		}
+	}
+}
+
+func (w *Wallet) Contains(account accounts.Account) bool {
+	if account.URL != (accounts.URL{}) && account.URL != w.URL() {
+		return false
+	}
+
+	ctx := context.Background()
+	err := retry(ctx, w.retry, func() error {
+		_, getErr := w.client.GetEthAccount(ctx, w.storeName, account.Address.Hex())
+		return getErr
+	})
+
+	return err == nil
+}
+
+func (w *Wallet) Derive(accounts.DerivationPath, bool) (accounts.Account, error) {
+	return accounts.Account{}, accounts.ErrNotSupported
+}
+
+func (w *Wallet) SelfDerive([]accounts.DerivationPath, ethereum.ChainStateReader) {}
+
+// SignData signs plain text data only, see SignText
+func (w *Wallet) SignData(account accounts.Account, mimeType string, data []byte) ([]byte, error) {
+	if mimeType != accounts.MimetypeTextPlain {
+		return nil, accounts.ErrNotSupported
+	}
+
+	return w.SignText(account, data)
+}
+
+func (w *Wallet) SignDataWithPassphrase(account accounts.Account, _, mimeType string, data []byte) ([]byte, error) {
+	return w.SignData(account, mimeType, data)
+}
+
+// SignText signs the EIP-191 hash of text with the account
+func (w *Wallet) SignText(account accounts.Account, text []byte) ([]byte, error) {
+	ctx := context.Background()
+
+	var signature string
+	err := retry(ctx, w.retry, func() error {
+		var signErr error
+		signature, signErr = w.client.SignMessage(ctx, w.storeName, account.Address.Hex(), &apitypes.SignMessageRequest{Message: text})
+		return signErr
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return hexutil.Decode(signature)
+}
+
+func (w *Wallet) SignTextWithPassphrase(account accounts.Account, _ string, text []byte) ([]byte, error) {
+	return w.SignText(account, text)
+}
+
+func (w *Wallet) SignTx(account accounts.Account, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return NewSigner(w.client, w.storeName, account.Address, chainID).WithRetry(w.retry).SignTx(context.Background(), tx)
+}
+
+func (w *Wallet) SignTxWithPassphrase(account accounts.Account, _ string, tx *types.Transaction, chainID *big.Int) (*types.Transaction, error) {
+	return w.SignTx(account, tx, chainID)
+}
+
+func (w *Wallet) account(address common.Address) accounts.Account {
+	return accounts.Account{Address: address, URL: w.URL()}
+}
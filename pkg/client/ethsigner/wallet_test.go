@@ -0,0 +1,90 @@
+package ethsigner
+
+import (
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/client"
+	"github.com/consensys/quorum-key-manager/pkg/client/mock"
+	apitypes "github.com/consensys/quorum-key-manager/src/stores/api/types"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWallet(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ethClient := mock.NewMockEthClient(ctrl)
+	wallet := NewWallet(ethClient, storeName).WithRetry(&RetryConfig{MaxAttempts: 1})
+	address := accountAddress(t)
+	account := accounts.Account{Address: address}
+
+	t.Run("should list the accounts of all pages", func(t *testing.T) {
+		firstPage := make([]string, accountsPageSize)
+		for i := range firstPage {
+			firstPage[i] = common.BigToAddress(big.NewInt(int64(i + 1))).Hex()
+		}
+
+		gomock.InOrder(
+			ethClient.EXPECT().ListEthAccounts(gomock.Any(), storeName, uint64(accountsPageSize), uint64(0)).Return(firstPage, nil),
+			ethClient.EXPECT().ListEthAccounts(gomock.Any(), storeName, uint64(accountsPageSize), uint64(1)).Return([]string{address.Hex()}, nil),
+		)
+
+		accs := wallet.Accounts()
+		require.Len(t, accs, accountsPageSize+1)
+		assert.Equal(t, address, accs[accountsPageSize].Address)
+		assert.Equal(t, accounts.URL{Scheme: WalletScheme, Path: storeName}, accs[accountsPageSize].URL)
+	})
+
+	t.Run("should check whether the store contains an account", func(t *testing.T) {
+		ethClient.EXPECT().GetEthAccount(gomock.Any(), storeName, address.Hex()).Return(&apitypes.EthAccountResponse{}, nil)
+		assert.True(t, wallet.Contains(account))
+
+		ethClient.EXPECT().GetEthAccount(gomock.Any(), storeName, address.Hex()).Return(nil, &client.ResponseError{StatusCode: http.StatusNotFound})
+		assert.False(t, wallet.Contains(account))
+
+		assert.False(t, wallet.Contains(accounts.Account{Address: address, URL: accounts.URL{Scheme: WalletScheme, Path: "other-store"}}))
+	})
+
+	t.Run("should sign text", func(t *testing.T) {
+		ethClient.EXPECT().SignMessage(gomock.Any(), storeName, address.Hex(), &apitypes.SignMessageRequest{Message: []byte("my message")}).Return("0x0102", nil)
+
+		signature, err := wallet.SignData(account, accounts.MimetypeTextPlain, []byte("my message"))
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, signature)
+	})
+
+	t.Run("should not sign other data", func(t *testing.T) {
+		_, err := wallet.SignData(account, accounts.MimetypeTypedData, []byte("my message"))
+		assert.Equal(t, accounts.ErrNotSupported, err)
+
+		_, err = wallet.Derive(accounts.DefaultRootDerivationPath, false)
+		assert.Equal(t, accounts.ErrNotSupported, err)
+	})
+
+	t.Run("should sign transactions", func(t *testing.T) {
+		tx := types.NewTransaction(1, address, big.NewInt(0), 21000, big.NewInt(100), nil)
+		ethClient.EXPECT().SignTransaction(gomock.Any(), storeName, address.Hex(), gomock.Any()).Return(signLocally(t, tx, accountKey), nil)
+
+		signedTx, err := wallet.SignTxWithPassphrase(account, "ignored", tx, chainID)
+		require.NoError(t, err)
+
+		sender, err := types.Sender(types.LatestSignerForChainID(chainID), signedTx)
+		require.NoError(t, err)
+		assert.Equal(t, address, sender)
+	})
+
+	t.Run("should return the response error when signing fails", func(t *testing.T) {
+		ethClient.EXPECT().SignMessage(gomock.Any(), storeName, address.Hex(), gomock.Any()).Return("", &client.ResponseError{StatusCode: http.StatusForbidden})
+
+		_, err := wallet.SignText(account, []byte("my message"))
+		assert.True(t, client.IsForbiddenError(err), fmt.Sprintf("unexpected error %v", err))
+	})
+}
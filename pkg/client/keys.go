@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 )
 
@@ -54,6 +55,46 @@ func (c *HTTPClient) SignKey(ctx context.Context, storeName, id string, req *typ
 	return parseStringResponse(response)
 }
 
+func (c *HTTPClient) SignJWT(ctx context.Context, storeName, id string, req *types.SignJWTRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/sign-jwt", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
+func (c *HTTPClient) GetJWKS(ctx context.Context, storeName string) (*jwt.JWKsResponse, error) {
+	keySet := &jwt.JWKsResponse{}
+	reqURL := fmt.Sprintf("%s/jwks", withURLStore(c.config.URL, storeName))
+
+	response, err := getRequest(ctx, c.client, reqURL)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeResponse(response)
+	err = parseResponse(response, keySet)
+	if err != nil {
+		return nil, err
+	}
+
+	return keySet, nil
+}
+
+func (c *HTTPClient) ExportKey(ctx context.Context, storeName, id string, req *types.ExportKeyRequest) (string, error) {
+	reqURL := fmt.Sprintf("%s/%s/%s/export", withURLStore(c.config.URL, storeName), keysPath, id)
+	response, err := postRequest(ctx, c.client, reqURL, req)
+	if err != nil {
+		return "", err
+	}
+
+	defer closeResponse(response)
+	return parseStringResponse(response)
+}
+
 func (c *HTTPClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	key := &types.KeyResponse{}
 	reqURL := fmt.Sprintf("%s/%s/%s", withURLStore(c.config.URL, storeName), keysPath, id)
@@ -7,6 +7,7 @@ package mock
 import (
 	context "context"
 	jsonrpc "github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	jwt "github.com/consensys/quorum-key-manager/pkg/jwt"
 	types "github.com/consensys/quorum-key-manager/src/stores/api/types"
 	gomock "github.com/golang/mock/gomock"
 	reflect "reflect"
@@ -220,6 +221,51 @@ func (mr *MockKeysClientMockRecorder) SignKey(ctx, storeName, id, request interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeysClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// ExportKey mocks base method
+func (m *MockKeysClient) ExportKey(ctx context.Context, storeName, id string, request *types.ExportKeyRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportKey indicates an expected call of ExportKey
+func (mr *MockKeysClientMockRecorder) ExportKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportKey", reflect.TypeOf((*MockKeysClient)(nil).ExportKey), ctx, storeName, id, request)
+}
+
+// SignJWT mocks base method
+func (m *MockKeysClient) SignJWT(ctx context.Context, storeName, id string, request *types.SignJWTRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignJWT", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignJWT indicates an expected call of SignJWT
+func (mr *MockKeysClientMockRecorder) SignJWT(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignJWT", reflect.TypeOf((*MockKeysClient)(nil).SignJWT), ctx, storeName, id, request)
+}
+
+// GetJWKS mocks base method
+func (m *MockKeysClient) GetJWKS(ctx context.Context, storeName string) (*jwt.JWKsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS", ctx, storeName)
+	ret0, _ := ret[0].(*jwt.JWKsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS
+func (mr *MockKeysClientMockRecorder) GetJWKS(ctx, storeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockKeysClient)(nil).GetJWKS), ctx, storeName)
+}
+
 // GetKey mocks base method
 func (m *MockKeysClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -465,6 +511,21 @@ func (mr *MockEthClientMockRecorder) SignEEATransaction(ctx, storeName, address,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockEthClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// ExportEthAccount mocks base method
+func (m *MockEthClient) ExportEthAccount(ctx context.Context, storeName, address string, request *types.ExportEthAccountRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEthAccount", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEthAccount indicates an expected call of ExportEthAccount
+func (mr *MockEthClientMockRecorder) ExportEthAccount(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEthAccount", reflect.TypeOf((*MockEthClient)(nil).ExportEthAccount), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockEthClient) GetEthAccount(ctx context.Context, storeName, address string) (*types.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -860,6 +921,51 @@ func (mr *MockKeyManagerClientMockRecorder) SignKey(ctx, storeName, id, request
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignKey", reflect.TypeOf((*MockKeyManagerClient)(nil).SignKey), ctx, storeName, id, request)
 }
 
+// ExportKey mocks base method
+func (m *MockKeyManagerClient) ExportKey(ctx context.Context, storeName, id string, request *types.ExportKeyRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportKey", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportKey indicates an expected call of ExportKey
+func (mr *MockKeyManagerClientMockRecorder) ExportKey(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportKey", reflect.TypeOf((*MockKeyManagerClient)(nil).ExportKey), ctx, storeName, id, request)
+}
+
+// SignJWT mocks base method
+func (m *MockKeyManagerClient) SignJWT(ctx context.Context, storeName, id string, request *types.SignJWTRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignJWT", ctx, storeName, id, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignJWT indicates an expected call of SignJWT
+func (mr *MockKeyManagerClientMockRecorder) SignJWT(ctx, storeName, id, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignJWT", reflect.TypeOf((*MockKeyManagerClient)(nil).SignJWT), ctx, storeName, id, request)
+}
+
+// GetJWKS mocks base method
+func (m *MockKeyManagerClient) GetJWKS(ctx context.Context, storeName string) (*jwt.JWKsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJWKS", ctx, storeName)
+	ret0, _ := ret[0].(*jwt.JWKsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJWKS indicates an expected call of GetJWKS
+func (mr *MockKeyManagerClientMockRecorder) GetJWKS(ctx, storeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJWKS", reflect.TypeOf((*MockKeyManagerClient)(nil).GetJWKS), ctx, storeName)
+}
+
 // GetKey mocks base method
 func (m *MockKeyManagerClient) GetKey(ctx context.Context, storeName, id string) (*types.KeyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1082,6 +1188,21 @@ func (mr *MockKeyManagerClientMockRecorder) SignEEATransaction(ctx, storeName, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignEEATransaction", reflect.TypeOf((*MockKeyManagerClient)(nil).SignEEATransaction), ctx, storeName, address, request)
 }
 
+// ExportEthAccount mocks base method
+func (m *MockKeyManagerClient) ExportEthAccount(ctx context.Context, storeName, address string, request *types.ExportEthAccountRequest) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExportEthAccount", ctx, storeName, address, request)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExportEthAccount indicates an expected call of ExportEthAccount
+func (mr *MockKeyManagerClientMockRecorder) ExportEthAccount(ctx, storeName, address, request interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExportEthAccount", reflect.TypeOf((*MockKeyManagerClient)(nil).ExportEthAccount), ctx, storeName, address, request)
+}
+
 // GetEthAccount mocks base method
 func (m *MockKeyManagerClient) GetEthAccount(ctx context.Context, storeName, address string) (*types.EthAccountResponse, error) {
 	m.ctrl.T.Helper()
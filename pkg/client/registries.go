@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+)
+
+const registriesPathf = "%s/registries"
+
+// ListRegistries lists the registries whose name starts with the prefix.
+func (c *HTTPClient) ListRegistries(ctx context.Context, prefix string, limit, page uint64) ([]types.RegistryResponse, error) {
+	reqURL, _ := url.Parse(fmt.Sprintf(registriesPathf, c.config.URL))
+	values := url.Values{}
+	if prefix != "" {
+		values.Set("prefix", prefix)
+	}
+	if limit != 0 {
+		values.Set("limit", fmt.Sprintf("%d", limit))
+	}
+	if page != 0 {
+		values.Set("page", fmt.Sprintf("%d", page))
+	}
+	reqURL.RawQuery = values.Encode()
+
+	resp, err := getRequest(ctx, c.client, reqURL.String())
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var pageRes struct {
+		Data []types.RegistryResponse `json:"data"`
+	}
+	err = parseResponse(resp, &pageRes)
+	if err != nil {
+		return nil, err
+	}
+	return pageRes.Data, nil
+}
+
+// CreateRegistry creates an empty registry.
+func (c *HTTPClient) CreateRegistry(ctx context.Context, registry types.RegistryName, req *types.CreateRegistryRequest) (*types.RegistryResponse, error) {
+	url := fmt.Sprintf(registryPathf, c.config.URL, registry)
+	resp, err := postRequest(ctx, c.client, url, req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var r types.RegistryResponse
+	err = parseResponse(resp, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// GetRegistry gets a registry.
+func (c *HTTPClient) GetRegistry(ctx context.Context, registry types.RegistryName) (*types.RegistryResponse, error) {
+	url := fmt.Sprintf(registryPathf, c.config.URL, registry)
+	resp, err := getRequest(ctx, c.client, url)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var r types.RegistryResponse
+	err = parseResponse(resp, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// UpdateRegistry updates the metadata of a registry.
+func (c *HTTPClient) UpdateRegistry(ctx context.Context, registry types.RegistryName, req *types.UpdateRegistryRequest) (*types.RegistryResponse, error) {
+	url := fmt.Sprintf(registryPathf, c.config.URL, registry)
+	resp, err := patchRequest(ctx, c.client, url, req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var r types.RegistryResponse
+	err = parseResponse(resp, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
+
+// ImportAliases replaces the aliases of a registry, the changes are only computed if dryRun is set.
+func (c *HTTPClient) ImportAliases(ctx context.Context, registry types.RegistryName, req *types.ImportAliasesRequest, dryRun bool) (*types.ImportAliasesResponse, error) {
+	url := fmt.Sprintf(aliasesPathf, c.config.URL, registry)
+	if dryRun {
+		url += "?dry_run=true"
+	}
+	resp, err := putRequest(ctx, c.client, url, req)
+	if err != nil {
+		return nil, err
+	}
+	defer closeResponse(resp)
+
+	var r types.ImportAliasesResponse
+	err = parseResponse(resp, &r)
+	if err != nil {
+		return nil, err
+	}
+	return &r, nil
+}
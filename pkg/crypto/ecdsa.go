@@ -1,12 +1,28 @@
 package crypto
 
 import (
+	"bytes"
 	"crypto/ecdsa"
+	"encoding/asn1"
+	"errors"
+	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
+const ecdsaSignatureLength = 64
+
+var (
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+// ecdsaSignature is the ASN.1 ECDSA-Sig-Value structure (RFC 3279)
+type ecdsaSignature struct {
+	R, S *big.Int
+}
+
 func VerifyECDSASignature(publicKey, message, signature []byte) (bool, error) {
 	pubKey, err := crypto.UnmarshalPubkey(publicKey)
 	if err != nil {
@@ -18,3 +34,54 @@ func VerifyECDSASignature(publicKey, message, signature []byte) (bool, error) {
 
 	return ecdsa.Verify(pubKey, message, r, s), nil
 }
+
+// NormalizeECDSASignatureLowS returns the R || S signature with S in the lower half of the Secp256k1 order,
+// as required by Ethereum (EIP-2) and Bitcoin (BIP-62)
+func NormalizeECDSASignatureLowS(signature []byte) ([]byte, error) {
+	if len(signature) != ecdsaSignatureLength {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d, expected %d", len(signature), ecdsaSignatureLength)
+	}
+
+	s := new(big.Int).SetBytes(signature[32:])
+	if s.Cmp(secp256k1HalfN) <= 0 {
+		return signature, nil
+	}
+
+	normalized := make([]byte, ecdsaSignatureLength)
+	copy(normalized, signature[:32])
+	new(big.Int).Sub(secp256k1N, s).FillBytes(normalized[32:])
+
+	return normalized, nil
+}
+
+// MarshalECDSASignatureDER encodes a R || S signature as an ASN.1 DER ECDSA-Sig-Value
+func MarshalECDSASignatureDER(signature []byte) ([]byte, error) {
+	if len(signature) != ecdsaSignatureLength {
+		return nil, fmt.Errorf("invalid ECDSA signature length %d, expected %d", len(signature), ecdsaSignatureLength)
+	}
+
+	return asn1.Marshal(ecdsaSignature{
+		R: new(big.Int).SetBytes(signature[:32]),
+		S: new(big.Int).SetBytes(signature[32:]),
+	})
+}
+
+// ECDSARecoveryID returns the recovery ID (0 or 1) of the R || S signature of the digest by the uncompressed public key
+func ECDSARecoveryID(digest, signature, publicKey []byte) (byte, error) {
+	if len(signature) != ecdsaSignatureLength {
+		return 0, fmt.Errorf("invalid ECDSA signature length %d, expected %d", len(signature), ecdsaSignatureLength)
+	}
+
+	for _, recID := range []byte{0, 1} {
+		recoveredPubKey, err := crypto.Ecrecover(digest, append(append([]byte{}, signature...), recID))
+		if err != nil {
+			return 0, err
+		}
+
+		if bytes.Equal(recoveredPubKey, publicKey) {
+			return recID, nil
+		}
+	}
+
+	return 0, errors.New("signature does not match the public key")
+}
@@ -0,0 +1,70 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestECDSASignature(t *testing.T) {
+	privKey, err := crypto.HexToECDSA("56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e2e")
+	require.NoError(t, err)
+	digest := crypto.Keccak256([]byte("my data to sign"))
+	sig, err := crypto.Sign(digest, privKey)
+	require.NoError(t, err)
+	signature := sig[:64]
+
+	highS := append([]byte{}, signature...)
+	new(big.Int).Sub(secp256k1N, new(big.Int).SetBytes(signature[32:])).FillBytes(highS[32:])
+
+	t.Run("should normalize a high S signature", func(t *testing.T) {
+		normalized, err := NormalizeECDSASignatureLowS(highS)
+
+		require.NoError(t, err)
+		assert.Equal(t, signature, normalized)
+	})
+
+	t.Run("should keep a low S signature", func(t *testing.T) {
+		normalized, err := NormalizeECDSASignatureLowS(signature)
+
+		require.NoError(t, err)
+		assert.Equal(t, signature, normalized)
+	})
+
+	t.Run("should marshal a signature as DER", func(t *testing.T) {
+		der, err := MarshalECDSASignatureDER(signature)
+		require.NoError(t, err)
+
+		sig := &ecdsaSignature{}
+		_, err = asn1.Unmarshal(der, sig)
+		require.NoError(t, err)
+		assert.True(t, ecdsa.Verify(&privKey.PublicKey, digest, sig.R, sig.S))
+	})
+
+	t.Run("should find the recovery ID of a signature", func(t *testing.T) {
+		recID, err := ECDSARecoveryID(digest, signature, crypto.FromECDSAPub(&privKey.PublicKey))
+
+		require.NoError(t, err)
+		assert.Equal(t, sig[64], recID)
+	})
+
+	t.Run("should fail to find the recovery ID of a signature by another key", func(t *testing.T) {
+		otherKey, err := crypto.GenerateKey()
+		require.NoError(t, err)
+
+		_, err = ECDSARecoveryID(digest, signature, crypto.FromECDSAPub(&otherKey.PublicKey))
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with an invalid signature length", func(t *testing.T) {
+		_, err := MarshalECDSASignatureDER([]byte("invalid"))
+
+		assert.Error(t, err)
+	})
+}
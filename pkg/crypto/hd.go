@@ -0,0 +1,95 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// DefaultDerivationPath is the BIP-44 derivation path of the first Ethereum account
+const DefaultDerivationPath = "m/44'/60'/0'/0/0"
+
+var masterKeySeed = []byte("Bitcoin seed")
+
+// DeriveECDSAFromMnemonic derives a Secp256k1 private key from a BIP-39 mnemonic, its optional passphrase
+// and a BIP-32 derivation path (DefaultDerivationPath if empty)
+func DeriveECDSAFromMnemonic(mnemonic, passphrase, path string) (*ecdsa.PrivateKey, error) {
+	if path == "" {
+		path = DefaultDerivationPath
+	}
+
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, fmt.Errorf("invalid derivation path: %v", err)
+	}
+
+	mnemonic = strings.Join(strings.Fields(mnemonic), " ")
+	if _, err = bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return nil, fmt.Errorf("invalid BIP-39 mnemonic: %v", err)
+	}
+
+	mac := hmac.New(sha512.New, masterKeySeed)
+	_, _ = mac.Write(bip39.NewSeed(mnemonic, passphrase))
+	sum := mac.Sum(nil)
+	key, chainCode := new(big.Int).SetBytes(sum[:32]), sum[32:]
+	if key.Sign() == 0 || key.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, errors.New("invalid master key derived from mnemonic")
+	}
+
+	for _, index := range derivationPath {
+		key, chainCode, err = deriveChild(key, chainCode, index)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return crypto.ToECDSA(paddedBytes(key))
+}
+
+// deriveChild derives the BIP-32 child private key at index, indexes from 2^31 are hardened
+func deriveChild(key *big.Int, chainCode []byte, index uint32) (*big.Int, []byte, error) {
+	var data []byte
+	if index >= 0x80000000 {
+		data = append([]byte{0}, paddedBytes(key)...)
+	} else {
+		privKey, err := crypto.ToECDSA(paddedBytes(key))
+		if err != nil {
+			return nil, nil, err
+		}
+		data = crypto.CompressPubkey(&privKey.PublicKey)
+	}
+	data = append(data, make([]byte, 4)...)
+	binary.BigEndian.PutUint32(data[len(data)-4:], index)
+
+	mac := hmac.New(sha512.New, chainCode)
+	_, _ = mac.Write(data)
+	sum := mac.Sum(nil)
+
+	n := crypto.S256().Params().N
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(n) >= 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+
+	child := new(big.Int).Add(tweak, key)
+	child.Mod(child, n)
+	if child.Sign() == 0 {
+		return nil, nil, fmt.Errorf("invalid child key at index %d", index)
+	}
+
+	return child, sum[32:], nil
+}
+
+func paddedBytes(i *big.Int) []byte {
+	b := make([]byte, 32)
+	return i.FillBytes(b)
+}
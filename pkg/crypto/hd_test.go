@@ -0,0 +1,48 @@
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "test test test test test test test test test test test junk"
+
+func TestDeriveECDSAFromMnemonic(t *testing.T) {
+	t.Run("should derive the first account with the default derivation path", func(t *testing.T) {
+		privKey, err := DeriveECDSAFromMnemonic(testMnemonic, "", "")
+
+		require.NoError(t, err)
+		assert.Equal(t, "0xac0974bec39a17e36ba4a6b4d238ff944bacb478cbed5efcae784d7bf4f2ff80", hexutil.Encode(crypto.FromECDSA(privKey)))
+		assert.Equal(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+	})
+
+	t.Run("should derive an account with a custom derivation path", func(t *testing.T) {
+		privKey, err := DeriveECDSAFromMnemonic(testMnemonic, "", "m/44'/60'/0'/0/1")
+
+		require.NoError(t, err)
+		assert.Equal(t, "0x70997970C51812dc3A010C7d01b50e0d17dc79C8", crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+	})
+
+	t.Run("should derive a different account with a passphrase", func(t *testing.T) {
+		privKey, err := DeriveECDSAFromMnemonic(testMnemonic, "my-passphrase", "")
+
+		require.NoError(t, err)
+		assert.NotEqual(t, "0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266", crypto.PubkeyToAddress(privKey.PublicKey).Hex())
+	})
+
+	t.Run("should fail with an invalid mnemonic", func(t *testing.T) {
+		_, err := DeriveECDSAFromMnemonic("test test test test test test test test test test test wrong", "", "")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail with an invalid derivation path", func(t *testing.T) {
+		_, err := DeriveECDSAFromMnemonic(testMnemonic, "", "m/44'/invalid")
+
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,105 @@
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+)
+
+// Bounds of the KDF parameters of a keystore given by a user, the standard and light parameters of go-ethereum
+// and other wallets are within them. n bounds the memory and n * p the work of scrypt
+const (
+	maxScryptN    = 1 << 18
+	maxScryptNP   = 1 << 18
+	scryptR       = 8
+	keystoreDKLen = 32
+)
+
+type keystoreCrypto struct {
+	Crypto struct {
+		KDF       string                 `json:"kdf"`
+		KDFParams map[string]interface{} `json:"kdfparams"`
+	} `json:"crypto"`
+}
+
+// DecryptKeystore decrypts a keystore v3 JSON (Web3 Secret Storage) with its password.
+// The KDF parameters are checked first, so a keystore cannot make the decryption exhaust the memory or the CPU
+func DecryptKeystore(keystoreJSON []byte, password string) (*ecdsa.PrivateKey, error) {
+	ks := &keystoreCrypto{}
+	if err := json.Unmarshal(keystoreJSON, ks); err != nil {
+		return nil, fmt.Errorf("invalid keystore: %v", err)
+	}
+
+	if err := checkKDFParams(ks.Crypto.KDF, ks.Crypto.KDFParams); err != nil {
+		return nil, err
+	}
+
+	key, err := keystore.DecryptKey(keystoreJSON, password)
+	if err != nil {
+		return nil, err
+	}
+
+	return key.PrivateKey, nil
+}
+
+func checkKDFParams(kdf string, params map[string]interface{}) error {
+	if _, ok := params["salt"].(string); !ok {
+		return fmt.Errorf("invalid keystore KDF salt")
+	}
+
+	dkLen, err := kdfParam(params, "dklen")
+	if err != nil {
+		return err
+	}
+	if dkLen != keystoreDKLen {
+		return fmt.Errorf("invalid keystore KDF key length %d, expected %d", dkLen, keystoreDKLen)
+	}
+
+	switch kdf {
+	case "scrypt":
+		n, err := kdfParam(params, "n")
+		if err != nil {
+			return err
+		}
+		r, err := kdfParam(params, "r")
+		if err != nil {
+			return err
+		}
+		p, err := kdfParam(params, "p")
+		if err != nil {
+			return err
+		}
+
+		if n < 2 || n > maxScryptN || r != scryptR || p < 1 || n*p > maxScryptNP {
+			return fmt.Errorf("unsupported scrypt parameters (n=%d, r=%d, p=%d), expected n <= %d, r = %d and n * p <= %d", n, r, p, maxScryptN, scryptR, maxScryptNP)
+		}
+	case "pbkdf2":
+		c, err := kdfParam(params, "c")
+		if err != nil {
+			return err
+		}
+
+		if c < 1 || c > maxPBKDF2Iterations {
+			return fmt.Errorf("invalid PBKDF2 iteration count %d, must be between 1 and %d", c, maxPBKDF2Iterations)
+		}
+		if prf, _ := params["prf"].(string); prf != "hmac-sha256" {
+			return fmt.Errorf("unsupported PBKDF2 pseudo-random function %q, expected hmac-sha256", prf)
+		}
+	default:
+		return fmt.Errorf("unsupported keystore KDF %q", kdf)
+	}
+
+	return nil
+}
+
+// kdfParam returns an integer KDF parameter, JSON numbers are decoded as float64
+func kdfParam(params map[string]interface{}, name string) (int, error) {
+	v, ok := params[name].(float64)
+	if !ok || v != float64(int(v)) {
+		return 0, fmt.Errorf("invalid keystore KDF parameter %q", name)
+	}
+
+	return int(v), nil
+}
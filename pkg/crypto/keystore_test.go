@@ -0,0 +1,82 @@
+package crypto
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecryptKeystore(t *testing.T) {
+	privKey, err := crypto.HexToECDSA("56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e2e")
+	require.NoError(t, err)
+
+	key := &keystore.Key{Id: uuid.New(), Address: crypto.PubkeyToAddress(privKey.PublicKey), PrivateKey: privKey}
+	keystoreJSON, err := keystore.EncryptKey(key, "my-password", keystore.LightScryptN, keystore.LightScryptP)
+	require.NoError(t, err)
+
+	// withKDFParams returns the keystore with the given KDF parameters
+	withKDFParams := func(kdf string, params map[string]interface{}) []byte {
+		ks := map[string]interface{}{}
+		require.NoError(t, json.Unmarshal(keystoreJSON, &ks))
+
+		cryptoSection := ks["crypto"].(map[string]interface{})
+		kdfParams := cryptoSection["kdfparams"].(map[string]interface{})
+		for k, v := range params {
+			kdfParams[k] = v
+		}
+		cryptoSection["kdf"] = kdf
+
+		b, err := json.Marshal(ks)
+		require.NoError(t, err)
+		return b
+	}
+
+	t.Run("should decrypt a keystore", func(t *testing.T) {
+		decrypted, err := DecryptKeystore(keystoreJSON, "my-password")
+
+		require.NoError(t, err)
+		assert.Equal(t, crypto.FromECDSA(privKey), crypto.FromECDSA(decrypted))
+	})
+
+	t.Run("should fail with an invalid password", func(t *testing.T) {
+		_, err := DecryptKeystore(keystoreJSON, "wrong-password")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject scrypt parameters exhausting the memory", func(t *testing.T) {
+		_, err := DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"n": 1 << 30, "r": 8}), "my-password")
+		assert.Error(t, err)
+
+		_, err = DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"r": 1 << 20}), "my-password")
+		assert.Error(t, err)
+
+		_, err = DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"n": 1 << 18, "p": 1 << 10}), "my-password")
+		assert.Error(t, err)
+
+		_, err = DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"dklen": 16}), "my-password")
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject a PBKDF2 iteration count exhausting the CPU", func(t *testing.T) {
+		_, err := DecryptKeystore(withKDFParams("pbkdf2", map[string]interface{}{"c": 1 << 40, "prf": "hmac-sha256"}), "my-password")
+
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject invalid KDF parameters", func(t *testing.T) {
+		_, err := DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"salt": 12}), "my-password")
+		assert.Error(t, err)
+
+		_, err = DecryptKeystore(withKDFParams("scrypt", map[string]interface{}{"n": "4096"}), "my-password")
+		assert.Error(t, err)
+
+		_, err = DecryptKeystore(withKDFParams("argon2", nil), "my-password")
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,282 @@
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+const (
+	PrivateKeyPEMType          = "PRIVATE KEY"
+	EncryptedPrivateKeyPEMType = "ENCRYPTED PRIVATE KEY"
+	ECPrivateKeyPEMType        = "EC PRIVATE KEY"
+
+	pbkdf2Iterations = 600000
+	saltLength       = 16
+
+	// maxPBKDF2Iterations bounds the work done to decrypt a key given by a user
+	maxPBKDF2Iterations = 1000000
+)
+
+var (
+	oidPublicKeyECDSA = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidSecp256k1      = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+// privateKeyInfo is the PKCS#8 PrivateKeyInfo structure (RFC 5208)
+type privateKeyInfo struct {
+	Version    int
+	Algo       pkix.AlgorithmIdentifier
+	PrivateKey []byte
+}
+
+// ecPrivateKey is the SEC 1 ECPrivateKey structure (RFC 5915)
+type ecPrivateKey struct {
+	Version       int
+	PrivateKey    []byte
+	NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+	PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+}
+
+// encryptedPrivateKeyInfo is the PKCS#8 EncryptedPrivateKeyInfo structure (RFC 5208)
+type encryptedPrivateKeyInfo struct {
+	Algo          pkix.AlgorithmIdentifier
+	EncryptedData []byte
+}
+
+type pbes2Params struct {
+	KeyDerivationFunc pkix.AlgorithmIdentifier
+	EncryptionScheme  pkix.AlgorithmIdentifier
+}
+
+type pbkdf2Params struct {
+	Salt           []byte
+	IterationCount int
+	KeyLength      int                      `asn1:"optional"`
+	PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+}
+
+// MarshalECDSAPKCS8 encodes a Secp256k1 private key as an unencrypted PKCS#8 DER document
+func MarshalECDSAPKCS8(privKey *ecdsa.PrivateKey) ([]byte, error) {
+	curveParams, err := asn1.Marshal(oidSecp256k1)
+	if err != nil {
+		return nil, err
+	}
+
+	ecKey, err := asn1.Marshal(ecPrivateKey{
+		Version:    1,
+		PrivateKey: crypto.FromECDSA(privKey),
+		PublicKey:  asn1.BitString{Bytes: crypto.FromECDSAPub(&privKey.PublicKey), BitLength: 8 * 65},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return asn1.Marshal(privateKeyInfo{
+		Algo: pkix.AlgorithmIdentifier{
+			Algorithm:  oidPublicKeyECDSA,
+			Parameters: asn1.RawValue{FullBytes: curveParams},
+		},
+		PrivateKey: ecKey,
+	})
+}
+
+// ParseECDSAPKCS8 decodes a Secp256k1 private key from an unencrypted PKCS#8 DER document
+func ParseECDSAPKCS8(der []byte) (*ecdsa.PrivateKey, error) {
+	info := &privateKeyInfo{}
+	if _, err := asn1.Unmarshal(der, info); err != nil {
+		return nil, fmt.Errorf("invalid PKCS#8 private key: %v", err)
+	}
+
+	if !info.Algo.Algorithm.Equal(oidPublicKeyECDSA) {
+		return nil, fmt.Errorf("unsupported PKCS#8 private key algorithm %s", info.Algo.Algorithm)
+	}
+
+	curve := asn1.ObjectIdentifier{}
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &curve); err != nil || !curve.Equal(oidSecp256k1) {
+		return nil, errors.New("unsupported PKCS#8 elliptic curve, expected secp256k1")
+	}
+
+	return parseECPrivateKey(info.PrivateKey)
+}
+
+// ParseECDSAPEM decodes a Secp256k1 private key from a PKCS#8 ("PRIVATE KEY"), encrypted PKCS#8 ("ENCRYPTED PRIVATE KEY")
+// or SEC 1 ("EC PRIVATE KEY") PEM block. The passphrase is only used by encrypted blocks
+func ParseECDSAPEM(pemBlock []byte, passphrase string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode(pemBlock)
+	if block == nil {
+		return nil, errors.New("invalid PEM block")
+	}
+
+	switch block.Type {
+	case PrivateKeyPEMType:
+		return ParseECDSAPKCS8(block.Bytes)
+	case EncryptedPrivateKeyPEMType:
+		if passphrase == "" {
+			return nil, errors.New("passphrase is required to decrypt an encrypted private key")
+		}
+
+		der, err := DecryptPKCS8PEM(pemBlock, passphrase)
+		if err != nil {
+			return nil, err
+		}
+
+		return ParseECDSAPKCS8(der)
+	case ECPrivateKeyPEMType:
+		return parseECPrivateKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+}
+
+func parseECPrivateKey(der []byte) (*ecdsa.PrivateKey, error) {
+	ecKey := &ecPrivateKey{}
+	if _, err := asn1.Unmarshal(der, ecKey); err != nil {
+		return nil, fmt.Errorf("invalid EC private key: %v", err)
+	}
+
+	if len(ecKey.NamedCurveOID) > 0 && !ecKey.NamedCurveOID.Equal(oidSecp256k1) {
+		return nil, errors.New("unsupported EC private key curve, expected secp256k1")
+	}
+
+	return crypto.ToECDSA(ecKey.PrivateKey)
+}
+
+// EncryptPKCS8PEM encrypts a PKCS#8 DER document with a passphrase (PBES2 with PBKDF2-HMAC-SHA256 and AES-256-CBC)
+// and encodes it as an "ENCRYPTED PRIVATE KEY" PEM block
+func EncryptPKCS8PEM(der []byte, passphrase string) ([]byte, error) {
+	if passphrase == "" {
+		return nil, errors.New("passphrase must not be empty")
+	}
+
+	salt := make([]byte, saltLength)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(pbkdf2.Key([]byte(passphrase), salt, pbkdf2Iterations, 32, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	encrypted := append(append([]byte{}, der...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(encrypted, encrypted)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pbkdf2Iterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedDER, err := asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: encrypted,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: EncryptedPrivateKeyPEMType, Bytes: encryptedDER}), nil
+}
+
+// DecryptPKCS8PEM decrypts an "ENCRYPTED PRIVATE KEY" PEM block encrypted with PBES2 (PBKDF2-HMAC-SHA256 and AES-256-CBC)
+// and returns the PKCS#8 DER document
+func DecryptPKCS8PEM(pemBlock []byte, passphrase string) ([]byte, error) {
+	block, _ := pem.Decode(pemBlock)
+	if block == nil || block.Type != EncryptedPrivateKeyPEMType {
+		return nil, fmt.Errorf("expected a %q PEM block", EncryptedPrivateKeyPEMType)
+	}
+
+	info := &encryptedPrivateKeyInfo{}
+	if _, err := asn1.Unmarshal(block.Bytes, info); err != nil {
+		return nil, fmt.Errorf("invalid encrypted PKCS#8 private key: %v", err)
+	}
+
+	params := &pbes2Params{}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, fmt.Errorf("unsupported encryption algorithm %s, expected PBES2", info.Algo.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, params); err != nil {
+		return nil, fmt.Errorf("invalid PBES2 parameters: %v", err)
+	}
+
+	kdfParams := &pbkdf2Params{}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, fmt.Errorf("unsupported key derivation function %s, expected PBKDF2", params.KeyDerivationFunc.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, kdfParams); err != nil {
+		return nil, fmt.Errorf("invalid PBKDF2 parameters: %v", err)
+	}
+	if !kdfParams.PRF.Algorithm.Equal(oidHMACWithSHA256) {
+		return nil, fmt.Errorf("unsupported PBKDF2 pseudo-random function %s, expected HMAC-SHA256", kdfParams.PRF.Algorithm)
+	}
+	if kdfParams.IterationCount < 1 || kdfParams.IterationCount > maxPBKDF2Iterations {
+		return nil, fmt.Errorf("invalid PBKDF2 iteration count %d, must be between 1 and %d", kdfParams.IterationCount, maxPBKDF2Iterations)
+	}
+	if kdfParams.KeyLength != 0 && kdfParams.KeyLength != 32 {
+		return nil, fmt.Errorf("invalid PBKDF2 key length %d, expected 32", kdfParams.KeyLength)
+	}
+
+	var iv []byte
+	if !params.EncryptionScheme.Algorithm.Equal(oidAES256CBC) {
+		return nil, fmt.Errorf("unsupported encryption scheme %s, expected AES-256-CBC", params.EncryptionScheme.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil || len(iv) != aes.BlockSize {
+		return nil, errors.New("invalid AES-256-CBC initialization vector")
+	}
+
+	if len(info.EncryptedData) == 0 || len(info.EncryptedData)%aes.BlockSize != 0 {
+		return nil, errors.New("invalid encrypted data length")
+	}
+
+	cphr, err := aes.NewCipher(pbkdf2.Key([]byte(passphrase), kdfParams.Salt, kdfParams.IterationCount, 32, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+
+	der := make([]byte, len(info.EncryptedData))
+	cipher.NewCBCDecrypter(cphr, iv).CryptBlocks(der, info.EncryptedData)
+
+	padding := int(der[len(der)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(der[len(der)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.New("failed to decrypt private key, invalid passphrase")
+	}
+
+	return der[:len(der)-padding], nil
+}
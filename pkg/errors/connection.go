@@ -1,5 +1,7 @@
 package errors
 
+import "time"
+
 const (
 	Connection     = "CN000"
 	AKV            = "CN100"
@@ -16,6 +18,7 @@ const (
 	InvalidFormat    = "IR400"
 	InvalidParameter = "IR500"
 	Forbidden        = "IR600"
+	TooManyRequests  = "IR700"
 )
 
 // HashicorpVaultError is raised when failing to perform on Hashicorp Vault
@@ -91,6 +94,17 @@ func IsForbiddenError(err error) bool {
 	return isErrorClass(FromError(err).GetCode(), Forbidden)
 }
 
+// TooManyRequestsError is raised when the user exceeds a rate limit
+func TooManyRequestsError(retryAfter time.Duration, format string, a ...interface{}) *Error {
+	err := Errorf(TooManyRequests, format, a...)
+	err.retryAfter = retryAfter
+	return err
+}
+
+func IsTooManyRequestsError(err error) bool {
+	return isErrorClass(FromError(err).GetCode(), TooManyRequests)
+}
+
 // NotSupportedError is raised when operation is not supported
 func NotSupportedError(format string, a ...interface{}) *Error {
 	return Errorf(NotSupported, format, a...)
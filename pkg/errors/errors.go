@@ -2,11 +2,13 @@ package errors
 
 import (
 	"fmt"
+	"time"
 )
 
 type Error struct {
-	Message string
-	Code    string
+	Message    string
+	Code       string
+	retryAfter time.Duration
 }
 
 func (e *Error) GetCode() string {
@@ -21,13 +23,18 @@ func (e *Error) GetMessage() string {
 	return e.Message
 }
 
+// GetRetryAfter returns the duration after which the failed operation can be retried, 0 if unknown
+func (e *Error) GetRetryAfter() time.Duration {
+	return e.retryAfter
+}
+
 func (e *Error) SetMessage(format string, args ...interface{}) *Error {
 	e.Message = fmt.Sprintf(format, args...)
 	return e
 }
 
 func Errorf(code, format string, a ...interface{}) *Error {
-	return &Error{fmt.Sprintf(format, a...), code}
+	return &Error{Message: fmt.Sprintf(format, a...), Code: code}
 }
 
 func FromError(err interface{}) *Error {
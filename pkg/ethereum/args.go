@@ -44,8 +44,8 @@ func (args *PrivateArgs) WithPrivateFor(pubKeys []string) *PrivateArgs {
 	return args
 }
 
-func (args *PrivateArgs) WithPrivateType(pubKey string) *PrivateArgs {
-	args.PrivateFrom = &pubKey
+func (args *PrivateArgs) WithPrivateType(privateType PrivateType) *PrivateArgs {
+	args.PrivateType = &privateType
 	return args
 }
 
@@ -61,6 +61,8 @@ func (args *PrivateArgs) WithPrivacyGroupID(id string) *PrivateArgs {
 
 // TODO: Delete usage of unnecessary pointers: https://app.zenhub.com/workspaces/orchestrate-5ea70772b186e10067f57842/issues/consensys/quorum-key-manager/96
 type SendTxMsg struct {
+	// Type is the EIP-2718 transaction type, inferred from the other fields when not set
+	Type       *uint64
 	From       ethcommon.Address
 	To         *ethcommon.Address
 	Gas        *uint64
@@ -83,7 +85,22 @@ func (msg *SendTxMsg) IsLegacy() bool {
 	return msg.GasPrice != nil
 }
 
-func (msg *SendTxMsg) TxData(txType int, chainID *big.Int) *types.Transaction {
+// TxType returns the EIP-2718 type of the transaction.
+// Without an explicit type, a transaction with a gas price is legacy, or access list if it carries one, and dynamic fee otherwise
+func (msg *SendTxMsg) TxType() uint64 {
+	switch {
+	case msg.Type != nil:
+		return *msg.Type
+	case msg.GasPrice != nil && msg.AccessList != nil:
+		return types.AccessListTxType
+	case msg.GasPrice != nil:
+		return types.LegacyTxType
+	default:
+		return types.DynamicFeeTxType
+	}
+}
+
+func (msg *SendTxMsg) TxData(txType uint64, chainID *big.Int) *types.Transaction {
 	var txData types.TxData
 
 	switch txType {
@@ -96,6 +113,17 @@ func (msg *SendTxMsg) TxData(txType int, chainID *big.Int) *types.Transaction {
 			Value:    msg.Value,
 			Data:     *msg.Data,
 		}
+	case types.AccessListTxType:
+		txData = &types.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      *msg.Nonce,
+			GasPrice:   msg.GasPrice,
+			Gas:        *msg.Gas,
+			To:         msg.To,
+			Value:      msg.Value,
+			Data:       *msg.Data,
+			AccessList: msg.AccessList,
+		}
 	case types.DynamicFeeTxType:
 		txData = &types.DynamicFeeTx{
 			ChainID:    chainID,
@@ -124,6 +152,7 @@ func (msg *SendTxMsg) TxDataQuorum() *quorumtypes.Transaction {
 
 // TODO: Delete usage of unnecessary pointers: https://app.zenhub.com/workspaces/orchestrate-5ea70772b186e10067f57842/issues/consensys/quorum-key-manager/96
 type jsonSendTxMsg struct {
+	Type       *hexutil.Uint64    `json:"type,omitempty"`
 	From       ethcommon.Address  `json:"from,omitempty"`
 	To         *ethcommon.Address `json:"to,omitempty"`
 	Gas        *hexutil.Uint64    `json:"gas,omitempty"`
@@ -147,6 +176,7 @@ func (msg *SendTxMsg) UnmarshalJSON(b []byte) error {
 	}
 
 	*msg = SendTxMsg{
+		Type:        (*uint64)(raw.Type),
 		From:        raw.From,
 		To:          raw.To,
 		Gas:         (*uint64)(raw.Gas),
@@ -169,6 +199,7 @@ func (msg *SendTxMsg) UnmarshalJSON(b []byte) error {
 
 func (msg *SendTxMsg) MarshalJSON() ([]byte, error) {
 	return json.Marshal(&jsonSendTxMsg{
+		Type:        (*hexutil.Uint64)(msg.Type),
 		From:        msg.From,
 		To:          msg.To,
 		Gas:         (*hexutil.Uint64)(msg.Gas),
@@ -6,11 +6,13 @@ import (
 	"testing"
 
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
 func assertSendTxMsgEquals(t *testing.T, expectedMsg, msg *SendTxMsg) {
+	assert.Equal(t, expectedMsg.Type, msg.Type, "Type should be correct")
 	assert.Equal(t, expectedMsg.From, msg.From, "From should be correct")
 	assert.Equal(t, expectedMsg.To, msg.To, "To should be correct")
 	assert.Equal(t, expectedMsg.Gas, msg.Gas, "Gas should be correct")
@@ -18,6 +20,9 @@ func assertSendTxMsgEquals(t *testing.T, expectedMsg, msg *SendTxMsg) {
 	assert.Equal(t, expectedMsg.Value, msg.Value, "Value should be correct")
 	assert.Equal(t, expectedMsg.Nonce, msg.Nonce, "Nonce should be correct")
 	assert.Equal(t, expectedMsg.Data, msg.Data, "Data should be correct")
+	assert.Equal(t, expectedMsg.GasFeeCap, msg.GasFeeCap, "GasFeeCap should be correct")
+	assert.Equal(t, expectedMsg.GasTipCap, msg.GasTipCap, "GasTipCap should be correct")
+	assert.Equal(t, expectedMsg.AccessList, msg.AccessList, "AccessList should be correct")
 	assert.Equal(t, expectedMsg.PrivateFrom, msg.PrivateFrom, "PrivateFrom should be correct")
 	assert.Equal(t, expectedMsg.PrivateFor, msg.PrivateFor, "PrivateFor should be correct")
 	assert.Equal(t, expectedMsg.PrivacyFlag, msg.PrivacyFlag, "PrivacyFlag should be correct")
@@ -33,6 +38,7 @@ func TestSendTxMsg(t *testing.T) {
 
 		expectedSendTxMsg SendTxMsg
 		expectedIsPrivate bool
+		expectedTxType    uint64
 
 		expectedErrMsg string
 	}{
@@ -48,6 +54,32 @@ func TestSendTxMsg(t *testing.T) {
 				Nonce:    func(i uint64) *uint64 { return &i }(15),
 				Data:     func(b []byte) *[]byte { return &b }(ethcommon.FromHex("0xabcdef")),
 			},
+			expectedTxType: types.LegacyTxType,
+		},
+		{
+			desc: "access list fields",
+			body: []byte(`{"from":"0xc94770007dda54cf92009bff0de90c06f603a09f","to":"0xfe3b557e8fb62b89f4916b721be55ceb828dbd73","gasPrice":"0x3e8","accessList":[{"address":"0xfe3b557e8fb62b89f4916b721be55ceb828dbd73","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}]}`),
+			expectedSendTxMsg: SendTxMsg{
+				From:     ethcommon.HexToAddress("0xc94770007dda54cf92009bff0de90c06f603a09f"),
+				To:       func(addr ethcommon.Address) *ethcommon.Address { return &addr }(ethcommon.HexToAddress("0xfe3b557e8fb62b89f4916b721be55ceb828dbd73")),
+				GasPrice: big.NewInt(1000),
+				AccessList: types.AccessList{{
+					Address:     ethcommon.HexToAddress("0xfe3b557e8fb62b89f4916b721be55ceb828dbd73"),
+					StorageKeys: []ethcommon.Hash{ethcommon.HexToHash("0x1")},
+				}},
+			},
+			expectedTxType: types.AccessListTxType,
+		},
+		{
+			desc: "explicit dynamic fee type",
+			body: []byte(`{"type":"0x2","from":"0xc94770007dda54cf92009bff0de90c06f603a09f","maxFeePerGas":"0x3e8","maxPriorityFeePerGas":"0x1"}`),
+			expectedSendTxMsg: SendTxMsg{
+				Type:      func(i uint64) *uint64 { return &i }(2),
+				From:      ethcommon.HexToAddress("0xc94770007dda54cf92009bff0de90c06f603a09f"),
+				GasFeeCap: big.NewInt(1000),
+				GasTipCap: big.NewInt(1),
+			},
+			expectedTxType: types.DynamicFeeTxType,
 		},
 		{
 			desc: "partial public and private fields",
@@ -64,6 +96,7 @@ func TestSendTxMsg(t *testing.T) {
 				},
 			},
 			expectedIsPrivate: true,
+			expectedTxType:    types.DynamicFeeTxType,
 		},
 	}
 
@@ -81,6 +114,8 @@ func TestSendTxMsg(t *testing.T) {
 					assert.False(t, msg.IsPrivate(), "IsPrivate")
 				}
 
+				assert.Equal(t, tt.expectedTxType, msg.TxType(), "TxType")
+
 				b, err := json.Marshal(msg)
 				require.NoError(t, err, "Marshal must not fail")
 				assert.Equal(t, tt.body, b, "Marshal body should match")
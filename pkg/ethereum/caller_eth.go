@@ -24,13 +24,17 @@ var ethSrv = new(ethService)
 
 // ethService is a jsonrpc.Caller which methods are meant to be automatically populated using jsonrpc.ProvideCaller
 type ethService struct {
-	ChainID                   func(jsonrpc.Client) func(context.Context) (*hexutil.Big, error)                                    `method:"eth_chainId"`
-	GasPrice                  func(jsonrpc.Client) func(context.Context) (*hexutil.Big, error)                                    `namespace:"eth"`
-	GetTransactionCount       func(jsonrpc.Client) func(context.Context, ethcommon.Address, BlockNumber) (*hexutil.Uint64, error) `namespace:"eth"`
-	EstimateGas               func(jsonrpc.Client) func(context.Context, *CallMsg) (*hexutil.Uint64, error)                       `namespace:"eth"`
-	SendRawTransaction        func(jsonrpc.Client) func(context.Context, hexutil.Bytes) (ethcommon.Hash, error)                   `namespace:"eth"`
-	SendRawPrivateTransaction func(jsonrpc.Client) func(context.Context, hexutil.Bytes, *PrivateArgs) (ethcommon.Hash, error)     `namespace:"eth"`
-	GetBlockByNumber          func(jsonrpc.Client) func(context.Context, BlockNumber, bool) (*types.Header, error)                `method:"eth_getBlockByNumber"`
+	ChainID                   func(jsonrpc.Client) func(context.Context) (*hexutil.Big, error)                                        `method:"eth_chainId"`
+	GasPrice                  func(jsonrpc.Client) func(context.Context) (*hexutil.Big, error)                                        `namespace:"eth"`
+	GetTransactionCount       func(jsonrpc.Client) func(context.Context, ethcommon.Address, BlockNumber) (*hexutil.Uint64, error)     `namespace:"eth"`
+	EstimateGas               func(jsonrpc.Client) func(context.Context, *CallMsg) (*hexutil.Uint64, error)                           `namespace:"eth"`
+	SendRawTransaction        func(jsonrpc.Client) func(context.Context, hexutil.Bytes) (ethcommon.Hash, error)                       `namespace:"eth"`
+	SendRawPrivateTransaction func(jsonrpc.Client) func(context.Context, hexutil.Bytes, *PrivateArgs) (ethcommon.Hash, error)         `namespace:"eth"`
+	GetBlockByNumber          func(jsonrpc.Client) func(context.Context, BlockNumber, bool) (*types.Header, error)                    `method:"eth_getBlockByNumber"`
+	GetTransactionReceipt     func(jsonrpc.Client) func(context.Context, ethcommon.Hash) (*Receipt, error)                            `namespace:"eth"`
+	GetTransactionByHash      func(jsonrpc.Client) func(context.Context, ethcommon.Hash) (*TxInfo, error)                             `namespace:"eth"`
+	CreateAccessList          func(jsonrpc.Client) func(context.Context, *CallMsg, BlockNumber) (*AccessListResult, error)            `namespace:"eth"`
+	FeeHistory                func(jsonrpc.Client) func(context.Context, hexutil.Uint64, BlockNumber, []float64) (*FeeHistory, error) `namespace:"eth"`
 }
 
 //go:generate mockgen -source=caller_eth.go -destination=mock/caller_eth.go -package=mock
@@ -44,6 +48,12 @@ type EthCaller interface {
 	EstimateGas(context.Context, *CallMsg) (uint64, error)
 	SendRawTransaction(context.Context, []byte) (ethcommon.Hash, error)
 	SendRawPrivateTransaction(context.Context, []byte, *PrivateArgs) (ethcommon.Hash, error)
+	// GetTransactionReceipt returns nil if the transaction has not been mined
+	GetTransactionReceipt(context.Context, ethcommon.Hash) (*Receipt, error)
+	// GetTransactionByHash returns nil if the transaction is not known by the node
+	GetTransactionByHash(context.Context, ethcommon.Hash) (*TxInfo, error)
+	CreateAccessList(context.Context, *CallMsg, BlockNumber) (*AccessListResult, error)
+	FeeHistory(ctx context.Context, blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistory, error)
 }
 
 type ethCaller struct {
@@ -102,3 +112,19 @@ func (c *ethCaller) BaseFeePerGas(ctx context.Context, blockNumber BlockNumber)
 
 	return header.BaseFee, nil
 }
+
+func (c *ethCaller) GetTransactionReceipt(ctx context.Context, hash ethcommon.Hash) (*Receipt, error) {
+	return ethSrv.GetTransactionReceipt(c.client)(ctx, hash)
+}
+
+func (c *ethCaller) GetTransactionByHash(ctx context.Context, hash ethcommon.Hash) (*TxInfo, error) {
+	return ethSrv.GetTransactionByHash(c.client)(ctx, hash)
+}
+
+func (c *ethCaller) CreateAccessList(ctx context.Context, msg *CallMsg, blockNumber BlockNumber) (*AccessListResult, error) {
+	return ethSrv.CreateAccessList(c.client)(ctx, msg, blockNumber)
+}
+
+func (c *ethCaller) FeeHistory(ctx context.Context, blockCount uint64, newestBlock BlockNumber, rewardPercentiles []float64) (*FeeHistory, error) {
+	return ethSrv.FeeHistory(c.client)(ctx, hexutil.Uint64(blockCount), newestBlock, rewardPercentiles)
+}
@@ -124,6 +124,49 @@ func TestCaller(t *testing.T) {
 		assert.Equal(t, uint64(21000), gas, "Result should be valid")
 	})
 
+	t.Run("eth_createAccessList", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_createAccessList","params":[{"from":"0xfe3b557e8fb62b89f4916b721be55ceb828dbd73","to":"0x44aa93095d6749a706051658b970b941c72c1d53"},"latest"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"accessList":[{"address":"0x44aa93095d6749a706051658b970b941c72c1d53","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}],"gasUsed":"0x5dc0"}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		msg := (&CallMsg{}).
+			WithTo(ethcommon.HexToAddress("0x44Aa93095D6749A706051658B970b941c72c1D53")).
+			WithFrom(ethcommon.HexToAddress("0xFE3B557E8Fb62b89F4916B721be55cEb828dBd73"))
+		res, err := cllr.Eth().CreateAccessList(context.Background(), msg, LatestBlockNumber)
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(24000), uint64(res.GasUsed), "Result should be valid")
+		require.Len(t, res.AccessList, 1, "Result should be valid")
+		assert.Equal(t, ethcommon.HexToAddress("0x44Aa93095D6749A706051658B970b941c72c1D53"), res.AccessList[0].Address, "Result should be valid")
+	})
+
+	t.Run("eth_feeHistory", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_feeHistory","params":["0x3","latest",[50]],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"oldestBlock":"0xa","baseFeePerGas":["0x7","0x7","0x8","0x8"],"gasUsedRatio":[0.5,0,0.9],"reward":[["0x3"],["0x0"],["0x5"]]}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		history, err := cllr.Eth().FeeHistory(context.Background(), 3, LatestBlockNumber, []float64{50})
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(10), uint64(history.OldestBlock), "Result should be valid")
+		assert.Len(t, history.BaseFeePerGas, 4, "Result should be valid")
+		assert.Equal(t, big.NewInt(5), history.MedianReward(), "Empty blocks should be ignored")
+	})
+
 	t.Run("eth_sendRawTransaction", func(t *testing.T) {
 		m := testutils.RequestMatcher(
 			t,
@@ -165,6 +208,62 @@ func TestCaller(t *testing.T) {
 		assert.Equal(t, "0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a", hash.String(), "Result should be valid")
 	})
 
+	t.Run("eth_getTransactionReceipt", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"transactionHash":"0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a","blockNumber":"0x1b4","gasUsed":"0x5208","status":"0x1"}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(436), uint64(receipt.BlockNumber), "Result should be valid")
+		assert.True(t, receipt.Succeeded(), "Result should be valid")
+	})
+
+	t.Run("eth_getTransactionReceipt of a pending transaction", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":null}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		receipt, err := cllr.Eth().GetTransactionReceipt(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Nil(t, receipt, "Result should be nil")
+	})
+
+	t.Run("eth_getTransactionByHash", func(t *testing.T) {
+		m := testutils.RequestMatcher(
+			t,
+			"",
+			[]byte(`{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"],"id":null}`),
+		)
+		respBody := []byte(`{"jsonrpc": "2.0","result":{"hash":"0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a","from":"0xf17f52151ebef6c7334fad080c5704d77216b732","nonce":"0x1","blockNumber":null}}`)
+		transport.EXPECT().RoundTrip(m).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader(respBody)),
+			Header:     header,
+		}, nil)
+
+		tx, err := cllr.Eth().GetTransactionByHash(context.Background(), ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a"))
+		require.NoError(t, err, "Must not error")
+		assert.Equal(t, uint64(1), uint64(tx.Nonce), "Result should be valid")
+		assert.Nil(t, tx.BlockNumber, "Transaction should be pending")
+	})
+
 	t.Run("eea_sendRawTransaction", func(t *testing.T) {
 		m := testutils.RequestMatcher(
 			t,
@@ -0,0 +1,70 @@
+package ethereum
+
+import (
+	"encoding/base64"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// eeaTxFields is the number of RLP fields of a signed EEA transaction
+// nonce, gasPrice, gas, to, value, data, v, r, s, privateFrom, privateFor or privacyGroupId, restriction
+const eeaTxFields = 12
+
+// ParseEEAPrivateArgs returns the private arguments of a signed EEA transaction
+func ParseEEAPrivateArgs(raw []byte) (*PrivateArgs, error) {
+	var fields []rlp.RawValue
+	err := rlp.DecodeBytes(raw, &fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) != eeaTxFields {
+		return nil, fmt.Errorf("invalid EEA transaction, expected %d fields but got %d", eeaTxFields, len(fields))
+	}
+
+	args := new(PrivateArgs)
+
+	var privateFrom []byte
+	err = rlp.DecodeBytes(fields[9], &privateFrom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid privateFrom: %v", err)
+	}
+	args.WithPrivateFrom(base64.StdEncoding.EncodeToString(privateFrom))
+
+	// Recipients are either a list of keys or a privacy group ID
+	kind, _, _, err := rlp.Split(fields[10])
+	if err != nil {
+		return nil, fmt.Errorf("invalid private recipients: %v", err)
+	}
+
+	if kind == rlp.List {
+		var privateFor [][]byte
+		err = rlp.DecodeBytes(fields[10], &privateFor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid privateFor: %v", err)
+		}
+
+		keys := make([]string, len(privateFor))
+		for i, key := range privateFor {
+			keys[i] = base64.StdEncoding.EncodeToString(key)
+		}
+		args.WithPrivateFor(keys)
+	} else {
+		var privacyGroupID []byte
+		err = rlp.DecodeBytes(fields[10], &privacyGroupID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid privacyGroupId: %v", err)
+		}
+		args.WithPrivacyGroupID(base64.StdEncoding.EncodeToString(privacyGroupID))
+	}
+
+	var privateType string
+	err = rlp.DecodeBytes(fields[11], &privateType)
+	if err != nil {
+		return nil, fmt.Errorf("invalid restriction: %v", err)
+	}
+	args.WithPrivateType(PrivateType(privateType))
+
+	return args, nil
+}
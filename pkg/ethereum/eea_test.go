@@ -0,0 +1,59 @@
+package ethereum
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func encodeEEATx(t *testing.T, recipients interface{}) []byte {
+	to := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	raw, err := rlp.EncodeToBytes([]interface{}{
+		uint64(1),
+		big.NewInt(0),
+		uint64(21000),
+		&to,
+		big.NewInt(0),
+		[]byte{0xab},
+		big.NewInt(2710),
+		big.NewInt(1),
+		big.NewInt(2),
+		[]byte{0x01, 0x02},
+		recipients,
+		"restricted",
+	})
+	require.NoError(t, err)
+	return raw
+}
+
+func TestParseEEAPrivateArgs(t *testing.T) {
+	t.Run("should parse privateFor", func(t *testing.T) {
+		args, err := ParseEEAPrivateArgs(encodeEEATx(t, [][]byte{{0x03}, {0x04}}))
+		require.NoError(t, err)
+
+		assert.Equal(t, "AQI=", *args.PrivateFrom)
+		assert.Equal(t, []string{"Aw==", "BA=="}, *args.PrivateFor)
+		assert.Nil(t, args.PrivacyGroupID)
+		assert.Equal(t, PrivateTypeRestricted, *args.PrivateType)
+	})
+
+	t.Run("should parse privacyGroupId", func(t *testing.T) {
+		args, err := ParseEEAPrivateArgs(encodeEEATx(t, []byte{0x05}))
+		require.NoError(t, err)
+
+		assert.Equal(t, "BQ==", *args.PrivacyGroupID)
+		assert.Nil(t, args.PrivateFor)
+	})
+
+	t.Run("should fail on a public transaction", func(t *testing.T) {
+		raw, err := rlp.EncodeToBytes([]interface{}{uint64(1), big.NewInt(0), uint64(21000)})
+		require.NoError(t, err)
+
+		_, err = ParseEEAPrivateArgs(raw)
+		assert.Error(t, err)
+	})
+}
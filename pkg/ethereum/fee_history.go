@@ -0,0 +1,51 @@
+package ethereum
+
+import (
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// FeeHistory is the result of eth_feeHistory
+type FeeHistory struct {
+	OldestBlock   hexutil.Uint64   `json:"oldestBlock"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+	Reward        [][]*hexutil.Big `json:"reward,omitempty"`
+}
+
+// MedianReward returns the median of the first requested reward percentile over the non-empty blocks of the history,
+// or nil if no block holds a transaction
+func (h *FeeHistory) MedianReward() *big.Int {
+	var rewards []*big.Int
+	for idx, blockRewards := range h.Reward {
+		if len(blockRewards) == 0 || blockRewards[0] == nil {
+			continue
+		}
+
+		// Empty blocks report a zero reward which would drag the suggestion down
+		if idx < len(h.GasUsedRatio) && h.GasUsedRatio[idx] == 0 {
+			continue
+		}
+
+		rewards = append(rewards, blockRewards[0].ToInt())
+	}
+
+	if len(rewards) == 0 {
+		return nil
+	}
+
+	sort.Slice(rewards, func(i, j int) bool { return rewards[i].Cmp(rewards[j]) < 0 })
+
+	return new(big.Int).Set(rewards[len(rewards)/2])
+}
+
+// AccessListResult is the result of eth_createAccessList
+type AccessListResult struct {
+	AccessList types.AccessList `json:"accessList"`
+	GasUsed    hexutil.Uint64   `json:"gasUsed"`
+	// Error is set when the transaction would revert with the generated access list
+	Error string `json:"error,omitempty"`
+}
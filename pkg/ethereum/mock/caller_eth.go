@@ -6,37 +6,53 @@ package mock
 
 import (
 	context "context"
+	big "math/big"
+	reflect "reflect"
+
 	ethereum "github.com/consensys/quorum-key-manager/pkg/ethereum"
 	common "github.com/ethereum/go-ethereum/common"
 	gomock "github.com/golang/mock/gomock"
-	big "math/big"
-	reflect "reflect"
 )
 
-// MockEthCaller is a mock of EthCaller interface
+// MockEthCaller is a mock of EthCaller interface.
 type MockEthCaller struct {
 	ctrl     *gomock.Controller
 	recorder *MockEthCallerMockRecorder
 }
 
-// MockEthCallerMockRecorder is the mock recorder for MockEthCaller
+// MockEthCallerMockRecorder is the mock recorder for MockEthCaller.
 type MockEthCallerMockRecorder struct {
 	mock *MockEthCaller
 }
 
-// NewMockEthCaller creates a new mock instance
+// NewMockEthCaller creates a new mock instance.
 func NewMockEthCaller(ctrl *gomock.Controller) *MockEthCaller {
 	mock := &MockEthCaller{ctrl: ctrl}
 	mock.recorder = &MockEthCallerMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockEthCaller) EXPECT() *MockEthCallerMockRecorder {
 	return m.recorder
 }
 
-// ChainID mocks base method
+// BaseFeePerGas mocks base method.
+func (m *MockEthCaller) BaseFeePerGas(arg0 context.Context, arg1 ethereum.BlockNumber) (*big.Int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BaseFeePerGas", arg0, arg1)
+	ret0, _ := ret[0].(*big.Int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BaseFeePerGas indicates an expected call of BaseFeePerGas.
+func (mr *MockEthCallerMockRecorder) BaseFeePerGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFeePerGas", reflect.TypeOf((*MockEthCaller)(nil).BaseFeePerGas), arg0, arg1)
+}
+
+// ChainID mocks base method.
 func (m *MockEthCaller) ChainID(arg0 context.Context) (*big.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ChainID", arg0)
@@ -45,13 +61,58 @@ func (m *MockEthCaller) ChainID(arg0 context.Context) (*big.Int, error) {
 	return ret0, ret1
 }
 
-// ChainID indicates an expected call of ChainID
+// ChainID indicates an expected call of ChainID.
 func (mr *MockEthCallerMockRecorder) ChainID(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChainID", reflect.TypeOf((*MockEthCaller)(nil).ChainID), arg0)
 }
 
-// GasPrice mocks base method
+// CreateAccessList mocks base method.
+func (m *MockEthCaller) CreateAccessList(arg0 context.Context, arg1 *ethereum.CallMsg, arg2 ethereum.BlockNumber) (*ethereum.AccessListResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccessList", arg0, arg1, arg2)
+	ret0, _ := ret[0].(*ethereum.AccessListResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccessList indicates an expected call of CreateAccessList.
+func (mr *MockEthCallerMockRecorder) CreateAccessList(arg0, arg1, arg2 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccessList", reflect.TypeOf((*MockEthCaller)(nil).CreateAccessList), arg0, arg1, arg2)
+}
+
+// EstimateGas mocks base method.
+func (m *MockEthCaller) EstimateGas(arg0 context.Context, arg1 *ethereum.CallMsg) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EstimateGas indicates an expected call of EstimateGas.
+func (mr *MockEthCallerMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockEthCaller)(nil).EstimateGas), arg0, arg1)
+}
+
+// FeeHistory mocks base method.
+func (m *MockEthCaller) FeeHistory(ctx context.Context, blockCount uint64, newestBlock ethereum.BlockNumber, rewardPercentiles []float64) (*ethereum.FeeHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FeeHistory", ctx, blockCount, newestBlock, rewardPercentiles)
+	ret0, _ := ret[0].(*ethereum.FeeHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FeeHistory indicates an expected call of FeeHistory.
+func (mr *MockEthCallerMockRecorder) FeeHistory(ctx, blockCount, newestBlock, rewardPercentiles interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FeeHistory", reflect.TypeOf((*MockEthCaller)(nil).FeeHistory), ctx, blockCount, newestBlock, rewardPercentiles)
+}
+
+// GasPrice mocks base method.
 func (m *MockEthCaller) GasPrice(arg0 context.Context) (*big.Int, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GasPrice", arg0)
@@ -60,28 +121,28 @@ func (m *MockEthCaller) GasPrice(arg0 context.Context) (*big.Int, error) {
 	return ret0, ret1
 }
 
-// GasPrice indicates an expected call of GasPrice
+// GasPrice indicates an expected call of GasPrice.
 func (mr *MockEthCallerMockRecorder) GasPrice(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GasPrice", reflect.TypeOf((*MockEthCaller)(nil).GasPrice), arg0)
 }
 
-// BaseFeePerGas mocks base method
-func (m *MockEthCaller) BaseFeePerGas(arg0 context.Context, arg1 ethereum.BlockNumber) (*big.Int, error) {
+// GetTransactionByHash mocks base method.
+func (m *MockEthCaller) GetTransactionByHash(arg0 context.Context, arg1 common.Hash) (*ethereum.TxInfo, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BaseFeePerGas", arg0, arg1)
-	ret0, _ := ret[0].(*big.Int)
+	ret := m.ctrl.Call(m, "GetTransactionByHash", arg0, arg1)
+	ret0, _ := ret[0].(*ethereum.TxInfo)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// BaseFeePerGas indicates an expected call of BaseFeePerGas
-func (mr *MockEthCallerMockRecorder) BaseFeePerGas(arg0, arg1 interface{}) *gomock.Call {
+// GetTransactionByHash indicates an expected call of GetTransactionByHash.
+func (mr *MockEthCallerMockRecorder) GetTransactionByHash(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BaseFeePerGas", reflect.TypeOf((*MockEthCaller)(nil).BaseFeePerGas), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionByHash", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionByHash), arg0, arg1)
 }
 
-// GetTransactionCount mocks base method
+// GetTransactionCount mocks base method.
 func (m *MockEthCaller) GetTransactionCount(arg0 context.Context, arg1 common.Address, arg2 ethereum.BlockNumber) (uint64, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetTransactionCount", arg0, arg1, arg2)
@@ -90,53 +151,53 @@ func (m *MockEthCaller) GetTransactionCount(arg0 context.Context, arg1 common.Ad
 	return ret0, ret1
 }
 
-// GetTransactionCount indicates an expected call of GetTransactionCount
+// GetTransactionCount indicates an expected call of GetTransactionCount.
 func (mr *MockEthCallerMockRecorder) GetTransactionCount(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionCount", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionCount), arg0, arg1, arg2)
 }
 
-// EstimateGas mocks base method
-func (m *MockEthCaller) EstimateGas(arg0 context.Context, arg1 *ethereum.CallMsg) (uint64, error) {
+// GetTransactionReceipt mocks base method.
+func (m *MockEthCaller) GetTransactionReceipt(arg0 context.Context, arg1 common.Hash) (*ethereum.Receipt, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "EstimateGas", arg0, arg1)
-	ret0, _ := ret[0].(uint64)
+	ret := m.ctrl.Call(m, "GetTransactionReceipt", arg0, arg1)
+	ret0, _ := ret[0].(*ethereum.Receipt)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// EstimateGas indicates an expected call of EstimateGas
-func (mr *MockEthCallerMockRecorder) EstimateGas(arg0, arg1 interface{}) *gomock.Call {
+// GetTransactionReceipt indicates an expected call of GetTransactionReceipt.
+func (mr *MockEthCallerMockRecorder) GetTransactionReceipt(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EstimateGas", reflect.TypeOf((*MockEthCaller)(nil).EstimateGas), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTransactionReceipt", reflect.TypeOf((*MockEthCaller)(nil).GetTransactionReceipt), arg0, arg1)
 }
 
-// SendRawTransaction mocks base method
-func (m *MockEthCaller) SendRawTransaction(arg0 context.Context, arg1 []byte) (common.Hash, error) {
+// SendRawPrivateTransaction mocks base method.
+func (m *MockEthCaller) SendRawPrivateTransaction(arg0 context.Context, arg1 []byte, arg2 *ethereum.PrivateArgs) (common.Hash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendRawTransaction", arg0, arg1)
+	ret := m.ctrl.Call(m, "SendRawPrivateTransaction", arg0, arg1, arg2)
 	ret0, _ := ret[0].(common.Hash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendRawTransaction indicates an expected call of SendRawTransaction
-func (mr *MockEthCallerMockRecorder) SendRawTransaction(arg0, arg1 interface{}) *gomock.Call {
+// SendRawPrivateTransaction indicates an expected call of SendRawPrivateTransaction.
+func (mr *MockEthCallerMockRecorder) SendRawPrivateTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawTransaction), arg0, arg1)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawPrivateTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawPrivateTransaction), arg0, arg1, arg2)
 }
 
-// SendRawPrivateTransaction mocks base method
-func (m *MockEthCaller) SendRawPrivateTransaction(arg0 context.Context, arg1 []byte, arg2 *ethereum.PrivateArgs) (common.Hash, error) {
+// SendRawTransaction mocks base method.
+func (m *MockEthCaller) SendRawTransaction(arg0 context.Context, arg1 []byte) (common.Hash, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SendRawPrivateTransaction", arg0, arg1, arg2)
+	ret := m.ctrl.Call(m, "SendRawTransaction", arg0, arg1)
 	ret0, _ := ret[0].(common.Hash)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SendRawPrivateTransaction indicates an expected call of SendRawPrivateTransaction
-func (mr *MockEthCallerMockRecorder) SendRawPrivateTransaction(arg0, arg1, arg2 interface{}) *gomock.Call {
+// SendRawTransaction indicates an expected call of SendRawTransaction.
+func (mr *MockEthCallerMockRecorder) SendRawTransaction(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawPrivateTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawPrivateTransaction), arg0, arg1, arg2)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendRawTransaction", reflect.TypeOf((*MockEthCaller)(nil).SendRawTransaction), arg0, arg1)
 }
@@ -0,0 +1,30 @@
+package ethereum
+
+import (
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Receipt holds the fields of a transaction receipt needed to follow a transaction
+type Receipt struct {
+	TxHash      ethcommon.Hash `json:"transactionHash"`
+	BlockHash   ethcommon.Hash `json:"blockHash"`
+	BlockNumber hexutil.Uint64 `json:"blockNumber"`
+	GasUsed     hexutil.Uint64 `json:"gasUsed"`
+	// Status is 1 if the transaction succeeded, 0 if it reverted
+	Status hexutil.Uint64 `json:"status"`
+}
+
+// Succeeded indicates whether the transaction of the receipt has been executed successfully
+func (r *Receipt) Succeeded() bool {
+	return r.Status == 1
+}
+
+// TxInfo holds the fields of a transaction known by a node
+type TxInfo struct {
+	Hash  ethcommon.Hash    `json:"hash"`
+	From  ethcommon.Address `json:"from"`
+	Nonce hexutil.Uint64    `json:"nonce"`
+	// BlockNumber is nil while the transaction is pending
+	BlockNumber *hexutil.Uint64 `json:"blockNumber"`
+}
@@ -44,6 +44,32 @@ func isSigningAlgorithm(fl validator.FieldLevel) bool {
 	return true
 }
 
+func isHashAlgorithm(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.NoHash), string(entities.Sha256), string(entities.Keccak256), string(entities.Sha512):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+func isSignatureEncoding(fl validator.FieldLevel) bool {
+	if fl.Field().String() != "" {
+		switch fl.Field().String() {
+		case string(entities.RawEncoding), string(entities.RSVEncoding), string(entities.DEREncoding), string(entities.JWSEncoding):
+			return true
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 func init() {
 	if validate != nil {
 		return
@@ -53,6 +79,8 @@ func init() {
 	_ = validate.RegisterValidation("isHexAddress", isHexAddress)
 	_ = validate.RegisterValidation("isCurve", isCurve)
 	_ = validate.RegisterValidation("isSigningAlgorithm", isSigningAlgorithm)
+	_ = validate.RegisterValidation("isHashAlgorithm", isHashAlgorithm)
+	_ = validate.RegisterValidation("isSignatureEncoding", isSignatureEncoding)
 }
 
 func getValidator() *validator.Validate {
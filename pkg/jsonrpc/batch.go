@@ -0,0 +1,57 @@
+package jsonrpc
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// IsBatch indicates whether a JSON-RPC message body is a batch (a JSON array)
+func IsBatch(b []byte) bool {
+	b = bytes.TrimLeft(b, " \t\r\n")
+	return len(b) > 0 && b[0] == '['
+}
+
+// UnmarshalBatch unmarshals the raw elements of a JSON-RPC batch
+func UnmarshalBatch(b []byte) ([]json.RawMessage, error) {
+	var raws []json.RawMessage
+	err := json.Unmarshal(b, &raws)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(raws) == 0 {
+		return nil, fmt.Errorf("empty batch")
+	}
+
+	return raws, nil
+}
+
+// matchBatchResponses orders responses in order of the requests by matching their IDs
+// Responses of notifications and requests without response are nil
+func matchBatchResponses(reqMsgs []*RequestMsg, respMsgs []*ResponseMsg) []*ResponseMsg {
+	byID := make(map[string][]*ResponseMsg)
+	for _, respMsg := range respMsgs {
+		id := rawID(respMsg.ID)
+		byID[id] = append(byID[id], respMsg)
+	}
+
+	ordered := make([]*ResponseMsg, len(reqMsgs))
+	for i, reqMsg := range reqMsgs {
+		if reqMsg.ID == nil {
+			continue
+		}
+
+		id := rawID(reqMsg.ID)
+		if resps := byID[id]; len(resps) > 0 {
+			ordered[i], byID[id] = resps[0], resps[1:]
+		}
+	}
+
+	return ordered
+}
+
+func rawID(id interface{}) string {
+	b, _ := json.Marshal(id)
+	return string(b)
+}
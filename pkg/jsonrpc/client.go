@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"sync/atomic"
@@ -16,6 +17,13 @@ type Client interface {
 	Do(*RequestMsg) (*ResponseMsg, error)
 }
 
+// BatchClient is a jsonrpc client able to send batch requests
+type BatchClient interface {
+	// DoBatch sends a jsonrpc batch request and returns the responses in order of the requests
+	// Responses of notifications and requests the server did not reply to are nil
+	DoBatch(context.Context, []*RequestMsg) ([]*ResponseMsg, error)
+}
+
 type incrementalIDClient struct {
 	client Client
 
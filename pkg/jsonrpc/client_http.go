@@ -1,6 +1,7 @@
 package jsonrpc
 
 import (
+	"context"
 	"net/http"
 
 	httpclient "github.com/consensys/quorum-key-manager/pkg/http/client"
@@ -58,3 +59,47 @@ func (c *HTTPClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 
 	return respMsg, nil
 }
+
+// DoBatch sends a jsonrpc batch request over the underlying HTTP client and returns the jsonrpc responses in order of the requests
+func (c *HTTPClient) DoBatch(ctx context.Context, reqMsgs []*RequestMsg) ([]*ResponseMsg, error) {
+	for _, reqMsg := range reqMsgs {
+		err := reqMsg.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "", nil)
+
+	// write request body
+	err := request.WriteJSON(req, reqMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, DownstreamError(err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, InvalidDownstreamHTTPStatusError(resp.StatusCode)
+	}
+
+	// Create responses and reads body
+	var respMsgs []*ResponseMsg
+	err = response.ReadJSON(resp, &respMsgs)
+	if err != nil {
+		return nil, InvalidDownstreamResponse(err)
+	}
+
+	// Invalid responses are ignored so only their requests fail
+	validRespMsgs := make([]*ResponseMsg, 0, len(respMsgs))
+	for _, respMsg := range respMsgs {
+		if respMsg != nil && respMsg.Validate() == nil {
+			validRespMsgs = append(validRespMsgs, respMsg)
+		}
+	}
+
+	return matchBatchResponses(reqMsgs, validRespMsgs), nil
+}
@@ -2,6 +2,7 @@ package jsonrpc
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -203,3 +204,56 @@ func TestHTTPClient(t *testing.T) {
 		})
 	}
 }
+
+func TestHTTPClientDoBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transport := testutils.NewMockRoundTripper(ctrl)
+	client := NewHTTPClient(&http.Client{Transport: transport})
+
+	header := make(http.Header)
+	header.Set("Content-Type", "application/json")
+
+	reqMsgs := []*RequestMsg{
+		{Version: "2.0", Method: "testMethod", Params: []int{1}, ID: 1},
+		{Version: "2.0", Method: "testMethod", Params: []int{2}, ID: "2"},
+		{Version: "2.0", Method: "testMethod", Params: []int{3}, ID: 3},
+	}
+	expectedReqBody := []byte(`[{"jsonrpc":"2.0","method":"testMethod","params":[1],"id":1},{"jsonrpc":"2.0","method":"testMethod","params":[2],"id":"2"},{"jsonrpc":"2.0","method":"testMethod","params":[3],"id":3}]`)
+
+	t.Run("should return responses in order of the requests", func(t *testing.T) {
+		transport.EXPECT().RoundTrip(testutils.RequestMatcher(t, "", expectedReqBody)).Return(&http.Response{
+			StatusCode: http.StatusOK,
+			Body:       ioutil.NopCloser(bytes.NewReader([]byte(`[{"jsonrpc":"2.0","id":"2","result":"b"},{"jsonrpc":"2.0","id":1,"result":"a"},{"id":3,"result":"c"}]`))),
+			Header:     header,
+		}, nil)
+
+		resps, err := client.DoBatch(context.Background(), reqMsgs)
+		require.NoError(t, err)
+		require.Len(t, resps, 3)
+
+		var result string
+		require.NoError(t, resps[0].UnmarshalResult(&result))
+		assert.Equal(t, "a", result)
+		require.NoError(t, resps[1].UnmarshalResult(&result))
+		assert.Equal(t, "b", result)
+		assert.Nil(t, resps[2], "invalid response should be ignored")
+	})
+
+	t.Run("should fail with invalid downstream HTTP status", func(t *testing.T) {
+		transport.EXPECT().RoundTrip(testutils.RequestMatcher(t, "", expectedReqBody)).Return(&http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       ioutil.NopCloser(bytes.NewReader(nil)),
+			Header:     header,
+		}, nil)
+
+		_, err := client.DoBatch(context.Background(), reqMsgs)
+		assert.Equal(t, InvalidDownstreamHTTPStatusError(http.StatusNotFound), err)
+	})
+
+	t.Run("should fail with invalid request", func(t *testing.T) {
+		_, err := client.DoBatch(context.Background(), []*RequestMsg{{Version: "2.0", ID: 1}})
+		require.Error(t, err)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -35,6 +36,8 @@ type WebSocketClient struct {
 	err error
 
 	errors chan error
+
+	notificationHandler func(*RequestMsg)
 }
 
 // NewWebsocketClient creates a new jsonrpc HTTPClient from an HTTP HTTPClient
@@ -54,6 +57,13 @@ func NewWebsocketClient(conn *websocket.Conn) *WebSocketClient {
 	}
 }
 
+// WithNotificationHandler sets the handler called, in order of reception, with the notifications sent by the server
+// (e.g. eth_subscription). Notifications are dropped if no handler is set. It must be called before Start
+func (c *WebSocketClient) WithNotificationHandler(h func(*RequestMsg)) *WebSocketClient {
+	c.notificationHandler = h
+	return c
+}
+
 func (c *WebSocketClient) Start(context.Context) error {
 	go c.read()
 	go c.manageOp()
@@ -89,14 +99,7 @@ func (c *WebSocketClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 		return nil, err
 	}
 
-	rawID, _ := json.Marshal(reqMsg.ID)
-	op := &operation{
-		c:    c,
-		id:   string(rawID),
-		msg:  reqMsg,
-		sent: make(chan error),
-		resp: make(chan *ResponseMsg),
-	}
+	op := c.newOperation(reqMsg.Context(), reqMsg)
 
 	err = c.send(op)
 	if err != nil {
@@ -112,8 +115,63 @@ func (c *WebSocketClient) Do(reqMsg *RequestMsg) (*ResponseMsg, error) {
 	return respMsg, nil
 }
 
+// DoBatch sends a jsonrpc batch request over the underlying connection and returns the jsonrpc responses in order of the requests
+func (c *WebSocketClient) DoBatch(ctx context.Context, reqMsgs []*RequestMsg) ([]*ResponseMsg, error) {
+	batchOp := &operation{
+		c:    c,
+		ctx:  ctx,
+		sent: make(chan error),
+	}
+
+	for _, reqMsg := range reqMsgs {
+		err := reqMsg.Validate()
+		if err != nil {
+			return nil, err
+		}
+
+		batchOp.batch = append(batchOp.batch, c.newOperation(ctx, reqMsg))
+	}
+
+	err := c.send(batchOp)
+	if err != nil {
+		return nil, DownstreamError(err)
+	}
+
+	respMsgs := make([]*ResponseMsg, len(reqMsgs))
+	for i, op := range batchOp.batch {
+		// Notifications do not have any response
+		if op.msg.ID == nil {
+			continue
+		}
+
+		respMsg, opErr := op.wait()
+		if opErr != nil && err == nil {
+			err = opErr
+		}
+		respMsgs[i] = respMsg
+	}
+
+	if err != nil {
+		return nil, DownstreamError(err)
+	}
+
+	return respMsgs, nil
+}
+
+func (c *WebSocketClient) newOperation(ctx context.Context, reqMsg *RequestMsg) *operation {
+	rawID, _ := json.Marshal(reqMsg.ID)
+	return &operation{
+		c:    c,
+		ctx:  ctx,
+		id:   string(rawID),
+		msg:  reqMsg,
+		sent: make(chan error),
+		resp: make(chan *ResponseMsg),
+	}
+}
+
 func (c *WebSocketClient) send(op *operation) error {
-	ctx := op.msg.Context()
+	ctx := op.ctx
 	select {
 	case <-ctx.Done():
 		return ctx.Err()
@@ -138,37 +196,80 @@ func (c *WebSocketClient) read() {
 			return
 		}
 
-		respMsg := new(ResponseMsg)
-		err = json.NewDecoder(r).Decode(respMsg)
+		b, err := ioutil.ReadAll(r)
 		if err != nil {
 			continue
 		}
 
-		err = respMsg.Validate()
+		if isNotification(b) {
+			c.handleNotification(b)
+			continue
+		}
+
+		var respMsgs []*ResponseMsg
+		if IsBatch(b) {
+			err = json.Unmarshal(b, &respMsgs)
+		} else {
+			respMsg := new(ResponseMsg)
+			err = json.Unmarshal(b, respMsg)
+			respMsgs = []*ResponseMsg{respMsg}
+		}
 		if err != nil {
 			continue
 		}
 
-		c.readResp <- respMsg
+		for _, respMsg := range respMsgs {
+			if respMsg == nil || respMsg.Validate() != nil {
+				continue
+			}
+
+			c.readResp <- respMsg
+		}
+	}
+}
+
+func (c *WebSocketClient) handleNotification(b []byte) {
+	if c.notificationHandler == nil {
+		return
+	}
+
+	msg := new(RequestMsg)
+	err := json.Unmarshal(b, msg)
+	if err != nil {
+		return
 	}
+
+	c.notificationHandler(msg)
+}
+
+// isNotification indicates whether a message received from the server is a request rather than a response
+func isNotification(b []byte) bool {
+	if IsBatch(b) {
+		return false
+	}
+
+	raw := new(struct {
+		Method *string `json:"method"`
+	})
+	err := json.Unmarshal(b, raw)
+
+	return err == nil && raw.Method != nil
 }
 
 func (c *WebSocketClient) manageOp() {
 	for {
 		select {
 		case op := <-c.todos:
+			if op.batch != nil {
+				c.writeBatch(op)
+				continue
+			}
+
 			// Register op
 			c.addOp(op)
 
-			// Compute write deadline
-			ctx := op.msg.Context()
-			deadline, ok := ctx.Deadline()
-			if !ok {
-				deadline = time.Now().Add(c.writeTimeout)
-			}
-
 			// Write Message
-			_ = op.c.conn.SetWriteDeadline(deadline)
+			_ = op.c.conn.SetWriteDeadline(c.writeDeadline(op.ctx))
 			err := op.c.conn.WriteJSON(op.msg)
 			op.sent <- err
 
@@ -211,6 +312,38 @@ func (c *WebSocketClient) manageOp() {
 	}
 }
 
+// writeBatch registers the operations of a batch expecting a response and writes their messages as a single JSON array
+func (c *WebSocketClient) writeBatch(batchOp *operation) {
+	msgs := make([]*RequestMsg, len(batchOp.batch))
+	for i, op := range batchOp.batch {
+		if op.msg.ID != nil {
+			c.addOp(op)
+		}
+		msgs[i] = op.msg
+	}
+
+	_ = c.conn.SetWriteDeadline(c.writeDeadline(batchOp.ctx))
+	err := c.conn.WriteJSON(msgs)
+	if err != nil {
+		for _, op := range batchOp.batch {
+			if op.msg.ID != nil {
+				c.removeOp(op)
+			}
+		}
+	}
+
+	batchOp.sent <- err
+}
+
+func (c *WebSocketClient) writeDeadline(ctx context.Context) time.Time {
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(c.writeTimeout)
+	}
+
+	return deadline
+}
+
 func (c *WebSocketClient) addOp(op *operation) {
 	c.liveOps[op.id] = op
 }
@@ -242,9 +375,13 @@ func (c *WebSocketClient) handleRespMsg(respMsg *ResponseMsg) {
 type operation struct {
 	c *WebSocketClient
 
+	ctx context.Context
 	id  string
 	msg *RequestMsg
 
+	// batch holds the operations of a batch request
+	batch []*operation
+
 	sent chan error
 
 	resp chan *ResponseMsg
@@ -252,7 +389,7 @@ type operation struct {
 }
 
 func (op *operation) wait() (*ResponseMsg, error) {
-	ctx := op.msg.Context()
+	ctx := op.ctx
 
 	select {
 	case <-ctx.Done():
@@ -235,3 +235,57 @@ func TestWebSocketClient(t *testing.T) {
 
 	close(h.resps)
 }
+
+func TestWebSocketClientNotifications(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close()
+			for {
+				reqMsg := new(RequestMsg)
+				if err := conn.ReadJSON(reqMsg); err != nil {
+					return
+				}
+
+				// Notification is sent before the response to the request
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xab","result":"0x1"}}`))
+				_ = conn.WriteJSON((&ResponseMsg{}).WithVersion("2.0").WithID(reqMsg.ID).WithResult("0xab"))
+			}
+		}()
+	}))
+	defer s.Close()
+
+	conn, _, err := dialer.Dial(makeWsProto(s.URL), nil)
+	require.NoError(t, err, "Dial must not error")
+	defer conn.Close()
+
+	notifications := make(chan *RequestMsg, 1)
+	client := NewWebsocketClient(conn).WithNotificationHandler(func(msg *RequestMsg) {
+		notifications <- msg
+	})
+	err = client.Start(context.TODO())
+	require.NoError(t, err, "Start should not error")
+
+	resp, err := client.Do(new(RequestMsg).WithVersion("2.0").WithMethod("eth_subscribe").WithParams([]string{"newHeads"}).WithID(1))
+	require.NoError(t, err, "Do must not error")
+
+	var subID string
+	err = resp.UnmarshalResult(&subID)
+	require.NoError(t, err, "UnmarshalResult must not error")
+	assert.Equal(t, "0xab", subID, "Result must be correct")
+
+	select {
+	case msg := <-notifications:
+		assert.Equal(t, "eth_subscription", msg.Method, "Notification method must be correct")
+		assert.Nil(t, msg.ID, "Notification must not have an ID")
+	case <-time.After(time.Second):
+		t.Fatal("notification must have been handled")
+	}
+
+	err = client.Stop(context.TODO())
+	require.NoError(t, err, "Stop must not error")
+}
@@ -56,6 +56,13 @@ func NotImplementedMethodError(method string) *ErrorMsg {
 	}
 }
 
+func MethodNotAllowedError(method string) *ErrorMsg {
+	return &ErrorMsg{
+		Code:    -32601,
+		Message: fmt.Sprintf("Method %q not allowed", method),
+	}
+}
+
 func MethodNotFoundError() *ErrorMsg {
 	return &ErrorMsg{
 		Code:    -32601,
@@ -54,6 +54,15 @@ func MethodNotFound(rw ResponseWriter, msg *RequestMsg) {
 // that replies to each request with an invalid method error
 func MethodNotFoundHandler() Handler { return HandlerFunc(MethodNotFound) }
 
+// MethodNotAllowed replies to the request with a method not allowed error
+func MethodNotAllowed(rw ResponseWriter, msg *RequestMsg) {
+	_ = WriteError(rw, MethodNotAllowedError(msg.Method))
+}
+
+// MethodNotAllowedHandler returns a simple handler
+// that replies to each request with a method not allowed error
+func MethodNotAllowedHandler() Handler { return HandlerFunc(MethodNotAllowed) }
+
 // NotImplementedMethod replies to the request with a not implemented error
 func NotImplementedMethod(rw ResponseWriter, msg *RequestMsg) {
 	_ = WriteError(rw, NotImplementedMethodError(msg.Method))
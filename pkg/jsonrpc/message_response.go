@@ -14,6 +14,9 @@ type ResponseMsg struct {
 	Error   *ErrorMsg
 
 	raw *jsonRespMsg
+
+	// nullResult indicates the response holds a null result (e.g. receipt of a pending transaction)
+	nullResult bool
 }
 
 // jsonRespMsg is a struct allowing to encode/decode a JSON-RPC response body
@@ -40,6 +43,8 @@ func (msg *ResponseMsg) UnmarshalJSON(b []byte) error {
 
 	if raw.Result != nil {
 		msg.Result = *raw.Result
+	} else if raw.Error == nil {
+		msg.nullResult = hasResultField(b)
 	}
 
 	if raw.Error != nil {
@@ -110,7 +115,7 @@ func (msg *ResponseMsg) Validate() error {
 	}
 
 	isSuccess := msg.Error == nil
-	hasResult := msg.Result != nil
+	hasResult := msg.Result != nil || msg.nullResult
 
 	if isSuccess && !hasResult {
 		return fmt.Errorf("missing result on success")
@@ -207,3 +212,14 @@ func (msg *ResponseMsg) UnmarshalID(v interface{}) error {
 
 	return err
 }
+
+// hasResultField indicates whether a JSON-RPC response body contains a result field, possibly null
+func hasResultField(b []byte) bool {
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return false
+	}
+
+	_, ok := fields["result"]
+	return ok
+}
@@ -176,6 +176,10 @@ func TestResponseMsgValidate(t *testing.T) {
 			desc: "valid request with valid json.RawMessage id",
 			msg:  &ResponseMsg{Version: "2.0", ID: json.RawMessage(`"abcd"`), Result: true},
 		},
+		{
+			desc: "valid success response with null result",
+			msg:  &ResponseMsg{Version: "2.0", ID: 0, nullResult: true},
+		},
 		{
 			desc:           "invalid success response without result",
 			msg:            &ResponseMsg{Version: "2.0", ID: 0},
@@ -5,6 +5,7 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	jsonrpc "github.com/consensys/quorum-key-manager/pkg/jsonrpc"
@@ -34,7 +35,7 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// Do mock base method.
+// Do mocks base method.
 func (m *MockClient) Do(arg0 *jsonrpc.RequestMsg) (*jsonrpc.ResponseMsg, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Do", arg0)
@@ -48,3 +49,41 @@ func (mr *MockClientMockRecorder) Do(arg0 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Do", reflect.TypeOf((*MockClient)(nil).Do), arg0)
 }
+
+// MockBatchClient is a mock of BatchClient interface.
+type MockBatchClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockBatchClientMockRecorder
+}
+
+// MockBatchClientMockRecorder is the mock recorder for MockBatchClient.
+type MockBatchClientMockRecorder struct {
+	mock *MockBatchClient
+}
+
+// NewMockBatchClient creates a new mock instance.
+func NewMockBatchClient(ctrl *gomock.Controller) *MockBatchClient {
+	mock := &MockBatchClient{ctrl: ctrl}
+	mock.recorder = &MockBatchClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBatchClient) EXPECT() *MockBatchClientMockRecorder {
+	return m.recorder
+}
+
+// DoBatch mocks base method.
+func (m *MockBatchClient) DoBatch(arg0 context.Context, arg1 []*jsonrpc.RequestMsg) ([]*jsonrpc.ResponseMsg, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DoBatch", arg0, arg1)
+	ret0, _ := ret[0].([]*jsonrpc.ResponseMsg)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DoBatch indicates an expected call of DoBatch.
+func (mr *MockBatchClientMockRecorder) DoBatch(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DoBatch", reflect.TypeOf((*MockBatchClient)(nil).DoBatch), arg0, arg1)
+}
@@ -0,0 +1,100 @@
+package jwt
+
+import (
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	qkmcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt"
+)
+
+const ES256KAlg = "ES256K"
+
+// SigningMethodES256K implements ECDSA over the secp256k1 curve using SHA-256 as described in RFC 8812
+type SigningMethodES256K struct{}
+
+var SigningMethodSecp256k1 = &SigningMethodES256K{}
+
+func init() {
+	jwt.RegisterSigningMethod(ES256KAlg, func() jwt.SigningMethod {
+		return SigningMethodSecp256k1
+	})
+}
+
+func (m *SigningMethodES256K) Alg() string {
+	return ES256KAlg
+}
+
+func (m *SigningMethodES256K) Verify(signingString, signature string, key interface{}) error {
+	pubKey, ok := key.(*ecdsa.PublicKey)
+	if !ok {
+		return jwt.ErrInvalidKeyType
+	}
+
+	sig, err := jwt.DecodeSegment(signature)
+	if err != nil {
+		return err
+	}
+	if len(sig) != 64 {
+		return jwt.ErrECDSAVerification
+	}
+
+	digest := sha256.Sum256([]byte(signingString))
+	verified, err := qkmcrypto.VerifyECDSASignature(crypto.FromECDSAPub(pubKey), digest[:], sig)
+	if err != nil {
+		return err
+	} else if !verified {
+		return jwt.ErrECDSAVerification
+	}
+
+	return nil
+}
+
+func (m *SigningMethodES256K) Sign(signingString string, key interface{}) (string, error) {
+	privKey, ok := key.(*ecdsa.PrivateKey)
+	if !ok {
+		return "", jwt.ErrInvalidKeyType
+	}
+
+	return SignES256K(signingString, func(digest []byte) ([]byte, error) {
+		sig, err := crypto.Sign(digest, privKey)
+		if err != nil {
+			return nil, err
+		}
+
+		// We remove the recID from the signature (last byte).
+		return sig[:len(sig)-1], nil
+	})
+}
+
+// SignES256K signs the given signing string with an external signer receiving the SHA-256 digest and returning the raw R || S signature
+func SignES256K(signingString string, sign func(digest []byte) ([]byte, error)) (string, error) {
+	digest := sha256.Sum256([]byte(signingString))
+	sig, err := sign(digest[:])
+	if err != nil {
+		return "", err
+	}
+	if len(sig) != 64 {
+		return "", fmt.Errorf("invalid ES256K signature length %d", len(sig))
+	}
+
+	return jwt.EncodeSegment(sig), nil
+}
+
+// SignedStringES256K returns the complete signed token using an external signer, see SignES256K
+func SignedStringES256K(token *jwt.Token, sign func(digest []byte) ([]byte, error)) (string, error) {
+	signingString, err := token.SigningString()
+	if err != nil {
+		return "", err
+	}
+
+	sig, err := SignES256K(signingString, sign)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join([]string{signingString, sig}, "."), nil
+}
@@ -6,6 +6,8 @@ import (
 	"net/http"
 
 	json2 "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt"
 	"gopkg.in/square/go-jose.v2"
 )
 
@@ -19,11 +21,33 @@ type JWKsKey struct {
 	Use string   `json:"use"`
 	E   string   `json:"e,omitempty"`
 	N   string   `json:"n,omitempty"`
+	Crv string   `json:"crv,omitempty"`
+	X   string   `json:"x,omitempty"`
+	Y   string   `json:"y,omitempty"`
 	Kid string   `json:"kid"`
-	X5c []string `json:"x5c"`
+	X5c []string `json:"x5c,omitempty"`
 	X5t string   `json:"x5t,omitempty"`
 }
 
+// NewSecp256k1JWK returns the JWK of an uncompressed secp256k1 public key used to verify ES256K signatures (RFC 8812)
+func NewSecp256k1JWK(kid string, pubKey []byte) (*JWKsKey, error) {
+	ecdsaPubKey, err := crypto.UnmarshalPubkey(pubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	x, y := make([]byte, 32), make([]byte, 32)
+	return &JWKsKey{
+		Alg: ES256KAlg,
+		Kty: "EC",
+		Use: "sig",
+		Crv: "secp256k1",
+		X:   jwt.EncodeSegment(ecdsaPubKey.X.FillBytes(x)),
+		Y:   jwt.EncodeSegment(ecdsaPubKey.Y.FillBytes(y)),
+		Kid: kid,
+	}, nil
+}
+
 func RetrieveKeySet(ctx context.Context, client *http.Client, authEndpoint string) (*jose.JSONWebKeySet, error) {
 	req, _ := http.NewRequestWithContext(ctx, "GET", authEndpoint, nil)
 	response, err := client.Do(req)
@@ -0,0 +1,29 @@
+package jwt
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/golang-jwt/jwt"
+)
+
+// CompactJWS returns the compact serialization (RFC 7515) of the JWS of the payload protected by the header. The signer receives
+// the signing input and returns the signature encoded in base64url. The payload is omitted if detached (RFC 7515 appendix F)
+func CompactJWS(header map[string]interface{}, payload []byte, detached bool, sign func(signingInput string) (string, error)) (string, error) {
+	headerJSON, err := json.Marshal(header)
+	if err != nil {
+		return "", err
+	}
+
+	encodedHeader, encodedPayload := jwt.EncodeSegment(headerJSON), jwt.EncodeSegment(payload)
+	sig, err := sign(strings.Join([]string{encodedHeader, encodedPayload}, "."))
+	if err != nil {
+		return "", err
+	}
+
+	if detached {
+		encodedPayload = ""
+	}
+
+	return strings.Join([]string{encodedHeader, encodedPayload, sig}, "."), nil
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"net/http"
+	"net/url"
 
 	httpclient "github.com/consensys/quorum-key-manager/pkg/http/client"
 	"github.com/consensys/quorum-key-manager/pkg/http/request"
@@ -15,7 +17,30 @@ import (
 
 // Client is a client to Tessera Private Transaction Manager
 type Client interface {
+	// StoreRaw stores a payload and returns its key
 	StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error)
+
+	// Send stores a payload, distributes it to the recipients and returns its key
+	// Recipients are either the privateFor keys or the members of a privacy group
+	Send(ctx context.Context, req *SendRequest) ([]byte, error)
+
+	// Receive returns the payload of a key, decrypted for the recipient to (the default key of the node if empty)
+	Receive(ctx context.Context, key []byte, to string) (*ReceiveResponse, error)
+
+	// TransactionByHash returns the payload of a transaction hash, decrypted for the recipient to (the default key of the node if empty)
+	TransactionByHash(ctx context.Context, hash []byte, to string) (*ReceiveResponse, error)
+
+	// CreatePrivacyGroup creates a privacy group
+	CreatePrivacyGroup(ctx context.Context, req *CreatePrivacyGroupRequest) (*PrivacyGroup, error)
+
+	// FindPrivacyGroup returns the privacy groups with the given members
+	FindPrivacyGroup(ctx context.Context, addresses []string) ([]*PrivacyGroup, error)
+
+	// RetrievePrivacyGroup returns a privacy group
+	RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*PrivacyGroup, error)
+
+	// Resend resends the payloads of a recipient
+	Resend(ctx context.Context, req *ResendRequest) error
 }
 
 // HTTPClient is a tessera.Client that uses http
@@ -23,6 +48,8 @@ type HTTPClient struct {
 	client httpclient.Client
 }
 
+var _ Client = &HTTPClient{}
+
 // NewHTTPClient creates a new HTTPClient
 func NewHTTPClient(c httpclient.Client) *HTTPClient {
 	return &HTTPClient{
@@ -30,6 +57,16 @@ func NewHTTPClient(c httpclient.Client) *HTTPClient {
 	}
 }
 
+// ResponseError is the error returned when Tessera responds with a non-success HTTP status code
+type ResponseError struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *ResponseError) Error() string {
+	return fmt.Sprintf("tessera responded with status %d: %s", e.StatusCode, e.Message)
+}
+
 type StoreRawRequest struct {
 	Payload     string `json:"payload"`
 	PrivateFrom string `json:"privateFrom"`
@@ -39,34 +76,185 @@ type StoreRawResponse struct {
 	Key string `json:"key"`
 }
 
-func (c *HTTPClient) StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error) {
-	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "/storeraw", nil)
+type SendRequest struct {
+	Payload        []byte   `json:"payload"`
+	From           string   `json:"from,omitempty"`
+	To             []string `json:"to,omitempty"`
+	PrivacyGroupID string   `json:"privacyGroupId,omitempty"`
+}
+
+type SendResponse struct {
+	Key []byte `json:"key"`
+}
+
+type ReceiveRequest struct {
+	Key []byte `json:"key"`
+	To  string `json:"to,omitempty"`
+}
+
+type ReceiveResponse struct {
+	Payload        []byte   `json:"payload"`
+	SenderKey      string   `json:"senderKey,omitempty"`
+	PrivacyGroupID string   `json:"privacyGroupId,omitempty"`
+	ManagedParties []string `json:"managedParties,omitempty"`
+}
+
+type CreatePrivacyGroupRequest struct {
+	Addresses   []string `json:"addresses"`
+	From        string   `json:"from"`
+	Name        string   `json:"name,omitempty"`
+	Description string   `json:"description,omitempty"`
+}
+
+type FindPrivacyGroupRequest struct {
+	Addresses []string `json:"addresses"`
+}
+
+type RetrievePrivacyGroupRequest struct {
+	PrivacyGroupID string `json:"privacyGroupId"`
+}
+
+type PrivacyGroup struct {
+	PrivacyGroupID string   `json:"privacyGroupId"`
+	Name           string   `json:"name,omitempty"`
+	Description    string   `json:"description,omitempty"`
+	Type           string   `json:"type,omitempty"`
+	Members        []string `json:"members"`
+}
 
-	err := request.WriteJSON(req, &StoreRawRequest{
+// Resend types
+const (
+	ResendAll        = "ALL"
+	ResendIndividual = "INDIVIDUAL"
+)
+
+type ResendRequest struct {
+	Type      string `json:"type"`
+	PublicKey string `json:"publicKey"`
+	Key       []byte `json:"key,omitempty"`
+}
+
+func (c *HTTPClient) StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error) {
+	msg := new(StoreRawResponse)
+	err := c.postJSON(ctx, "/storeraw", &StoreRawRequest{
 		Payload:     base64.StdEncoding.EncodeToString(payload),
 		PrivateFrom: privateFrom,
-	})
+	}, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.client.Do(req)
+	b, err := base64.StdEncoding.DecodeString(msg.Key)
 	if err != nil {
 		return nil, err
 	}
 
-	msg := new(StoreRawResponse)
-	err = response.ReadJSON(resp, msg)
+	return b, nil
+}
+
+func (c *HTTPClient) Send(ctx context.Context, req *SendRequest) ([]byte, error) {
+	msg := new(SendResponse)
+	err := c.postJSON(ctx, "/send", req, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	b, err := base64.StdEncoding.DecodeString(msg.Key)
+	return msg.Key, nil
+}
+
+func (c *HTTPClient) Receive(ctx context.Context, key []byte, to string) (*ReceiveResponse, error) {
+	msg := new(ReceiveResponse)
+	err := c.postJSON(ctx, "/receive", &ReceiveRequest{Key: key, To: to}, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	return b, nil
+	return msg, nil
+}
+
+func (c *HTTPClient) TransactionByHash(ctx context.Context, hash []byte, to string) (*ReceiveResponse, error) {
+	// Hashes are base64 encoded so they must be escaped in the path
+	encodedHash := base64.StdEncoding.EncodeToString(hash)
+	reqURL := &url.URL{Path: "/transaction/" + encodedHash, RawPath: "/transaction/" + url.PathEscape(encodedHash)}
+	if to != "" {
+		reqURL.RawQuery = url.Values{"to": []string{to}}.Encode()
+	}
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, reqURL.String(), nil)
+
+	msg := new(ReceiveResponse)
+	err := c.do(req, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (c *HTTPClient) CreatePrivacyGroup(ctx context.Context, req *CreatePrivacyGroupRequest) (*PrivacyGroup, error) {
+	msg := new(PrivacyGroup)
+	err := c.postJSON(ctx, "/createPrivacyGroup", req, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (c *HTTPClient) FindPrivacyGroup(ctx context.Context, addresses []string) ([]*PrivacyGroup, error) {
+	var msg []*PrivacyGroup
+	err := c.postJSON(ctx, "/findPrivacyGroup", &FindPrivacyGroupRequest{Addresses: addresses}, &msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (c *HTTPClient) RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*PrivacyGroup, error) {
+	msg := new(PrivacyGroup)
+	err := c.postJSON(ctx, "/retrievePrivacyGroup", &RetrievePrivacyGroupRequest{PrivacyGroupID: privacyGroupID}, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+func (c *HTTPClient) Resend(ctx context.Context, req *ResendRequest) error {
+	return c.postJSON(ctx, "/resend", req, nil)
+}
+
+func (c *HTTPClient) postJSON(ctx context.Context, path string, body, msg interface{}) error {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, path, nil)
+
+	err := request.WriteJSON(req, body)
+	if err != nil {
+		return err
+	}
+
+	return c.do(req, msg)
+}
+
+// do sends a request and decodes the JSON response into msg, the response body is ignored if msg is nil
+func (c *HTTPClient) do(req *http.Request, msg interface{}) error {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		defer resp.Body.Close()
+		b, _ := ioutil.ReadAll(resp.Body)
+		return &ResponseError{StatusCode: resp.StatusCode, Message: string(b)}
+	}
+
+	if msg == nil {
+		resp.Body.Close()
+		return nil
+	}
+
+	return response.ReadJSON(resp, msg)
 }
 
 var ErrNotConfigured = fmt.Errorf("tessera not configured")
@@ -74,6 +262,36 @@ var ErrNotConfigured = fmt.Errorf("tessera not configured")
 // NotConfiguredClient is a Tessera Client that always return a tessera not configured error
 type NotConfiguredClient struct{}
 
+var _ Client = &NotConfiguredClient{}
+
 func (c *NotConfiguredClient) StoreRaw(context.Context, []byte, string) ([]byte, error) {
 	return nil, ErrNotConfigured
 }
+
+func (c *NotConfiguredClient) Send(context.Context, *SendRequest) ([]byte, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) Receive(context.Context, []byte, string) (*ReceiveResponse, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) TransactionByHash(context.Context, []byte, string) (*ReceiveResponse, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) CreatePrivacyGroup(context.Context, *CreatePrivacyGroupRequest) (*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) FindPrivacyGroup(context.Context, []string) ([]*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) RetrievePrivacyGroup(context.Context, string) (*PrivacyGroup, error) {
+	return nil, ErrNotConfigured
+}
+
+func (c *NotConfiguredClient) Resend(context.Context, *ResendRequest) error {
+	return ErrNotConfigured
+}
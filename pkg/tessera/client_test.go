@@ -66,3 +66,109 @@ func TestStoreRaw(t *testing.T) {
 		})
 	}
 }
+
+func jsonResponse(status int, body string) *http.Response {
+	resp := &http.Response{
+		StatusCode: status,
+		Body:       ioutil.NopCloser(bytes.NewReader([]byte(body))),
+		Header:     make(http.Header),
+	}
+	resp.Header.Set("Content-Type", "application/json")
+	return resp
+}
+
+func TestPrivateTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transport := testutils.NewMockRoundTripper(ctrl)
+	client := NewHTTPClient(&http.Client{Transport: transport})
+	ctx := context.Background()
+
+	t.Run("should send a payload to its recipients", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/send", []byte(`{"payload":"q80=","from":"A1aV","to":["B1aV"]}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, `{"key":"AQI="}`), nil)
+
+		key, err := client.Send(ctx, &SendRequest{Payload: []byte{0xab, 0xcd}, From: "A1aV", To: []string{"B1aV"}})
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, key)
+	})
+
+	t.Run("should receive a payload", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/receive", []byte(`{"key":"AQI=","to":"B1aV"}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, `{"payload":"q80=","senderKey":"A1aV"}`), nil)
+
+		resp, err := client.Receive(ctx, []byte{1, 2}, "B1aV")
+		require.NoError(t, err)
+		assert.Equal(t, &ReceiveResponse{Payload: []byte{0xab, 0xcd}, SenderKey: "A1aV"}, resp)
+	})
+
+	t.Run("should get the payload of a transaction", func(t *testing.T) {
+		transport.EXPECT().RoundTrip(gomock.Any()).DoAndReturn(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, http.MethodGet, req.Method)
+			assert.Equal(t, "/transaction/q83%2F", req.URL.EscapedPath())
+			assert.Equal(t, "B1aV", req.URL.Query().Get("to"))
+			return jsonResponse(http.StatusOK, `{"payload":"AQI="}`), nil
+		})
+
+		resp, err := client.TransactionByHash(ctx, []byte{0xab, 0xcd, 0xff}, "B1aV")
+		require.NoError(t, err)
+		assert.Equal(t, []byte{1, 2}, resp.Payload)
+	})
+
+	t.Run("should return a response error on failure", func(t *testing.T) {
+		resp := jsonResponse(http.StatusNotFound, `Message with hash q80= was not found`)
+		resp.Header.Set("Content-Type", "text/plain")
+		transport.EXPECT().RoundTrip(gomock.Any()).Return(resp, nil)
+
+		_, err := client.Receive(ctx, []byte{0xab, 0xcd}, "")
+		require.Error(t, err)
+		assert.Equal(t, &ResponseError{StatusCode: http.StatusNotFound, Message: "Message with hash q80= was not found"}, err)
+	})
+
+	t.Run("should resend the payloads of a recipient", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/resend", []byte(`{"type":"ALL","publicKey":"B1aV"}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, ``), nil)
+
+		err := client.Resend(ctx, &ResendRequest{Type: ResendAll, PublicKey: "B1aV"})
+		require.NoError(t, err)
+	})
+}
+
+func TestPrivacyGroups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transport := testutils.NewMockRoundTripper(ctrl)
+	client := NewHTTPClient(&http.Client{Transport: transport})
+	ctx := context.Background()
+	group := `{"privacyGroupId":"G1aV","name":"group","type":"PANTHEON","members":["A1aV","B1aV"]}`
+	expectedGroup := &PrivacyGroup{PrivacyGroupID: "G1aV", Name: "group", Type: "PANTHEON", Members: []string{"A1aV", "B1aV"}}
+
+	t.Run("should create a privacy group", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/createPrivacyGroup", []byte(`{"addresses":["A1aV","B1aV"],"from":"A1aV","name":"group"}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, group), nil)
+
+		pg, err := client.CreatePrivacyGroup(ctx, &CreatePrivacyGroupRequest{Addresses: []string{"A1aV", "B1aV"}, From: "A1aV", Name: "group"})
+		require.NoError(t, err)
+		assert.Equal(t, expectedGroup, pg)
+	})
+
+	t.Run("should find privacy groups", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/findPrivacyGroup", []byte(`{"addresses":["A1aV","B1aV"]}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, "["+group+"]"), nil)
+
+		pgs, err := client.FindPrivacyGroup(ctx, []string{"A1aV", "B1aV"})
+		require.NoError(t, err)
+		assert.Equal(t, []*PrivacyGroup{expectedGroup}, pgs)
+	})
+
+	t.Run("should retrieve a privacy group", func(t *testing.T) {
+		m := testutils.RequestMatcher(t, "/retrievePrivacyGroup", []byte(`{"privacyGroupId":"G1aV"}`))
+		transport.EXPECT().RoundTrip(m).Return(jsonResponse(http.StatusOK, group), nil)
+
+		pg, err := client.RetrievePrivacyGroup(ctx, "G1aV")
+		require.NoError(t, err)
+		assert.Equal(t, expectedGroup, pg)
+	})
+}
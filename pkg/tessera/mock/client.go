@@ -8,6 +8,7 @@ import (
 	context "context"
 	reflect "reflect"
 
+	tessera "github.com/consensys/quorum-key-manager/pkg/tessera"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -34,7 +35,96 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// StoreRaw mock base method.
+// CreatePrivacyGroup mocks base method.
+func (m *MockClient) CreatePrivacyGroup(ctx context.Context, req *tessera.CreatePrivacyGroupRequest) (*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivacyGroup", ctx, req)
+	ret0, _ := ret[0].(*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivacyGroup indicates an expected call of CreatePrivacyGroup.
+func (mr *MockClientMockRecorder) CreatePrivacyGroup(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivacyGroup", reflect.TypeOf((*MockClient)(nil).CreatePrivacyGroup), ctx, req)
+}
+
+// FindPrivacyGroup mocks base method.
+func (m *MockClient) FindPrivacyGroup(ctx context.Context, addresses []string) ([]*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPrivacyGroup", ctx, addresses)
+	ret0, _ := ret[0].([]*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPrivacyGroup indicates an expected call of FindPrivacyGroup.
+func (mr *MockClientMockRecorder) FindPrivacyGroup(ctx, addresses interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPrivacyGroup", reflect.TypeOf((*MockClient)(nil).FindPrivacyGroup), ctx, addresses)
+}
+
+// Receive mocks base method.
+func (m *MockClient) Receive(ctx context.Context, key []byte, to string) (*tessera.ReceiveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", ctx, key, to)
+	ret0, _ := ret[0].(*tessera.ReceiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockClientMockRecorder) Receive(ctx, key, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockClient)(nil).Receive), ctx, key, to)
+}
+
+// Resend mocks base method.
+func (m *MockClient) Resend(ctx context.Context, req *tessera.ResendRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Resend", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Resend indicates an expected call of Resend.
+func (mr *MockClientMockRecorder) Resend(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Resend", reflect.TypeOf((*MockClient)(nil).Resend), ctx, req)
+}
+
+// RetrievePrivacyGroup mocks base method.
+func (m *MockClient) RetrievePrivacyGroup(ctx context.Context, privacyGroupID string) (*tessera.PrivacyGroup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RetrievePrivacyGroup", ctx, privacyGroupID)
+	ret0, _ := ret[0].(*tessera.PrivacyGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RetrievePrivacyGroup indicates an expected call of RetrievePrivacyGroup.
+func (mr *MockClientMockRecorder) RetrievePrivacyGroup(ctx, privacyGroupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RetrievePrivacyGroup", reflect.TypeOf((*MockClient)(nil).RetrievePrivacyGroup), ctx, privacyGroupID)
+}
+
+// Send mocks base method.
+func (m *MockClient) Send(ctx context.Context, req *tessera.SendRequest) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", ctx, req)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockClientMockRecorder) Send(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockClient)(nil).Send), ctx, req)
+}
+
+// StoreRaw mocks base method.
 func (m *MockClient) StoreRaw(ctx context.Context, payload []byte, privateFrom string) ([]byte, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "StoreRaw", ctx, payload, privateFrom)
@@ -48,3 +138,18 @@ func (mr *MockClientMockRecorder) StoreRaw(ctx, payload, privateFrom interface{}
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StoreRaw", reflect.TypeOf((*MockClient)(nil).StoreRaw), ctx, payload, privateFrom)
 }
+
+// TransactionByHash mocks base method.
+func (m *MockClient) TransactionByHash(ctx context.Context, hash []byte, to string) (*tessera.ReceiveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TransactionByHash", ctx, hash, to)
+	ret0, _ := ret[0].(*tessera.ReceiveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TransactionByHash indicates an expected call of TransactionByHash.
+func (mr *MockClientMockRecorder) TransactionByHash(ctx, hash, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TransactionByHash", reflect.TypeOf((*MockClient)(nil).TransactionByHash), ctx, hash, to)
+}
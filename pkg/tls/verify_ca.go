@@ -5,6 +5,12 @@ import (
 )
 
 func VerifyCertificateAuthority(certs []*x509.Certificate, serverName string, rootCAs *x509.CertPool, skipVerify bool) error {
+	_, err := VerifyCertificateChains(certs, serverName, rootCAs, skipVerify)
+	return err
+}
+
+// VerifyCertificateChains verifies the first certificate against the root CAs, using the others as intermediates, and returns the verified chains
+func VerifyCertificateChains(certs []*x509.Certificate, serverName string, rootCAs *x509.CertPool, skipVerify bool) ([][]*x509.Certificate, error) {
 	opts := x509.VerifyOptions{
 		Intermediates: x509.NewCertPool(),
 		Roots:         rootCAs,
@@ -21,7 +27,5 @@ func VerifyCertificateAuthority(certs []*x509.Certificate, serverName string, ro
 		opts.Intermediates.AddCert(cert)
 	}
 
-	_, err := certs[0].Verify(opts)
-
-	return err
+	return certs[0].Verify(opts)
 }
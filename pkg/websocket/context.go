@@ -0,0 +1,23 @@
+package websocket
+
+import (
+	"context"
+
+	"github.com/gorilla/websocket"
+)
+
+// RedialFunc dials a new server connection replacing the current one of a proxied session
+type RedialFunc func(ctx context.Context) (*websocket.Conn, error)
+
+type redialCtxKey struct{}
+
+// WithRedial attaches a RedialFunc to a context
+func WithRedial(ctx context.Context, redial RedialFunc) context.Context {
+	return context.WithValue(ctx, redialCtxKey{}, redial)
+}
+
+// RedialFromContext returns the RedialFunc attached to a context, nil if there is none
+func RedialFromContext(ctx context.Context) RedialFunc {
+	redial, _ := ctx.Value(redialCtxKey{}).(RedialFunc)
+	return redial
+}
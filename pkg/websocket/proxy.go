@@ -2,12 +2,14 @@ package websocket
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"sync"
 	"time"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 
+	"github.com/consensys/quorum-key-manager/pkg/common"
 	"github.com/consensys/quorum-key-manager/pkg/http/header"
 	"github.com/consensys/quorum-key-manager/pkg/http/proxy"
 	"github.com/consensys/quorum-key-manager/pkg/http/request"
@@ -22,8 +24,36 @@ type ProxyConfig struct {
 
 	PingPongTimeout        *json.Duration `json:"pingPongTimeout,omitempty"`
 	WriteControlMsgTimeout *json.Duration `json:"writeControlMsgTimeout,omitempty"`
+
+	Reconnect *ReconnectConfig `json:"reconnect,omitempty"`
+}
+
+// ReconnectConfig configures how interceptors reconnect to the server when the server connection drops
+type ReconnectConfig struct {
+	// MaxAttempts is the number of dials attempted before giving up, reconnection is disabled if 0
+	MaxAttempts *int           `json:"maxAttempts,omitempty"`
+	Interval    *json.Duration `json:"interval,omitempty"`
+}
+
+func (cfg *ReconnectConfig) SetDefault() *ReconnectConfig {
+	if cfg.MaxAttempts == nil {
+		cfg.MaxAttempts = common.ToPtr(5).(*int)
+	}
+
+	if cfg.Interval == nil {
+		cfg.Interval = &json.Duration{Duration: time.Second}
+	}
+
+	return cfg
 }
 
+var (
+	ErrProxyStopped      = errors.New("websocket proxy is stopped")
+	ErrClientClosed      = errors.New("client connection is closed")
+	ErrReconnectDisabled = errors.New("reconnection is disabled")
+	ErrReconnectFailed   = errors.New("failed to reconnect to server")
+)
+
 func (cfg *ProxyConfig) SetDefault() *ProxyConfig {
 	if cfg.Upgrader == nil {
 		cfg.Upgrader = new(UpgraderConfig)
@@ -43,9 +73,16 @@ func (cfg *ProxyConfig) SetDefault() *ProxyConfig {
 		cfg.WriteControlMsgTimeout = &json.Duration{Duration: time.Second}
 	}
 
+	if cfg.Reconnect == nil {
+		cfg.Reconnect = new(ReconnectConfig)
+	}
+	cfg.Reconnect.SetDefault()
+
 	return cfg
 }
 
+// InterceptorFunc intercepts the messages of a proxied session.
+// The context holds a RedialFunc (see RedialFromContext) allowing to replace a dropped server connection
 type InterceptorFunc func(ctx context.Context, clientConn, serverConn *websocket.Conn) (clientErrors, serverErrors <-chan error)
 
 type Proxy struct {
@@ -71,6 +108,9 @@ type Proxy struct {
 	PingPongTimeout        time.Duration
 	WriteControlMsgTimeout time.Duration
 	CloseGracePeriod       time.Duration
+
+	ReconnectAttempts int
+	ReconnectInterval time.Duration
 }
 
 func NewProxy(cfg *ProxyConfig, logger log.Logger) *Proxy {
@@ -80,6 +120,8 @@ func NewProxy(cfg *ProxyConfig, logger log.Logger) *Proxy {
 		PingPongTimeout:        cfg.PingPongTimeout.Duration,
 		WriteControlMsgTimeout: cfg.WriteControlMsgTimeout.Duration,
 		CloseGracePeriod:       5 * time.Second,
+		ReconnectAttempts:      *cfg.Reconnect.MaxAttempts,
+		ReconnectInterval:      cfg.Reconnect.Interval.Duration,
 		stop:                   make(chan struct{}),
 		done:                   make(chan struct{}),
 		ops:                    make(chan *operation),
@@ -148,10 +190,9 @@ func (prx *Proxy) serveWS(rw http.ResponseWriter, req *http.Request) {
 		req:                 req,
 		logger:              prx.logger,
 		clientConn:          clientConn,
-		serverConn:          serverConn,
+		server:              newServerLink(serverConn),
 		done:                make(chan struct{}),
 		receivedClientClose: make(chan struct{}),
-		receivedServerClose: make(chan struct{}),
 	}
 
 	// sed ops for processing
@@ -178,12 +219,37 @@ func (prx *Proxy) errorHandler() proxy.HandleRoundTripErrorFunc {
 }
 
 func (prx *Proxy) handleUpgrade(rw http.ResponseWriter, req *http.Request) (clientConn, serverConn *websocket.Conn, err error) {
+	var (
+		outReq *http.Request
+		resp   *http.Response
+	)
+	serverConn, resp, outReq, err = prx.dialServer(req.Context(), req)
+	if err != nil {
+		prx.errorHandler()(rw, outReq, err)
+		return
+	}
+
+	// delete headers that will be re-populated on Upgrade
+	_ = response.HeadersModifier(header.DeleteWebSocketHeaders).Modify(resp)
+
+	// Upgrade client connection
+	clientConn, err = prx.Upgrader.Upgrade(rw, req, resp.Header)
+	if err != nil {
+		_ = prx.writeClose(serverConn, GoingAway, true)
+		serverConn.Close()
+		return
+	}
+
+	return
+}
+
+// dialServer prepares the client request and dials the server
+func (prx *Proxy) dialServer(ctx context.Context, req *http.Request) (serverConn *websocket.Conn, resp *http.Response, outReq *http.Request, err error) {
 	// Prepare request
-	outReq := req.Clone(req.Context())
+	outReq = req.Clone(ctx)
 	if prx.ReqPreparer != nil {
 		outReq, err = prx.ReqPreparer.Prepare(outReq)
 		if err != nil {
-			prx.errorHandler()(rw, outReq, err)
 			return
 		}
 	}
@@ -200,32 +266,19 @@ func (prx *Proxy) handleUpgrade(rw http.ResponseWriter, req *http.Request) (clie
 	outReq.URL.Scheme = "ws"
 
 	// Dial server
-	var resp *http.Response
 	serverConn, resp, err = prx.Dialer.DialContext(outReq.Context(), outReq.URL.String(), outReq.Header)
 	if err != nil {
-		prx.errorHandler()(rw, outReq, err)
 		return
 	}
 
 	if prx.RespModifier != nil {
 		err = prx.RespModifier.Modify(resp)
 		if err != nil {
-			prx.errorHandler()(rw, outReq, err)
+			serverConn.Close()
 			return
 		}
 	}
 
-	// delete headers that will be re-populated on Upgrade
-	_ = response.HeadersModifier(header.DeleteWebSocketHeaders).Modify(resp)
-
-	// Upgrade client connection
-	clientConn, err = prx.Upgrader.Upgrade(rw, req, resp.Header)
-	if err != nil {
-		_ = prx.writeClose(serverConn, GoingAway, true)
-		serverConn.Close()
-		return
-	}
-
 	return
 }
 
@@ -254,27 +307,56 @@ type operation struct {
 	req    *http.Request
 	logger log.Logger
 
-	clientConn, serverConn *websocket.Conn
+	clientConn *websocket.Conn
 
-	writeClientCloseOnce, writeServerCloseOnce sync.Once
-	receivedClientClose, receivedServerClose   chan struct{}
+	// server is replaced when the interceptor redials the server
+	serverMux sync.RWMutex
+	server    *serverLink
+
+	writeClientCloseOnce sync.Once
+	receivedClientClose  chan struct{}
 
 	done chan struct{}
 }
 
+// serverLink is a connection to the server with its closing state
+type serverLink struct {
+	conn *websocket.Conn
+
+	writeCloseOnce sync.Once
+	receivedClose  chan struct{}
+}
+
+func newServerLink(conn *websocket.Conn) *serverLink {
+	return &serverLink{
+		conn:          conn,
+		receivedClose: make(chan struct{}),
+	}
+}
+
+func (op *operation) currentServer() *serverLink {
+	op.serverMux.RLock()
+	defer op.serverMux.RUnlock()
+	return op.server
+}
+
 func (op *operation) run() {
+	server := op.currentServer()
+
 	// Set read timeouts
 	_ = op.clientConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
-	_ = op.serverConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
+	_ = server.conn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
 
 	// Pipe control messages
-	op.pipeControlMessages()
+	op.pipeClientControlMessages()
+	op.pipeServerControlMessages(server)
 
 	// Handle stop
 	go op.handleStop()
 
 	// Run interceptor
-	clientErrs, serverErrs := op.prx.interceptor()(op.req.Context(), op.clientConn, op.serverConn)
+	ctx := WithRedial(op.req.Context(), op.redial)
+	clientErrs, serverErrs := op.prx.interceptor()(ctx, op.clientConn, server.conn)
 
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
@@ -303,6 +385,48 @@ func (op *operation) run() {
 	close(op.done)
 }
 
+// redial replaces the server connection, retrying as configured on the proxy.
+// It gives up as soon as the proxy stops or the client closes its connection
+func (op *operation) redial(ctx context.Context) (*websocket.Conn, error) {
+	if op.prx.ReconnectAttempts <= 0 {
+		return nil, ErrReconnectDisabled
+	}
+
+	for attempt := 1; attempt <= op.prx.ReconnectAttempts; attempt++ {
+		select {
+		case <-op.prx.stop:
+			return nil, ErrProxyStopped
+		case <-op.receivedClientClose:
+			return nil, ErrClientClosed
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(op.prx.ReconnectInterval):
+		}
+
+		conn, _, _, err := op.prx.dialServer(op.req.Context(), op.req)
+		if err != nil {
+			op.logger.WithError(err).Debug("failed to redial server", "attempt", attempt)
+			continue
+		}
+
+		server := newServerLink(conn)
+		_ = conn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
+		op.pipeServerControlMessages(server)
+
+		op.serverMux.Lock()
+		previous := op.server
+		op.server = server
+		op.serverMux.Unlock()
+
+		previous.conn.Close()
+
+		op.logger.Info("reconnected to server", "attempt", attempt)
+		return conn, nil
+	}
+
+	return nil, ErrReconnectFailed
+}
+
 func (op *operation) handleStop() {
 	<-op.prx.stop
 	wg := &sync.WaitGroup{}
@@ -339,8 +463,9 @@ func (op *operation) writeClientClose(msg []byte, waitCloseBack bool) {
 }
 
 func (op *operation) writeServerClose(msg []byte, waitCloseBack bool) {
-	op.writeServerCloseOnce.Do(func() {
-		err := op.prx.writeControl(op.serverConn, websocket.CloseMessage, msg)
+	server := op.currentServer()
+	server.writeCloseOnce.Do(func() {
+		err := op.prx.writeControl(server.conn, websocket.CloseMessage, msg)
 		if err != nil {
 			op.logger.WithError(err).Debug("error writing Close to server connection")
 			return
@@ -349,20 +474,20 @@ func (op *operation) writeServerClose(msg []byte, waitCloseBack bool) {
 		if waitCloseBack {
 			after := time.After(op.prx.CloseGracePeriod)
 			select {
-			case <-op.receivedServerClose:
+			case <-server.receivedClose:
 			case <-after:
 			}
 		}
 	})
 }
 
-func (op *operation) pipeControlMessages() {
+func (op *operation) pipeClientControlMessages() {
 	op.clientConn.SetPingHandler(func(data string) error {
 		// We received a message from client, so we refresh read timeline
 		_ = op.clientConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
 
 		// Forward Ping to server
-		err := op.prx.writeControl(op.serverConn, websocket.PingMessage, []byte(data))
+		err := op.prx.writeControl(op.currentServer().conn, websocket.PingMessage, []byte(data))
 		if err != nil {
 			op.logger.WithError(err).Debug("error writing Ping message on server connection")
 		}
@@ -375,7 +500,7 @@ func (op *operation) pipeControlMessages() {
 		_ = op.clientConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
 
 		// Forward Pong to server
-		err := op.prx.writeControl(op.serverConn, websocket.PongMessage, []byte(data))
+		err := op.prx.writeControl(op.currentServer().conn, websocket.PongMessage, []byte(data))
 		if err != nil {
 			op.logger.WithError(err).Debug("error writing Pong message on server connection")
 		}
@@ -383,9 +508,29 @@ func (op *operation) pipeControlMessages() {
 		return nil
 	})
 
-	op.serverConn.SetPingHandler(func(data string) error {
+	op.clientConn.SetCloseHandler(func(code int, text string) error {
+		select {
+		case <-op.receivedClientClose:
+			return nil
+		default:
+			close(op.receivedClientClose)
+		}
+
+		// We answer Close back to client
+		var msg []byte
+		if code != websocket.CloseNoStatusReceived {
+			msg = websocket.FormatCloseMessage(code, "")
+		}
+		op.writeClientClose(msg, false)
+
+		return nil
+	})
+}
+
+func (op *operation) pipeServerControlMessages(server *serverLink) {
+	server.conn.SetPingHandler(func(data string) error {
 		// We received a message from server, so we refresh read timeline
-		_ = op.serverConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
+		_ = server.conn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
 
 		// Forward Ping to client
 		err := op.prx.writeControl(op.clientConn, websocket.PingMessage, []byte(data))
@@ -396,9 +541,9 @@ func (op *operation) pipeControlMessages() {
 		return nil
 	})
 
-	op.serverConn.SetPongHandler(func(data string) error {
+	server.conn.SetPongHandler(func(data string) error {
 		// We received a message from server, so we refresh read timeline
-		_ = op.serverConn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
+		_ = server.conn.SetReadDeadline(time.Now().Add(op.prx.PingPongTimeout))
 
 		// Forward pong to client
 		err := op.prx.writeControl(op.clientConn, websocket.PongMessage, []byte(data))
@@ -409,30 +554,12 @@ func (op *operation) pipeControlMessages() {
 		return nil
 	})
 
-	op.clientConn.SetCloseHandler(func(code int, text string) error {
+	server.conn.SetCloseHandler(func(code int, text string) error {
 		select {
-		case <-op.receivedClientClose:
+		case <-server.receivedClose:
 			return nil
 		default:
-			close(op.receivedClientClose)
-		}
-
-		// We answer Close back to client
-		var msg []byte
-		if code != websocket.CloseNoStatusReceived {
-			msg = websocket.FormatCloseMessage(code, "")
-		}
-		op.writeClientClose(msg, false)
-
-		return nil
-	})
-
-	op.serverConn.SetCloseHandler(func(code int, text string) error {
-		select {
-		case <-op.receivedServerClose:
-			return nil
-		default:
-			close(op.receivedServerClose)
+			close(server.receivedClose)
 		}
 
 		// We answer Close back to server
@@ -440,7 +567,12 @@ func (op *operation) pipeControlMessages() {
 		if code != websocket.CloseNoStatusReceived {
 			msg = websocket.FormatCloseMessage(code, "")
 		}
-		op.writeServerClose(msg, false)
+		server.writeCloseOnce.Do(func() {
+			err := op.prx.writeControl(server.conn, websocket.CloseMessage, msg)
+			if err != nil {
+				op.logger.WithError(err).Debug("error writing Close to server connection")
+			}
+		})
 
 		return nil
 	})
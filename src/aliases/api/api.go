@@ -8,15 +8,16 @@ import (
 )
 
 type AliasAPI struct {
-	alias aliasent.AliasBackend
+	connector aliasent.Connector
 }
 
-func New(alias aliasent.AliasBackend) *AliasAPI {
+func New(connector aliasent.Connector) *AliasAPI {
 	return &AliasAPI{
-		alias: alias,
+		connector: connector,
 	}
 }
 
 func (api *AliasAPI) Register(r *mux.Router) {
-	handlers.NewAliasHandler(api.alias).Register(r)
+	handlers.NewRegistryHandler(api.connector).Register(r)
+	handlers.NewAliasHandler(api.connector).Register(r)
 }
@@ -4,82 +4,105 @@ import (
 	"fmt"
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
 	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
 	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/gorilla/mux"
 )
 
 type AliasHandler struct {
-	alias aliasent.AliasBackend
+	connector aliasent.Connector
 }
 
-func NewAliasHandler(backend aliasent.AliasBackend) *AliasHandler {
+func NewAliasHandler(connector aliasent.Connector) *AliasHandler {
 	h := AliasHandler{
-		alias: backend,
+		connector: connector,
 	}
 
 	return &h
 }
 
 func (h *AliasHandler) Register(r *mux.Router) {
-	regRoute := r.PathPrefix("/registries/{registry_name}").Subrouter()
-	regRoute.HandleFunc("", h.deleteRegistry).Methods(http.MethodDelete)
-
-	alRoute := regRoute.PathPrefix("/aliases").Subrouter()
+	alRoute := r.PathPrefix("/registries/{registry_name}/aliases").Subrouter()
 	alRoute.HandleFunc("", h.listAliases).Methods(http.MethodGet)
+	alRoute.HandleFunc("", h.importAliases).Methods(http.MethodPut)
 	alRoute.HandleFunc("/{alias_key}", h.createAlias).Methods(http.MethodPost)
 	alRoute.HandleFunc("/{alias_key}", h.getAlias).Methods(http.MethodGet)
+	alRoute.HandleFunc("/{alias_key}/versions", h.listAliasVersions).Methods(http.MethodGet)
 	alRoute.HandleFunc("/{alias_key}", h.updateAlias).Methods(http.MethodPut)
 	alRoute.HandleFunc("/{alias_key}", h.deleteAlias).Methods(http.MethodDelete)
 }
 
-// @Summary Delete a registry
-// @Description Delete a registry and all its keys
-// @Tags Registries
+// @Summary Creates an alias
+// @Description Create an alias of a key in a dedicated alias registry
+// @Tags Aliases
+// @Accept json
+// @Produce json
 // @Param registry_name path string true "registry identifier"
-// @Success 204 "Deleted successfully"
+// @Param alias_key path string true "alias identifier"
+// @Param request body types.AliasRequest true "Create Alias Request"
+// @Success 200 {object} types.AliasResponse "Alias data"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
-// @Failure 404 {object} ErrorResponse "Registry not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 422 {object} ErrorResponse "Invalid alias value"
 // @Failure 500 {object} ErrorResponse "Internal server error"
-// @Router /registries/{registry_name} [delete]
-func (h *AliasHandler) deleteRegistry(w http.ResponseWriter, r *http.Request) {
+// @Router /registries/{registry_name}/aliases/{alias_key} [post]
+func (h *AliasHandler) createAlias(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	// should always exist in this subrouter
 	regName := vars["registry_name"]
+	key := vars["alias_key"]
 
-	err := validatePathVars(regName)
+	err := validatePathVars(regName, key)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
 		return
 	}
 
-	err = h.alias.DeleteRegistry(r.Context(), aliasent.RegistryName(regName))
+	var aliasReq types.AliasRequest
+	err = jsonutils.UnmarshalBody(r.Body, &aliasReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	eAlias := types.FormatAlias(types.RegistryName(regName), key, &aliasReq)
+	alias, err := h.aliases(r).CreateAlias(r.Context(), eAlias.RegistryName, eAlias)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(w, types.FormatAliasResponse(alias))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
-	w.Header().Set("X-Content-Type-Options", "nosniff")
-	w.WriteHeader(http.StatusNoContent)
 }
 
-// @Summary Creates an alias
-// @Description Create an alias of a key in a dedicated alias registry
+// @Summary Get an alias
+// @Description Get an alias of a key from a dedicated alias registry, a past value can be fetched by its version
 // @Tags Aliases
-// @Accept json
 // @Produce json
 // @Param registry_name path string true "registry identifier"
 // @Param alias_key path string true "alias identifier"
-// @Param request body types.AliasRequest true "Create Alias Request"
+// @Param version query int false "version of the alias, the current version by default"
 // @Success 200 {object} types.AliasResponse "Alias data"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 404 {object} ErrorResponse "Alias not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 500 {object} ErrorResponse "Internal server error"
-// @Router /registries/{registry_name}/aliases/{alias_key} [post]
-func (h *AliasHandler) createAlias(w http.ResponseWriter, r *http.Request) {
+// @Router /registries/{registry_name}/aliases/{alias_key} [get]
+func (h *AliasHandler) getAlias(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	// should always exist in this subrouter
 	regName := vars["registry_name"]
@@ -91,42 +114,43 @@ func (h *AliasHandler) createAlias(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var aliasReq types.AliasRequest
-	err = jsonutils.UnmarshalBody(r.Body, &aliasReq)
-	if err != nil {
-		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
-		return
+	var alias *aliasent.Alias
+	if v := r.URL.Query().Get("version"); v != "" {
+		version, err2 := strconv.Atoi(v)
+		if err2 != nil || version < 1 {
+			infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError("version must be a positive integer"))
+			return
+		}
+		alias, err = h.aliases(r).GetAliasVersion(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key), version)
+	} else {
+		alias, err = h.aliases(r).GetAlias(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key))
 	}
-
-	eAlias := types.FormatAlias(types.RegistryName(regName), key, aliasReq.Value)
-	alias, err := h.alias.CreateAlias(r.Context(), eAlias.RegistryName, eAlias)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
 
-	resp := types.AliasResponse{
-		Value: types.AliasValue(alias.Value),
-	}
-	err = infrahttp.WriteJSON(w, resp)
+	err = infrahttp.WriteJSON(w, types.FormatAliasResponse(alias))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
 }
 
-// @Summary Get an alias
-// @Description Get an alias of a key from a dedicated alias registry
+// @Summary List the versions of an alias
+// @Description List all the versions of an alias of a key from a dedicated alias registry, from the oldest to the current one
 // @Tags Aliases
 // @Produce json
 // @Param registry_name path string true "registry identifier"
 // @Param alias_key path string true "alias identifier"
-// @Success 200 {object} types.AliasResponse "Alias data"
+// @Success 200 {array} types.AliasResponse "a list of versions of the alias"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
 // @Failure 404 {object} ErrorResponse "Alias not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 500 {object} ErrorResponse "Internal server error"
-// @Router /registries/{registry_name}/aliases/{alias_key} [get]
-func (h *AliasHandler) getAlias(w http.ResponseWriter, r *http.Request) {
+// @Router /registries/{registry_name}/aliases/{alias_key}/versions [get]
+func (h *AliasHandler) listAliasVersions(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	// should always exist in this subrouter
 	regName := vars["registry_name"]
@@ -138,15 +162,13 @@ func (h *AliasHandler) getAlias(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	alias, err := h.alias.GetAlias(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key))
+	versions, err := h.aliases(r).ListAliasVersions(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
 
-	err = infrahttp.WriteJSON(w, types.AliasResponse{
-		Value: types.AliasValue(alias.Value),
-	})
+	err = infrahttp.WriteJSON(w, types.FormatAliasResponses(versions))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
@@ -165,6 +187,9 @@ func (h *AliasHandler) getAlias(w http.ResponseWriter, r *http.Request) {
 // @Success 200 {object} types.AliasResponse "Alias data"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
 // @Failure 404 {object} ErrorResponse "Alias not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 422 {object} ErrorResponse "Invalid alias value"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /registries/{registry_name}/aliases/{alias_key} [put]
 func (h *AliasHandler) updateAlias(w http.ResponseWriter, r *http.Request) {
@@ -186,21 +211,14 @@ func (h *AliasHandler) updateAlias(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	alias := &aliasent.Alias{
-		RegistryName: aliasent.RegistryName(regName),
-		Key:          aliasent.AliasKey(key),
-		Value:        aliasent.AliasValue(aliasReq.Value),
-	}
-
-	alias, err = h.alias.UpdateAlias(r.Context(), aliasent.RegistryName(regName), *alias)
+	eAlias := types.FormatAlias(types.RegistryName(regName), key, &aliasReq)
+	alias, err := h.aliases(r).UpdateAlias(r.Context(), eAlias.RegistryName, eAlias)
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
 
-	err = infrahttp.WriteJSON(w, types.AliasResponse{
-		Value: types.AliasValue(alias.Value),
-	})
+	err = infrahttp.WriteJSON(w, types.FormatAliasResponse(alias))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
@@ -216,6 +234,8 @@ func (h *AliasHandler) updateAlias(w http.ResponseWriter, r *http.Request) {
 // @Success 204 "Deleted successfully"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
 // @Failure 404 {object} ErrorResponse "Alias not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /registries/{registry_name}/aliases/{alias_key} [delete]
 func (h *AliasHandler) deleteAlias(w http.ResponseWriter, r *http.Request) {
@@ -230,7 +250,7 @@ func (h *AliasHandler) deleteAlias(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	err = h.alias.DeleteAlias(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key))
+	err = h.aliases(r).DeleteAlias(r.Context(), aliasent.RegistryName(regName), aliasent.AliasKey(key))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
@@ -240,14 +260,19 @@ func (h *AliasHandler) deleteAlias(w http.ResponseWriter, r *http.Request) {
 	w.WriteHeader(http.StatusNoContent)
 }
 
-// @Summary Get all the aliases in a registry
-// @Description Get all the aliases in a registry
+// @Summary Get the aliases in a registry
+// @Description Get the aliases in a registry, in order of creation. The aliases are exported in CSV if text/csv is accepted
 // @Tags Aliases
 // @Produce json
+// @Produce text/csv
 // @Param registry_name path string true "registry identifier"
-// @Param alias_key path string true "alias identifier"
+// @Param prefix query string false "prefix of the alias keys"
+// @Param limit query int false "page size, all the aliases by default"
+// @Param page query int false "page number"
 // @Success 200 {array} types.Alias "a list of Aliases"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /registries/{registry_name}/aliases [get]
 func (h *AliasHandler) listAliases(w http.ResponseWriter, r *http.Request) {
@@ -261,12 +286,25 @@ func (h *AliasHandler) listAliases(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	als, err := h.alias.ListAliases(r.Context(), aliasent.RegistryName(regName))
+	// Aliases are not paginated by default
+	limit, offset, err := getLimitOffset(r, "")
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
 		return
 	}
 
+	als, err := h.aliases(r).ListAliases(r.Context(), aliasent.RegistryName(regName), r.URL.Query().Get("prefix"), limit, offset)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	if acceptsCSV(r) {
+		w.Header().Set("Content-Type", csvContentType)
+		_ = types.WriteAliasesCSV(w, types.FormatEntityAliases(als))
+		return
+	}
+
 	err = infrahttp.WriteJSON(w, types.FormatEntityAliases(als))
 	if err != nil {
 		infrahttp.WriteHTTPErrorResponse(w, err)
@@ -274,6 +312,81 @@ func (h *AliasHandler) listAliases(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// @Summary Import the aliases of a registry
+// @Description Replace the aliases of a registry by the imported ones, the aliases missing from the import are deleted.
+// @Description The aliases are imported in JSON or in CSV with the columns key, kind, values and tags if the content type is text/csv.
+// @Description In dry run, the changes are returned without being applied
+// @Tags Aliases
+// @Accept json
+// @Accept text/csv
+// @Produce json
+// @Param registry_name path string true "registry identifier"
+// @Param dry_run query bool false "only compute the changes"
+// @Param request body types.ImportAliasesRequest true "Import Aliases Request"
+// @Success 200 {object} types.ImportAliasesResponse "Changes of the aliases"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 422 {object} ErrorResponse "Invalid alias value"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries/{registry_name}/aliases [put]
+func (h *AliasHandler) importAliases(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	// should always exist in this subrouter
+	regName := vars["registry_name"]
+
+	err := validatePathVars(regName)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	var aliasesReq types.ImportAliasesRequest
+	if strings.HasPrefix(r.Header.Get("Content-Type"), csvContentType) {
+		aliasesReq.Aliases, err = types.ParseAliasesCSV(r.Body)
+	} else {
+		err = jsonutils.UnmarshalBody(r.Body, &aliasesReq)
+	}
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	for _, alias := range aliasesReq.Aliases {
+		err = validatePathVars(string(alias.Key))
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+			return
+		}
+	}
+
+	dryRun := false
+	if v := r.URL.Query().Get("dry_run"); v != "" {
+		dryRun, err = strconv.ParseBool(v)
+		if err != nil {
+			infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError("invalid dry_run value"))
+			return
+		}
+	}
+
+	diff, err := h.aliases(r).ImportAliases(r.Context(), aliasent.RegistryName(regName), types.FormatImportAliases(regName, aliasesReq.Aliases), dryRun)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(w, types.FormatImportAliasesResponse(diff, dryRun))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+}
+
+// aliases returns the aliases accessible to the user of the request
+func (h *AliasHandler) aliases(r *http.Request) aliasent.AliasBackend {
+	return h.connector.Aliases(authenticator.UserInfoContextFromContext(r.Context()))
+}
+
 var pathVarsRegexCompileOnce sync.Once
 var pathVarsRegex *regexp.Regexp
 
@@ -295,3 +408,38 @@ func validatePathVars(pathVars ...string) error {
 	}
 	return nil
 }
+
+const csvContentType = "text/csv"
+
+func acceptsCSV(r *http.Request) bool {
+	return strings.Contains(r.Header.Get("Accept"), csvContentType)
+}
+
+// getLimitOffset returns the page requested, a page size of 0 meaning no limit
+func getLimitOffset(r *http.Request, defaultLimit string) (limit, offset uint64, err error) {
+	strLimit := r.URL.Query().Get("limit")
+	strPage := r.URL.Query().Get("page")
+	if strLimit == "" {
+		strLimit = defaultLimit
+	}
+	if strLimit == "" && strPage != "" {
+		strLimit = infrahttp.DefaultPageSize
+	}
+
+	if strLimit != "" {
+		limit, err = strconv.ParseUint(strLimit, 10, 64)
+		if err != nil {
+			return 0, 0, errors.InvalidFormatError("invalid limit value")
+		}
+	}
+
+	if strPage != "" {
+		page, err := strconv.ParseUint(strPage, 10, 64)
+		if err != nil {
+			return 0, 0, errors.InvalidFormatError("invalid page value")
+		}
+		offset = page * limit
+	}
+
+	return limit, offset, nil
+}
@@ -25,26 +25,32 @@ import (
 )
 
 type apiHelper struct {
-	ctx     context.Context
-	mock    *mock.MockAliasBackend
-	rec     *httptest.ResponseRecorder
-	router  *mux.Router
-	handler *aliasapi.AliasAPI
+	ctx        context.Context
+	mock       *mock.MockAliasBackend
+	registries *mock.MockRegistries
+	rec        *httptest.ResponseRecorder
+	router     *mux.Router
+	handler    *aliasapi.AliasAPI
 }
 
 func newAPIHelper(t *testing.T) *apiHelper {
 	ctrl := gomock.NewController(t)
 	store := mock.NewMockAliasBackend(ctrl)
-	handler := aliasapi.New(store)
+	registries := mock.NewMockRegistries(ctrl)
+	connector := mock.NewMockConnector(ctrl)
+	connector.EXPECT().Aliases(gomock.Any()).Return(store).AnyTimes()
+	connector.EXPECT().Registries(gomock.Any()).Return(registries).AnyTimes()
+	handler := aliasapi.New(connector)
 	router := mux.NewRouter()
 	handler.Register(router)
 
 	return &apiHelper{
-		ctx:     context.Background(),
-		mock:    store,
-		rec:     httptest.NewRecorder(),
-		router:  router,
-		handler: handler,
+		ctx:        context.Background(),
+		mock:       store,
+		registries: registries,
+		rec:        httptest.NewRecorder(),
+		router:     router,
+		handler:    handler,
 	}
 }
 
@@ -53,13 +59,17 @@ const nonExistingKey = "non_existing_key"
 type Case struct {
 	reg   string
 	key   string
-	value string
+	value types.AliasValue
 
 	status int
 }
 
 func defaultCase() Case {
-	return Case{"my-registry", "group-A", `[ “ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=”, ”2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0=” ]`, http.StatusOK}
+	value := types.AliasValue{
+		Kind:   "tessera",
+		Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="},
+	}
+	return Case{"my-registry", "group-A", value, http.StatusOK}
 }
 
 func TestCreateAlias(t *testing.T) {
@@ -68,7 +78,7 @@ func TestCreateAlias(t *testing.T) {
 		helper := newAPIHelper(t)
 		c := defaultCase()
 		req := types.AliasRequest{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		}
 		var b bytes.Buffer
 		err := json.NewEncoder(&b).Encode(req)
@@ -90,7 +100,50 @@ func TestCreateAlias(t *testing.T) {
 		err = json.Unmarshal(res, &resp)
 		require.NoError(t, err)
 
-		assert.Equal(t, types.AliasResponse{Value: types.AliasValue(c.value)}, resp)
+		assert.Equal(t, types.AliasResponse{Value: c.value}, resp)
+	})
+
+	t.Run("legacy value with tags", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		c := defaultCase()
+
+		ent := newEntAlias(c.reg, c.key, c.value)
+		ent.Tags = map[string]string{"env": "production"}
+		helper.mock.EXPECT().CreateAlias(gomock.Any(), ent.RegistryName, ent).Return(&ent, nil)
+
+		path := fmt.Sprintf("/registries/%s/aliases/%s", c.reg, c.key)
+		body := `{"value": "[\"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=\", \"2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0=\"]", "tags": {"env": "production"}}`
+		r, err := newJSONRequest(helper.ctx, "POST", path, strings.NewReader(body))
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+	})
+
+	t.Run("invalid value", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		c := defaultCase()
+		c.value.Values = []string{"0123"}
+		c.status = http.StatusUnprocessableEntity
+
+		req := types.AliasRequest{
+			Value: c.value,
+		}
+		var b bytes.Buffer
+		err := json.NewEncoder(&b).Encode(req)
+		require.NoError(t, err)
+
+		ent := newEntAlias(c.reg, c.key, c.value)
+		helper.mock.EXPECT().CreateAlias(gomock.Any(), ent.RegistryName, ent).Return(nil, errors.InvalidParameterError("invalid tessera value"))
+
+		path := fmt.Sprintf("/registries/%s/aliases/%s", c.reg, c.key)
+		r, err := newJSONRequest(helper.ctx, "POST", path, &b)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
 	})
 
 	t.Run("already existing alias", func(t *testing.T) {
@@ -101,7 +154,7 @@ func TestCreateAlias(t *testing.T) {
 		c.status = http.StatusConflict
 
 		req := types.AliasRequest{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		}
 		var b bytes.Buffer
 		err := json.NewEncoder(&b).Encode(req)
@@ -130,7 +183,7 @@ func TestUpdateAlias(t *testing.T) {
 
 		c := defaultCase()
 		req := types.AliasRequest{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		}
 		var b bytes.Buffer
 		err := json.NewEncoder(&b).Encode(req)
@@ -153,7 +206,7 @@ func TestUpdateAlias(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, types.AliasResponse{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		},
 			resp)
 	})
@@ -166,7 +219,7 @@ func TestUpdateAlias(t *testing.T) {
 		c.key = nonExistingKey
 		c.status = http.StatusNotFound
 		alias := types.AliasRequest{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		}
 		var b bytes.Buffer
 		err := json.NewEncoder(&b).Encode(alias)
@@ -210,7 +263,7 @@ func TestGetAlias(t *testing.T) {
 		require.NoError(t, err)
 
 		assert.Equal(t, types.AliasResponse{
-			Value: types.AliasValue(c.value),
+			Value: c.value,
 		}, resp)
 
 	})
@@ -222,7 +275,7 @@ func TestGetAlias(t *testing.T) {
 		c := defaultCase()
 		c.key = nonExistingKey
 		c.status = http.StatusNotFound
-		ent := newEntAlias(c.reg, c.key, "")
+		ent := newEntAlias(c.reg, c.key, types.AliasValue{})
 		helper.mock.EXPECT().GetAlias(gomock.Any(), ent.RegistryName, ent.Key).Return(nil, errors.NotFoundError(""))
 
 		path := fmt.Sprintf("/registries/%s/aliases/%s", c.reg, c.key)
@@ -237,6 +290,67 @@ func TestGetAlias(t *testing.T) {
 	})
 }
 
+func TestGetAliasVersion(t *testing.T) {
+	t.Run("past version", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		ent := newEntAlias(c.reg, c.key, c.value)
+		ent.Version = 2
+		helper.mock.EXPECT().GetAliasVersion(gomock.Any(), ent.RegistryName, ent.Key, 2).Return(&ent, nil)
+
+		path := fmt.Sprintf("/registries/%s/aliases/%s?version=2", c.reg, c.key)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+
+		var resp types.AliasResponse
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, types.AliasResponse{Value: c.value, Version: 2}, resp)
+	})
+
+	t.Run("invalid version", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		path := fmt.Sprintf("/registries/%s/aliases/%s?version=latest", c.reg, c.key)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusBadRequest, helper.rec.Code)
+	})
+
+	t.Run("list of versions", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		v1 := newEntAlias(c.reg, c.key, types.AliasValue{Kind: "tessera", Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}})
+		v1.Version = 1
+		v2 := newEntAlias(c.reg, c.key, c.value)
+		v2.Version = 2
+		helper.mock.EXPECT().ListAliasVersions(gomock.Any(), v1.RegistryName, v1.Key).Return([]aliasent.Alias{v1, v2}, nil)
+
+		path := fmt.Sprintf("/registries/%s/aliases/%s/versions", c.reg, c.key)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+
+		var resp []types.AliasResponse
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, types.FormatAliasResponses([]aliasent.Alias{v1, v2}), resp)
+	})
+}
+
 func TestDeleteAlias(t *testing.T) {
 	t.Run("one element", func(t *testing.T) {
 		t.Parallel()
@@ -261,7 +375,7 @@ func TestDeleteAlias(t *testing.T) {
 		c := defaultCase()
 		c.key = nonExistingKey
 		c.status = http.StatusNotFound
-		ent := newEntAlias(c.reg, c.key, "")
+		ent := newEntAlias(c.reg, c.key, types.AliasValue{})
 		helper.mock.EXPECT().DeleteAlias(gomock.Any(), ent.RegistryName, ent.Key).Return(errors.NotFoundError(""))
 
 		path := fmt.Sprintf("/registries/%s/aliases/%s", c.reg, c.key)
@@ -285,7 +399,7 @@ func TestListAliases(t *testing.T) {
 		c := defaultCase()
 		c.reg = "non_existing_registry"
 		c.status = http.StatusNotFound
-		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg)).Return(nil, errors.NotFoundError(""))
+		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg), "", uint64(0), uint64(0)).Return(nil, errors.NotFoundError(""))
 
 		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
 		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
@@ -304,7 +418,7 @@ func TestListAliases(t *testing.T) {
 
 		c := defaultCase()
 		var ents []aliasent.Alias
-		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg)).Return(ents, nil)
+		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg), "", uint64(0), uint64(0)).Return(ents, nil)
 
 		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
 		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
@@ -329,15 +443,18 @@ func TestListAliases(t *testing.T) {
 		c := defaultCase()
 		ents := []aliasent.Alias{
 			{
-				Key:   "JPM",
-				Value: `[ "ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=" ]`,
+				Key:     "JPM",
+				Value:   aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}},
+				Version: 1,
 			},
 			{
-				Key:   "GS",
-				Value: `[ "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0=" ]`,
+				Key:     "GS",
+				Value:   aliasent.AliasValue{Kind: aliasent.KindEthAddress, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12a2"}},
+				Tags:    map[string]string{"env": "production"},
+				Version: 3,
 			},
 		}
-		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg)).Return(ents, nil)
+		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg), "", uint64(0), uint64(0)).Return(ents, nil)
 
 		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
 		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
@@ -353,6 +470,131 @@ func TestListAliases(t *testing.T) {
 	})
 }
 
+func TestListAliasesPage(t *testing.T) {
+	t.Run("page of aliases with a prefix", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		ents := []aliasent.Alias{newEntAlias("", "group-B", c.value)}
+		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg), "group-", uint64(10), uint64(20)).Return(ents, nil)
+
+		path := fmt.Sprintf("/registries/%s/aliases?prefix=group-&limit=10&page=2", c.reg)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+
+		var als []types.Alias
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &als)
+		require.NoError(t, err)
+		assert.Equal(t, types.FormatEntityAliases(ents), als)
+	})
+
+	t.Run("invalid limit", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		path := fmt.Sprintf("/registries/%s/aliases?limit=ten", c.reg)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusBadRequest, helper.rec.Code)
+	})
+
+	t.Run("export in CSV", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		ent := newEntAlias(c.reg, c.key, c.value)
+		ent.Tags = map[string]string{"env": "production"}
+		helper.mock.EXPECT().ListAliases(gomock.Any(), aliasent.RegistryName(c.reg), "", uint64(0), uint64(0)).Return([]aliasent.Alias{ent}, nil)
+
+		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
+		r, err := newJSONRequest(helper.ctx, "GET", path, nil)
+		require.NoError(t, err)
+		r.Header.Set("Accept", "text/csv")
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+		assert.Equal(t, "text/csv", helper.rec.Header().Get("Content-Type"))
+		assert.Equal(t, "key,kind,values,tags\ngroup-A,tessera,ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=;2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0=,env=production\n", helper.rec.Body.String())
+	})
+}
+
+func TestImportAliases(t *testing.T) {
+	t.Run("dry run from JSON", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		ent := newEntAlias(c.reg, c.key, c.value)
+		diff := &aliasent.AliasesDiff{Created: []aliasent.Alias{ent}, Updated: []aliasent.Alias{}, Deleted: []aliasent.Alias{}, Unchanged: 3}
+		helper.mock.EXPECT().ImportAliases(gomock.Any(), aliasent.RegistryName(c.reg), []aliasent.Alias{ent}, true).Return(diff, nil)
+
+		var b bytes.Buffer
+		err := json.NewEncoder(&b).Encode(types.ImportAliasesRequest{Aliases: []types.ImportAlias{{Key: types.AliasKey(c.key), Value: c.value}}})
+		require.NoError(t, err)
+
+		path := fmt.Sprintf("/registries/%s/aliases?dry_run=true", c.reg)
+		r, err := newJSONRequest(helper.ctx, "PUT", path, &b)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+
+		var resp types.ImportAliasesResponse
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, types.ImportAliasesResponse{
+			DryRun:    true,
+			Created:   []types.ImportAlias{{Key: types.AliasKey(c.key), Value: c.value}},
+			Updated:   []types.ImportAlias{},
+			Deleted:   []types.ImportAlias{},
+			Unchanged: 3,
+		}, resp)
+	})
+
+	t.Run("from CSV", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		ent := newEntAlias(c.reg, c.key, c.value)
+		ent.Tags = map[string]string{"env": "production"}
+		diff := &aliasent.AliasesDiff{Updated: []aliasent.Alias{ent}}
+		helper.mock.EXPECT().ImportAliases(gomock.Any(), aliasent.RegistryName(c.reg), []aliasent.Alias{ent}, false).Return(diff, nil)
+
+		body := "key,kind,values,tags\ngroup-A,tessera,ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=;2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0=,env=production\n"
+		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
+		r, err := newJSONRequest(helper.ctx, "PUT", path, strings.NewReader(body))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", "text/csv")
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, c.status, helper.rec.Code)
+	})
+
+	t.Run("invalid alias key", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		c := defaultCase()
+		body := "key,kind,values\nbad@key,tessera,ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=\n"
+		path := fmt.Sprintf("/registries/%s/aliases", c.reg)
+		r, err := newJSONRequest(helper.ctx, "PUT", path, strings.NewReader(body))
+		require.NoError(t, err)
+		r.Header.Set("Content-Type", "text/csv")
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusBadRequest, helper.rec.Code)
+	})
+}
+
 func TestPathValidate(t *testing.T) {
 	cases := []struct {
 		path string
@@ -412,12 +654,12 @@ func TestJSONHeader(t *testing.T) {
 			r, err := newJSONRequest(helper.ctx, c.method, c.path, input)
 			require.NoError(t, err)
 
-			ent := newEntAlias("1", "1", `[ \"0123\" ]`)
+			ent := newEntAlias("1", "1", types.AliasValue{Kind: "tessera", Values: []string{"0123"}})
 			// accept any call just to make the test work
 			helper.mock.EXPECT().CreateAlias(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&ent, nil)
 			helper.mock.EXPECT().GetAlias(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&ent, nil)
 			helper.mock.EXPECT().UpdateAlias(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return(&ent, nil)
-			helper.mock.EXPECT().ListAliases(gomock.Any(), gomock.Any()).AnyTimes().Return([]aliasent.Alias{ent}, nil)
+			helper.mock.EXPECT().ListAliases(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().Return([]aliasent.Alias{ent}, nil)
 
 			helper.router.ServeHTTP(helper.rec, r)
 			assert.Contains(t, helper.rec.Result().Header.Values("Content-Type"), "application/json")
@@ -425,11 +667,11 @@ func TestJSONHeader(t *testing.T) {
 	}
 }
 
-func newEntAlias(registry, key, value string) aliasent.Alias {
+func newEntAlias(registry, key string, value types.AliasValue) aliasent.Alias {
 	return aliasent.Alias{
 		RegistryName: aliasent.RegistryName(registry),
 		Key:          aliasent.AliasKey(key),
-		Value:        aliasent.AliasValue(value),
+		Value:        aliasent.AliasValue{Kind: aliasent.AliasKind(value.Kind), Values: value.Values},
 	}
 }
 
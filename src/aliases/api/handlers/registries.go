@@ -0,0 +1,223 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+type RegistryHandler struct {
+	connector aliasent.Connector
+}
+
+func NewRegistryHandler(connector aliasent.Connector) *RegistryHandler {
+	h := RegistryHandler{
+		connector: connector,
+	}
+
+	return &h
+}
+
+func (h *RegistryHandler) Register(r *mux.Router) {
+	r.HandleFunc("/registries", h.listRegistries).Methods(http.MethodGet)
+	r.HandleFunc("/registries/{registry_name}", h.createRegistry).Methods(http.MethodPost)
+	r.HandleFunc("/registries/{registry_name}", h.getRegistry).Methods(http.MethodGet)
+	r.HandleFunc("/registries/{registry_name}", h.updateRegistry).Methods(http.MethodPatch)
+	r.HandleFunc("/registries/{registry_name}", h.deleteRegistry).Methods(http.MethodDelete)
+}
+
+// @Summary List the registries
+// @Description List the registries accessible to the user, in order of creation
+// @Tags Registries
+// @Produce json
+// @Param prefix query string false "prefix of the registry names"
+// @Param limit query int false "page size"
+// @Param page query int false "page number"
+// @Success 200 {array} PageResponse "Registry list"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries [get]
+func (h *RegistryHandler) listRegistries(w http.ResponseWriter, r *http.Request) {
+	limit, offset, err := getLimitOffset(r, infrahttp.DefaultPageSize)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	regs, err := h.registries(r).ListRegistries(r.Context(), r.URL.Query().Get("prefix"), limit, offset)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	_ = infrahttp.WritePagingResponse(w, r, types.FormatRegistryResponses(regs))
+}
+
+// @Summary Create a registry
+// @Description Create an empty registry with its metadata
+// @Tags Registries
+// @Accept json
+// @Produce json
+// @Param registry_name path string true "registry identifier"
+// @Param request body types.CreateRegistryRequest true "Create Registry Request"
+// @Success 200 {object} types.RegistryResponse "Registry data"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 409 {object} ErrorResponse "Registry already exists"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries/{registry_name} [post]
+func (h *RegistryHandler) createRegistry(w http.ResponseWriter, r *http.Request) {
+	regName := mux.Vars(r)["registry_name"]
+
+	err := validatePathVars(regName)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	var regReq types.CreateRegistryRequest
+	err = jsonutils.UnmarshalBody(r.Body, &regReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	reg, err := h.registries(r).CreateRegistry(r.Context(), types.FormatCreateRegistryRequest(regName, &regReq))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(w, types.FormatRegistryResponse(reg))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+}
+
+// @Summary Get a registry
+// @Description Get the metadata of a registry
+// @Tags Registries
+// @Produce json
+// @Param registry_name path string true "registry identifier"
+// @Success 200 {object} types.RegistryResponse "Registry data"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 404 {object} ErrorResponse "Registry not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries/{registry_name} [get]
+func (h *RegistryHandler) getRegistry(w http.ResponseWriter, r *http.Request) {
+	regName := mux.Vars(r)["registry_name"]
+
+	err := validatePathVars(regName)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	reg, err := h.registries(r).GetRegistry(r.Context(), aliasent.RegistryName(regName))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(w, types.FormatRegistryResponse(reg))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+}
+
+// @Summary Update a registry
+// @Description Update the description, the owner or the allowed tenants of a registry, only the owner can update it
+// @Tags Registries
+// @Accept json
+// @Produce json
+// @Param registry_name path string true "registry identifier"
+// @Param request body types.UpdateRegistryRequest true "Update Registry Request"
+// @Success 200 {object} types.RegistryResponse "Registry data"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 404 {object} ErrorResponse "Registry not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries/{registry_name} [patch]
+func (h *RegistryHandler) updateRegistry(w http.ResponseWriter, r *http.Request) {
+	regName := mux.Vars(r)["registry_name"]
+
+	err := validatePathVars(regName)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	var regReq types.UpdateRegistryRequest
+	err = jsonutils.UnmarshalBody(r.Body, &regReq)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	registries := h.registries(r)
+	reg, err := registries.GetRegistry(r.Context(), aliasent.RegistryName(regName))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	reg, err = registries.UpdateRegistry(r.Context(), types.ApplyUpdateRegistryRequest(*reg, &regReq))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+
+	err = infrahttp.WriteJSON(w, types.FormatRegistryResponse(reg))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+}
+
+// @Summary Delete a registry
+// @Description Delete a registry and all its keys
+// @Tags Registries
+// @Param registry_name path string true "registry identifier"
+// @Success 204 "Deleted successfully"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 404 {object} ErrorResponse "Registry not found"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /registries/{registry_name} [delete]
+func (h *RegistryHandler) deleteRegistry(w http.ResponseWriter, r *http.Request) {
+	regName := mux.Vars(r)["registry_name"]
+
+	err := validatePathVars(regName)
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	err = h.connector.Aliases(authenticator.UserInfoContextFromContext(r.Context())).DeleteRegistry(r.Context(), aliasent.RegistryName(regName))
+	if err != nil {
+		infrahttp.WriteHTTPErrorResponse(w, err)
+		return
+	}
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// registries returns the registries accessible to the user of the request
+func (h *RegistryHandler) registries(r *http.Request) aliasent.Registries {
+	return h.connector.Registries(authenticator.UserInfoContextFromContext(r.Context()))
+}
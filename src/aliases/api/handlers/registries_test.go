@@ -0,0 +1,121 @@
+package handlers_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/aliases/api/types"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	infrahttp "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistries(t *testing.T) {
+	registry := aliasent.Registry{
+		Name:           "my-registry",
+		Description:    "participants",
+		Tenant:         "tenant-A",
+		AllowedTenants: []string{"tenant-B"},
+		CreatedBy:      "alice",
+	}
+
+	t.Run("list registries", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		helper.registries.EXPECT().ListRegistries(gomock.Any(), "my-", uint64(100), uint64(0)).Return([]aliasent.Registry{registry}, nil)
+
+		r, err := newJSONRequest(helper.ctx, "GET", "/registries?prefix=my-", nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusOK, helper.rec.Code)
+
+		var resp struct {
+			Data   []types.RegistryResponse     `json:"data"`
+			Paging infrahttp.PagePagingResponse `json:"paging"`
+		}
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, []types.RegistryResponse{types.FormatRegistryResponse(&registry)}, resp.Data)
+		assert.Empty(t, resp.Paging.Next)
+	})
+
+	t.Run("create a registry", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		helper.registries.EXPECT().CreateRegistry(gomock.Any(), aliasent.Registry{
+			Name:           "my-registry",
+			Description:    "participants",
+			AllowedTenants: []string{"tenant-B"},
+		}).Return(&registry, nil)
+
+		body := `{"description": "participants", "allowedTenants": ["tenant-B"]}`
+		r, err := newJSONRequest(helper.ctx, "POST", "/registries/my-registry", strings.NewReader(body))
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusOK, helper.rec.Code)
+
+		var resp types.RegistryResponse
+		err = json.Unmarshal(helper.rec.Body.Bytes(), &resp)
+		require.NoError(t, err)
+		assert.Equal(t, types.FormatRegistryResponse(&registry), resp)
+	})
+
+	t.Run("create an existing registry", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		helper.registries.EXPECT().CreateRegistry(gomock.Any(), gomock.Any()).Return(nil, errors.StatusConflictError("conflict"))
+
+		r, err := newJSONRequest(helper.ctx, "POST", "/registries/my-registry", strings.NewReader(`{}`))
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusConflict, helper.rec.Code)
+	})
+
+	t.Run("update the allowed tenants of a registry", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+
+		updated := registry
+		updated.AllowedTenants = []string{}
+		helper.registries.EXPECT().GetRegistry(gomock.Any(), registry.Name).Return(&registry, nil)
+		helper.registries.EXPECT().UpdateRegistry(gomock.Any(), updated).Return(&updated, nil)
+
+		r, err := newJSONRequest(helper.ctx, "PATCH", "/registries/my-registry", strings.NewReader(`{"allowedTenants": []}`))
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusOK, helper.rec.Code)
+	})
+
+	t.Run("get a missing registry", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		helper.registries.EXPECT().GetRegistry(gomock.Any(), registry.Name).Return(nil, errors.NotFoundError("not found"))
+
+		r, err := newJSONRequest(helper.ctx, "GET", "/registries/my-registry", nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusNotFound, helper.rec.Code)
+	})
+
+	t.Run("delete a registry", func(t *testing.T) {
+		t.Parallel()
+		helper := newAPIHelper(t)
+		helper.mock.EXPECT().DeleteRegistry(gomock.Any(), registry.Name).Return(nil)
+
+		r, err := newJSONRequest(helper.ctx, "DELETE", "/registries/my-registry", nil)
+		require.NoError(t, err)
+
+		helper.router.ServeHTTP(helper.rec, r)
+		assert.Equal(t, http.StatusNoContent, helper.rec.Code)
+	})
+}
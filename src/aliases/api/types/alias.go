@@ -1,10 +1,21 @@
 package types
 
-import aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+import (
+	"encoding/json"
+	"time"
+
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+)
 
 type Alias struct {
-	Key   AliasKey   `json:"key"`
-	Value AliasValue `json:"value"`
+	Key       AliasKey          `json:"key"`
+	Value     AliasValue        `json:"value"`
+	Tags      map[string]string `json:"tags,omitempty" example:"env:production"`
+	Version   int               `json:"version" example:"2"`
+	CreatedBy string            `json:"createdBy,omitempty" example:"alice"`
+	UpdatedBy string            `json:"updatedBy,omitempty" example:"bob"`
+	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 
 	registryName RegistryName
 }
@@ -13,15 +24,25 @@ func FormatEntityAlias(ent aliasent.Alias) Alias {
 	return Alias{
 		registryName: RegistryName(ent.RegistryName),
 		Key:          AliasKey(ent.Key),
-		Value:        AliasValue(ent.Value),
+		Value:        FormatEntityAliasValue(ent.Value),
+		Tags:         ent.Tags,
+		Version:      ent.Version,
+		CreatedBy:    ent.CreatedBy,
+		UpdatedBy:    ent.UpdatedBy,
+		CreatedAt:    ent.CreatedAt,
+		UpdatedAt:    ent.UpdatedAt,
 	}
 }
 
-func FormatAlias(registry RegistryName, key string, value AliasValue) aliasent.Alias {
+func FormatAlias(registry RegistryName, key string, req *AliasRequest) aliasent.Alias {
 	return aliasent.Alias{
 		RegistryName: aliasent.RegistryName(registry),
 		Key:          aliasent.AliasKey(key),
-		Value:        aliasent.AliasValue(value),
+		Value: aliasent.AliasValue{
+			Kind:   aliasent.AliasKind(req.Value.Kind),
+			Values: req.Value.Values,
+		},
+		Tags: req.Tags,
 	}
 }
 
@@ -34,16 +55,70 @@ func FormatEntityAliases(ents []aliasent.Alias) []Alias {
 	return als
 }
 
-type AliasValue string
+// AliasValue holds one or several values of the same kind.
+// Kinds are "tessera" for Tessera/Orion public keys, "ethereum" for Ethereum addresses,
+// "key" for key manager keys referenced as {store}/{key_id} and "privacy_group" for privacy group IDs
+type AliasValue struct {
+	Kind   string   `json:"kind" example:"tessera"`
+	Values []string `json:"values" example:"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="`
+}
+
+// UnmarshalJSON also accepts the untyped values of the previous API, a JSON string holding Tessera keys
+func (v *AliasValue) UnmarshalJSON(data []byte) error {
+	var legacy string
+	if err := json.Unmarshal(data, &legacy); err == nil {
+		value := aliasent.ParseLegacyValue(legacy)
+		*v = FormatEntityAliasValue(value)
+		return nil
+	}
+
+	type aliasValue AliasValue
+	return json.Unmarshal(data, (*aliasValue)(v))
+}
+
+func FormatEntityAliasValue(ent aliasent.AliasValue) AliasValue {
+	return AliasValue{
+		Kind:   string(ent.Kind),
+		Values: ent.Values,
+	}
+}
 
 type AliasKey string
 
 type RegistryName string
 
 type AliasRequest struct {
-	Value AliasValue `json:"value"`
+	Value AliasValue        `json:"value"`
+	Tags  map[string]string `json:"tags,omitempty" example:"env:production"`
 }
 
 type AliasResponse struct {
-	Value AliasValue `json:"value"`
+	Value     AliasValue        `json:"value"`
+	Tags      map[string]string `json:"tags,omitempty" example:"env:production"`
+	Version   int               `json:"version" example:"2"`
+	CreatedBy string            `json:"createdBy,omitempty" example:"alice"`
+	UpdatedBy string            `json:"updatedBy,omitempty" example:"bob"`
+	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func FormatAliasResponse(ent *aliasent.Alias) AliasResponse {
+	return AliasResponse{
+		Value:     FormatEntityAliasValue(ent.Value),
+		Tags:      ent.Tags,
+		Version:   ent.Version,
+		CreatedBy: ent.CreatedBy,
+		UpdatedBy: ent.UpdatedBy,
+		CreatedAt: ent.CreatedAt,
+		UpdatedAt: ent.UpdatedAt,
+	}
+}
+
+func FormatAliasResponses(ents []aliasent.Alias) []AliasResponse {
+	var resps = []AliasResponse{}
+	for i := range ents {
+		resps = append(resps, FormatAliasResponse(&ents[i]))
+	}
+
+	return resps
 }
@@ -0,0 +1,126 @@
+package types
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+)
+
+// Aliases are imported and exported in CSV with a header row and the columns key, kind, values and tags.
+// The values of an alias are separated by semicolons, its tags are written as name=value pairs separated by semicolons
+var csvHeader = []string{"key", "kind", "values", "tags"}
+
+const csvSeparator = ";"
+
+// ParseAliasesCSV parses aliases from CSV, the tags column is optional
+func ParseAliasesCSV(r io.Reader) ([]ImportAlias, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, errors.InvalidFormatError("missing CSV header")
+	}
+
+	columns := map[string]int{}
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, name := range csvHeader[:3] {
+		if _, ok := columns[name]; !ok {
+			return nil, errors.InvalidFormatError("missing CSV column %q", name)
+		}
+	}
+
+	als := []ImportAlias{}
+	for line := 2; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid CSV: %s", err.Error())
+		}
+
+		field := func(name string) string {
+			i, ok := columns[name]
+			if !ok || i >= len(record) {
+				return ""
+			}
+			return strings.TrimSpace(record[i])
+		}
+
+		alias := ImportAlias{
+			Key: AliasKey(field("key")),
+			Value: AliasValue{
+				Kind:   field("kind"),
+				Values: splitCSVList(field("values")),
+			},
+		}
+		if alias.Key == "" {
+			return nil, errors.InvalidFormatError("missing key on CSV line %d", line)
+		}
+
+		for _, tag := range splitCSVList(field("tags")) {
+			parts := strings.SplitN(tag, "=", 2)
+			if len(parts) != 2 {
+				return nil, errors.InvalidFormatError("invalid tag %q on CSV line %d", tag, line)
+			}
+			if alias.Tags == nil {
+				alias.Tags = map[string]string{}
+			}
+			alias.Tags[parts[0]] = parts[1]
+		}
+
+		als = append(als, alias)
+	}
+
+	return als, nil
+}
+
+// WriteAliasesCSV writes aliases in CSV
+func WriteAliasesCSV(w io.Writer, als []Alias) error {
+	writer := csv.NewWriter(w)
+
+	err := writer.Write(csvHeader)
+	if err != nil {
+		return err
+	}
+
+	for _, alias := range als {
+		tags := make([]string, 0, len(alias.Tags))
+		for name, value := range alias.Tags {
+			tags = append(tags, fmt.Sprintf("%s=%s", name, value))
+		}
+		sort.Strings(tags)
+
+		err = writer.Write([]string{
+			string(alias.Key),
+			alias.Value.Kind,
+			strings.Join(alias.Value.Values, csvSeparator),
+			strings.Join(tags, csvSeparator),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+func splitCSVList(s string) []string {
+	items := []string{}
+	for _, item := range strings.Split(s, csvSeparator) {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
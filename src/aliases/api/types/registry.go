@@ -0,0 +1,134 @@
+package types
+
+import (
+	"time"
+
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+)
+
+type CreateRegistryRequest struct {
+	Description string `json:"description,omitempty" example:"Tessera keys of the participants"`
+	// Owner is the tenant owning the registry, the tenant of the user by default. Only administrators can create registries for other tenants
+	Owner          string   `json:"owner,omitempty" example:"tenant-A"`
+	AllowedTenants []string `json:"allowedTenants,omitempty" example:"tenant-B"`
+}
+
+// UpdateRegistryRequest updates the given fields of a registry, an empty owner makes the registry accessible to all tenants
+type UpdateRegistryRequest struct {
+	Description    *string   `json:"description,omitempty" example:"Tessera keys of the participants"`
+	Owner          *string   `json:"owner,omitempty" example:"tenant-A"`
+	AllowedTenants *[]string `json:"allowedTenants,omitempty" example:"tenant-B"`
+}
+
+type RegistryResponse struct {
+	Name           RegistryName `json:"name" example:"my-registry"`
+	Description    string       `json:"description,omitempty" example:"Tessera keys of the participants"`
+	Owner          string       `json:"owner,omitempty" example:"tenant-A"`
+	AllowedTenants []string     `json:"allowedTenants,omitempty" example:"tenant-B"`
+	CreatedBy      string       `json:"createdBy,omitempty" example:"alice"`
+	CreatedAt      time.Time    `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+}
+
+func FormatCreateRegistryRequest(name string, req *CreateRegistryRequest) aliasent.Registry {
+	return aliasent.Registry{
+		Name:           aliasent.RegistryName(name),
+		Description:    req.Description,
+		Tenant:         req.Owner,
+		AllowedTenants: req.AllowedTenants,
+	}
+}
+
+// ApplyUpdateRegistryRequest returns the registry updated with the fields of the request
+func ApplyUpdateRegistryRequest(registry aliasent.Registry, req *UpdateRegistryRequest) aliasent.Registry {
+	if req.Description != nil {
+		registry.Description = *req.Description
+	}
+	if req.Owner != nil {
+		registry.Tenant = *req.Owner
+	}
+	if req.AllowedTenants != nil {
+		registry.AllowedTenants = *req.AllowedTenants
+	}
+
+	return registry
+}
+
+func FormatRegistryResponse(ent *aliasent.Registry) RegistryResponse {
+	return RegistryResponse{
+		Name:           RegistryName(ent.Name),
+		Description:    ent.Description,
+		Owner:          ent.Tenant,
+		AllowedTenants: ent.AllowedTenants,
+		CreatedBy:      ent.CreatedBy,
+		CreatedAt:      ent.CreatedAt,
+	}
+}
+
+func FormatRegistryResponses(ents []aliasent.Registry) []RegistryResponse {
+	var resps = []RegistryResponse{}
+	for i := range ents {
+		resps = append(resps, FormatRegistryResponse(&ents[i]))
+	}
+
+	return resps
+}
+
+// ImportAlias is an alias imported in bulk into a registry
+type ImportAlias struct {
+	Key   AliasKey          `json:"key" validate:"required" example:"group-A"`
+	Value AliasValue        `json:"value"`
+	Tags  map[string]string `json:"tags,omitempty" example:"env:production"`
+}
+
+type ImportAliasesRequest struct {
+	Aliases []ImportAlias `json:"aliases" validate:"dive"`
+}
+
+// ImportAliasesResponse lists the aliases created, updated and deleted by an import
+type ImportAliasesResponse struct {
+	DryRun    bool          `json:"dryRun" example:"true"`
+	Created   []ImportAlias `json:"created"`
+	Updated   []ImportAlias `json:"updated"`
+	Deleted   []ImportAlias `json:"deleted"`
+	Unchanged int           `json:"unchanged" example:"120"`
+}
+
+func FormatImportAliases(registry string, als []ImportAlias) []aliasent.Alias {
+	ents := []aliasent.Alias{}
+	for i := range als {
+		ents = append(ents, aliasent.Alias{
+			RegistryName: aliasent.RegistryName(registry),
+			Key:          aliasent.AliasKey(als[i].Key),
+			Value: aliasent.AliasValue{
+				Kind:   aliasent.AliasKind(als[i].Value.Kind),
+				Values: als[i].Value.Values,
+			},
+			Tags: als[i].Tags,
+		})
+	}
+
+	return ents
+}
+
+func FormatImportAliasesResponse(diff *aliasent.AliasesDiff, dryRun bool) ImportAliasesResponse {
+	return ImportAliasesResponse{
+		DryRun:    dryRun,
+		Created:   formatImportedAliases(diff.Created),
+		Updated:   formatImportedAliases(diff.Updated),
+		Deleted:   formatImportedAliases(diff.Deleted),
+		Unchanged: diff.Unchanged,
+	}
+}
+
+func formatImportedAliases(ents []aliasent.Alias) []ImportAlias {
+	als := []ImportAlias{}
+	for _, ent := range ents {
+		als = append(als, ImportAlias{
+			Key:   AliasKey(ent.Key),
+			Value: FormatEntityAliasValue(ent.Value),
+			Tags:  ent.Tags,
+		})
+	}
+
+	return als
+}
@@ -0,0 +1,326 @@
+package aliasconn
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+// AliasConnector checks the permissions and the tenant of a user on the aliases and records who changed them.
+// Aliases can be managed by the owner of their registry and its allowed tenants, registries can only be deleted by their owner
+type AliasConnector struct {
+	db           aliasstore.Database
+	authorizator auth.Authorizator
+	username     string
+	logger       log.Logger
+}
+
+var _ aliasent.AliasBackend = &AliasConnector{}
+
+func NewAliasConnector(db aliasstore.Database, authorizator auth.Authorizator, username string, logger log.Logger) *AliasConnector {
+	return &AliasConnector{
+		db:           db,
+		authorizator: authorizator,
+		username:     username,
+		logger:       logger,
+	}
+}
+
+func (c *AliasConnector) CreateAlias(ctx context.Context, registry aliasent.RegistryName, alias aliasent.Alias) (*aliasent.Alias, error) {
+	logger := c.auditLogger(registry).With("key", alias.Key)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = alias.Value.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, true)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	alias.RegistryName = registry
+	alias.CreatedBy, alias.UpdatedBy = c.username, c.username
+	alias.CreatedAt, alias.UpdatedAt = now, now
+
+	a, err := c.db.Alias().CreateAlias(ctx, registry, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("alias created successfully")
+	return a, nil
+}
+
+func (c *AliasConnector) GetAlias(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) (*aliasent.Alias, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.Alias().GetAlias(ctx, registry, aliasKey)
+}
+
+func (c *AliasConnector) GetAliasVersion(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey, version int) (*aliasent.Alias, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.Alias().GetAliasVersion(ctx, registry, aliasKey, version)
+}
+
+func (c *AliasConnector) ListAliasVersions(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) ([]aliasent.Alias, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.Alias().ListAliasVersions(ctx, registry, aliasKey)
+}
+
+func (c *AliasConnector) UpdateAlias(ctx context.Context, registry aliasent.RegistryName, alias aliasent.Alias) (*aliasent.Alias, error) {
+	logger := c.auditLogger(registry).With("key", alias.Key)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = alias.Value.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil {
+		return nil, err
+	}
+
+	alias.RegistryName = registry
+	alias.UpdatedBy = c.username
+	alias.UpdatedAt = time.Now().UTC()
+
+	a, err := c.db.Alias().UpdateAlias(ctx, registry, alias)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("alias updated successfully")
+	return a, nil
+}
+
+func (c *AliasConnector) DeleteAlias(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) error {
+	logger := c.auditLogger(registry).With("key", aliasKey)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceAlias})
+	if err != nil {
+		return err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.Alias().DeleteAlias(ctx, registry, aliasKey)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("alias deleted successfully")
+	return nil
+}
+
+func (c *AliasConnector) ListAliases(ctx context.Context, registry aliasent.RegistryName, prefix string, limit, offset uint64) ([]aliasent.Alias, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.accessRegistry(ctx, registry, false)
+	if err != nil && errors.IsNotFoundError(err) {
+		// A registry without aliases does not exist
+		return []aliasent.Alias{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.Alias().ListAliases(ctx, registry, prefix, limit, offset)
+}
+
+func (c *AliasConnector) ImportAliases(ctx context.Context, registry aliasent.RegistryName, aliases []aliasent.Alias, dryRun bool) (*aliasent.AliasesDiff, error) {
+	logger := c.auditLogger(registry)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make(map[aliasent.AliasKey]bool, len(aliases))
+	for _, alias := range aliases {
+		if keys[alias.Key] {
+			return nil, errors.InvalidParameterError("alias %s is imported several times", alias.Key)
+		}
+		keys[alias.Key] = true
+
+		err = alias.Value.Validate()
+		if err != nil {
+			return nil, errors.InvalidParameterError("invalid alias %s: %s", alias.Key, errors.FromError(err).GetMessage())
+		}
+	}
+
+	now := time.Now().UTC()
+	imported := make([]aliasent.Alias, 0, len(aliases))
+	for _, alias := range aliases {
+		alias.RegistryName = registry
+		alias.CreatedBy, alias.UpdatedBy = c.username, c.username
+		alias.CreatedAt, alias.UpdatedAt = now, now
+		imported = append(imported, alias)
+	}
+
+	// The changes are computed, authorized and applied in a single transaction, so the applied changes are the authorized ones.
+	// A missing registry is only created once the import is authorized
+	var diff *aliasent.AliasesDiff
+	err = c.db.RunInTransaction(ctx, func(dbtx aliasstore.Database) error {
+		reg, err := dbtx.Registry().GetRegistry(ctx, registry)
+		if err != nil && !errors.IsNotFoundError(err) {
+			return err
+		}
+
+		var current []aliasent.Alias
+		if reg != nil {
+			err = checkRegistryAccess(c.authorizator, reg)
+			if err != nil {
+				return err
+			}
+
+			current, err = dbtx.Alias().ListAliases(ctx, registry, "", 0, 0)
+			if err != nil {
+				return err
+			}
+		}
+
+		diff = aliasent.DiffAliases(current, imported)
+		if len(diff.Deleted) > 0 {
+			err = c.authorizator.CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceAlias})
+			if err != nil {
+				return err
+			}
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		if reg == nil {
+			_, err = dbtx.Registry().CreateRegistry(ctx, aliasent.Registry{
+				Name:      registry,
+				Tenant:    c.authorizator.Tenant(),
+				CreatedBy: c.username,
+				CreatedAt: now,
+			})
+			if err != nil {
+				return err
+			}
+			logger.Info("registry created successfully")
+		}
+
+		return aliasent.ApplyAliasesDiff(ctx, dbtx.Alias(), registry, diff)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun {
+		logger.With("created", len(diff.Created), "updated", len(diff.Updated), "deleted", len(diff.Deleted)).Info("aliases imported successfully")
+	}
+	return diff, nil
+}
+
+func (c *AliasConnector) DeleteRegistry(ctx context.Context, registry aliasent.RegistryName) error {
+	logger := c.auditLogger(registry)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceAlias})
+	if err != nil {
+		return err
+	}
+
+	reg, err := c.db.Registry().GetRegistry(ctx, registry)
+	if err != nil {
+		return err
+	}
+
+	// Allowed tenants cannot delete the registry
+	err = c.authorizator.CheckOwnership(reg.Tenant, false)
+	if err != nil {
+		return err
+	}
+
+	err = c.db.Alias().DeleteRegistry(ctx, registry)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("registry deleted successfully")
+	return nil
+}
+
+// accessRegistry checks that the user owns the registry or belongs to its allowed tenants.
+// If create is set, a missing registry is created and owned by the tenant of the user
+func (c *AliasConnector) accessRegistry(ctx context.Context, name aliasent.RegistryName, create bool) error {
+	registry, err := c.db.Registry().GetRegistry(ctx, name)
+	if err != nil && create && errors.IsNotFoundError(err) {
+		registry, err = c.db.Registry().CreateRegistry(ctx, aliasent.Registry{
+			Name:      name,
+			Tenant:    c.authorizator.Tenant(),
+			CreatedBy: c.username,
+			CreatedAt: time.Now().UTC(),
+		})
+		if err != nil && (errors.IsAlreadyExistsError(err) || errors.IsStatusConflictError(err)) {
+			// The registry has been created concurrently
+			registry, err = c.db.Registry().GetRegistry(ctx, name)
+		}
+		if err == nil {
+			c.auditLogger(name).Info("registry created successfully")
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	return checkRegistryAccess(c.authorizator, registry)
+}
+
+// auditLogger returns a logger recording who changes the aliases of a registry
+func (c *AliasConnector) auditLogger(registry aliasent.RegistryName) log.Logger {
+	return c.logger.With("registry", registry, "username", c.username, "tenant", c.authorizator.Tenant())
+}
@@ -0,0 +1,213 @@
+package aliasconn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	mockaliases "github.com/consensys/quorum-key-manager/src/aliases/entities/mock"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
+	mockdb "github.com/consensys/quorum-key-manager/src/aliases/store/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const registryName aliasent.RegistryName = "my-registry"
+
+func TestAliasConnector(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	aliases := mockaliases.NewMockAliasBackend(ctrl)
+	registries := mockaliases.NewMockRegistryBackend(ctrl)
+	db := mockdb.NewMockDatabase(ctrl)
+	db.EXPECT().Alias().Return(aliases).AnyTimes()
+	db.EXPECT().Registry().Return(registries).AnyTimes()
+	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, persist func(aliasstore.Database) error) error {
+		return persist(db)
+	}).AnyTimes()
+
+	allPermissions := []types.Permission{types.ReadAlias, types.WriteAlias, types.DeleteAlias}
+	newConnector := func(tenant string, permissions ...types.Permission) *AliasConnector {
+		return NewAliasConnector(db, authorizator.New(permissions, tenant, logger), "alice", logger)
+	}
+
+	alias := aliasent.Alias{
+		Key:   "group-A",
+		Value: aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}},
+	}
+
+	t.Run("should create the registry of the first alias and record who created the alias", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(nil, errors.NotFoundError("not found"))
+		registries.EXPECT().CreateRegistry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reg aliasent.Registry) (*aliasent.Registry, error) {
+			assert.Equal(t, registryName, reg.Name)
+			assert.Equal(t, "tenant-A", reg.Tenant)
+			assert.Equal(t, "alice", reg.CreatedBy)
+			return &reg, nil
+		})
+		aliases.EXPECT().CreateAlias(gomock.Any(), registryName, gomock.Any()).DoAndReturn(func(_ context.Context, _ aliasent.RegistryName, a aliasent.Alias) (*aliasent.Alias, error) {
+			assert.Equal(t, registryName, a.RegistryName)
+			assert.Equal(t, "alice", a.CreatedBy)
+			assert.Equal(t, "alice", a.UpdatedBy)
+			assert.False(t, a.CreatedAt.IsZero())
+			return &a, nil
+		})
+
+		a, err := newConnector("tenant-A", allPermissions...).CreateAlias(ctx, registryName, alias)
+		require.NoError(t, err)
+		assert.Equal(t, alias.Value, a.Value)
+	})
+
+	t.Run("should fail to write an invalid value", func(t *testing.T) {
+		invalid := alias
+		invalid.Value = aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12a2"}}
+
+		_, err := newConnector("tenant-A", allPermissions...).CreateAlias(ctx, registryName, invalid)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		_, err = newConnector("tenant-A", allPermissions...).UpdateAlias(ctx, registryName, invalid)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should get the versions of an alias of an accessible registry", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-A"}, nil).Times(2)
+		aliases.EXPECT().GetAliasVersion(gomock.Any(), registryName, alias.Key, 1).Return(&alias, nil)
+		aliases.EXPECT().ListAliasVersions(gomock.Any(), registryName, alias.Key).Return([]aliasent.Alias{alias}, nil)
+
+		_, err := newConnector("tenant-A", allPermissions...).GetAliasVersion(ctx, registryName, alias.Key, 1)
+		require.NoError(t, err)
+
+		_, err = newConnector("tenant-A", allPermissions...).ListAliasVersions(ctx, registryName, alias.Key)
+		require.NoError(t, err)
+
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B"}, nil)
+		_, err = newConnector("tenant-A", allPermissions...).ListAliasVersions(ctx, registryName, alias.Key)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail without permission", func(t *testing.T) {
+		_, err := newConnector("tenant-A", types.ReadAlias).CreateAlias(ctx, registryName, alias)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		err = newConnector("tenant-A", types.ReadAlias, types.WriteAlias).DeleteRegistry(ctx, registryName)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should not access the registry of another tenant", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B"}, nil)
+
+		_, err := newConnector("tenant-A", allPermissions...).GetAlias(ctx, registryName, alias.Key)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should access the registry of another tenant as allowed tenant or admin", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B", AllowedTenants: []string{"tenant-A"}}, nil).Times(2)
+		aliases.EXPECT().GetAlias(gomock.Any(), registryName, alias.Key).Return(&alias, nil).Times(2)
+
+		_, err := newConnector("tenant-A", allPermissions...).GetAlias(ctx, registryName, alias.Key)
+		require.NoError(t, err)
+
+		_, err = newConnector("tenant-C", append(allPermissions, types.AdminTenant)...).GetAlias(ctx, registryName, alias.Key)
+		require.NoError(t, err)
+	})
+
+	t.Run("should record who updated the alias", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-A"}, nil)
+		aliases.EXPECT().UpdateAlias(gomock.Any(), registryName, gomock.Any()).DoAndReturn(func(_ context.Context, _ aliasent.RegistryName, a aliasent.Alias) (*aliasent.Alias, error) {
+			assert.Equal(t, "alice", a.UpdatedBy)
+			assert.False(t, a.UpdatedAt.IsZero())
+			return &a, nil
+		})
+
+		_, err := newConnector("tenant-A", allPermissions...).UpdateAlias(ctx, registryName, alias)
+		require.NoError(t, err)
+	})
+
+	t.Run("should only delete the registry as owner", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B", AllowedTenants: []string{"tenant-A"}}, nil)
+
+		err := newConnector("tenant-A", allPermissions...).DeleteRegistry(ctx, registryName)
+		assert.True(t, errors.IsNotFoundError(err))
+
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B"}, nil)
+		aliases.EXPECT().DeleteRegistry(gomock.Any(), registryName).Return(nil)
+
+		err = newConnector("tenant-B", allPermissions...).DeleteRegistry(ctx, registryName)
+		require.NoError(t, err)
+	})
+
+	t.Run("should import aliases and require the delete permission to delete aliases", func(t *testing.T) {
+		imported := []aliasent.Alias{alias}
+		current := []aliasent.Alias{{Key: "group-B"}}
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-A"}, nil).Times(2)
+		aliases.EXPECT().ListAliases(gomock.Any(), registryName, "", uint64(0), uint64(0)).Return(current, nil).Times(2)
+
+		_, err := newConnector("tenant-A", types.ReadAlias, types.WriteAlias).ImportAliases(ctx, registryName, imported, false)
+		assert.True(t, errors.IsForbiddenError(err))
+
+		aliases.EXPECT().DeleteAlias(gomock.Any(), registryName, aliasent.AliasKey("group-B")).Return(nil)
+		aliases.EXPECT().CreateAlias(gomock.Any(), registryName, gomock.Any()).DoAndReturn(func(_ context.Context, _ aliasent.RegistryName, a aliasent.Alias) (*aliasent.Alias, error) {
+			assert.Equal(t, "alice", a.CreatedBy)
+			return &a, nil
+		})
+		d, err := newConnector("tenant-A", allPermissions...).ImportAliases(ctx, registryName, imported, false)
+		require.NoError(t, err)
+		assert.Equal(t, current, d.Deleted)
+		assert.Len(t, d.Created, 1)
+	})
+
+	t.Run("should compute the import into a missing registry without creating it", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(nil, errors.NotFoundError("not found"))
+
+		d, err := newConnector("tenant-A", allPermissions...).ImportAliases(ctx, registryName, []aliasent.Alias{alias}, true)
+		require.NoError(t, err)
+		assert.Len(t, d.Created, 1)
+	})
+
+	t.Run("should create a missing registry owned by the tenant when importing aliases", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(nil, errors.NotFoundError("not found"))
+		registries.EXPECT().CreateRegistry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reg aliasent.Registry) (*aliasent.Registry, error) {
+			assert.Equal(t, "tenant-A", reg.Tenant)
+			return &reg, nil
+		})
+		aliases.EXPECT().CreateAlias(gomock.Any(), registryName, gomock.Any()).DoAndReturn(func(_ context.Context, _ aliasent.RegistryName, a aliasent.Alias) (*aliasent.Alias, error) {
+			return &a, nil
+		})
+
+		d, err := newConnector("tenant-A", types.WriteAlias).ImportAliases(ctx, registryName, []aliasent.Alias{alias}, false)
+		require.NoError(t, err)
+		assert.Len(t, d.Created, 1)
+	})
+
+	t.Run("should not create the registry nor import aliases into a registry of another tenant", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(&aliasent.Registry{Name: registryName, Tenant: "tenant-B"}, nil)
+
+		_, err := newConnector("tenant-A", allPermissions...).ImportAliases(ctx, registryName, []aliasent.Alias{alias}, false)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail to import invalid or duplicated aliases", func(t *testing.T) {
+		_, err := newConnector("tenant-A", allPermissions...).ImportAliases(ctx, registryName, []aliasent.Alias{alias, alias}, true)
+		assert.True(t, errors.IsInvalidParameterError(err))
+
+		invalid := alias
+		invalid.Value = aliasent.AliasValue{Kind: aliasent.KindEthAddress, Values: []string{"0x01"}}
+		_, err = newConnector("tenant-A", allPermissions...).ImportAliases(ctx, registryName, []aliasent.Alias{invalid}, true)
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should list no aliases of a missing registry", func(t *testing.T) {
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(nil, errors.NotFoundError("not found"))
+
+		als, err := newConnector("tenant-A", allPermissions...).ListAliases(ctx, registryName, "", 0, 0)
+		require.NoError(t, err)
+		assert.Empty(t, als)
+	})
+}
@@ -0,0 +1,37 @@
+package aliasconn
+
+import (
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+// Connector gives the users access to the aliases with their permissions and tenant
+type Connector struct {
+	db          aliasstore.Database
+	authManager auth.Manager
+	logger      log.Logger
+}
+
+var _ aliasent.Connector = &Connector{}
+
+func NewConnector(db aliasstore.Database, authManager auth.Manager, logger log.Logger) *Connector {
+	return &Connector{
+		db:          db,
+		authManager: authManager,
+		logger:      logger,
+	}
+}
+
+func (c *Connector) Aliases(userInfo *authtypes.UserInfo) aliasent.AliasBackend {
+	resolver := authorizator.New(c.authManager.UserPermissions(userInfo), userInfo.Tenant, c.logger)
+	return NewAliasConnector(c.db, resolver, userInfo.Username, c.logger)
+}
+
+func (c *Connector) Registries(userInfo *authtypes.UserInfo) aliasent.Registries {
+	resolver := authorizator.New(c.authManager.UserPermissions(userInfo), userInfo.Tenant, c.logger)
+	return NewRegistryConnector(c.db, resolver, userInfo.Username, c.logger)
+}
@@ -0,0 +1,149 @@
+package aliasconn
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+// RegistryConnector checks the permissions and the tenant of a user on the registries.
+// Registries are listed and described to the owner and the allowed tenants, only the owner can update them
+type RegistryConnector struct {
+	db           aliasstore.Database
+	authorizator auth.Authorizator
+	username     string
+	logger       log.Logger
+}
+
+var _ aliasent.Registries = &RegistryConnector{}
+
+func NewRegistryConnector(db aliasstore.Database, authorizator auth.Authorizator, username string, logger log.Logger) *RegistryConnector {
+	return &RegistryConnector{
+		db:           db,
+		authorizator: authorizator,
+		username:     username,
+		logger:       logger,
+	}
+}
+
+func (c *RegistryConnector) CreateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	logger := c.auditLogger(registry.Name)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.checkOwner(registry.Tenant)
+	if err != nil {
+		return nil, err
+	}
+
+	if registry.Tenant == "" {
+		registry.Tenant = c.authorizator.Tenant()
+	}
+	registry.CreatedBy = c.username
+	registry.CreatedAt = time.Now().UTC()
+
+	reg, err := c.db.Registry().CreateRegistry(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("registry created successfully")
+	return reg, nil
+}
+
+func (c *RegistryConnector) GetRegistry(ctx context.Context, name aliasent.RegistryName) (*aliasent.Registry, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	registry, err := c.db.Registry().GetRegistry(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkRegistryAccess(c.authorizator, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+func (c *RegistryConnector) UpdateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	logger := c.auditLogger(registry.Name)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	current, err := c.db.Registry().GetRegistry(ctx, registry.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// Allowed tenants cannot update the registry
+	err = c.authorizator.CheckOwnership(current.Tenant, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if registry.Tenant != current.Tenant {
+		err = c.checkOwner(registry.Tenant)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	reg, err := c.db.Registry().UpdateRegistry(ctx, registry)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.With("owner", reg.Tenant, "allowed_tenants", reg.AllowedTenants).Info("registry updated successfully")
+	return reg, nil
+}
+
+func (c *RegistryConnector) ListRegistries(ctx context.Context, prefix string, limit, offset uint64) ([]aliasent.Registry, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceAlias})
+	if err != nil {
+		return nil, err
+	}
+
+	return c.db.Registry().ListRegistries(ctx, c.authorizator.AccessibleTenants(), prefix, limit, offset)
+}
+
+// checkOwner checks that the user can make the tenant the owner of a registry, only administrators can give registries to other tenants
+func (c *RegistryConnector) checkOwner(tenant string) error {
+	if tenant == "" || tenant == c.authorizator.Tenant() || c.authorizator.AccessibleTenants() == nil {
+		return nil
+	}
+
+	errMessage := "only administrators can make another tenant the owner of a registry"
+	c.logger.With("tenant", c.authorizator.Tenant(), "owner", tenant).Error(errMessage)
+	return errors.ForbiddenError(errMessage)
+}
+
+// auditLogger returns a logger recording who changes a registry
+func (c *RegistryConnector) auditLogger(registry aliasent.RegistryName) log.Logger {
+	return c.logger.With("registry", registry, "username", c.username, "tenant", c.authorizator.Tenant())
+}
+
+// checkRegistryAccess checks that the user owns the registry or belongs to its allowed tenants
+func checkRegistryAccess(authorizator auth.Authorizator, registry *aliasent.Registry) error {
+	if len(registry.AllowedTenants) > 0 && authorizator.CheckAccess(registry.AllowedTenants) == nil {
+		return nil
+	}
+
+	return authorizator.CheckOwnership(registry.Tenant, false)
+}
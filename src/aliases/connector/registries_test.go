@@ -0,0 +1,87 @@
+package aliasconn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	mockaliases "github.com/consensys/quorum-key-manager/src/aliases/entities/mock"
+	mockdb "github.com/consensys/quorum-key-manager/src/aliases/store/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegistryConnector(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	registries := mockaliases.NewMockRegistryBackend(ctrl)
+	db := mockdb.NewMockDatabase(ctrl)
+	db.EXPECT().Registry().Return(registries).AnyTimes()
+
+	allPermissions := []types.Permission{types.ReadAlias, types.WriteAlias, types.DeleteAlias}
+	newConnector := func(tenant string, permissions ...types.Permission) *RegistryConnector {
+		return NewRegistryConnector(db, authorizator.New(permissions, tenant, logger), "alice", logger)
+	}
+
+	t.Run("should create a registry owned by the tenant of the user", func(t *testing.T) {
+		registries.EXPECT().CreateRegistry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reg aliasent.Registry) (*aliasent.Registry, error) {
+			assert.Equal(t, "tenant-A", reg.Tenant)
+			assert.Equal(t, "alice", reg.CreatedBy)
+			assert.Equal(t, "participants", reg.Description)
+			return &reg, nil
+		})
+
+		_, err := newConnector("tenant-A", allPermissions...).CreateRegistry(ctx, aliasent.Registry{Name: registryName, Description: "participants"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should only create a registry for another tenant as admin", func(t *testing.T) {
+		_, err := newConnector("tenant-A", allPermissions...).CreateRegistry(ctx, aliasent.Registry{Name: registryName, Tenant: "tenant-B"})
+		assert.True(t, errors.IsForbiddenError(err))
+
+		registries.EXPECT().CreateRegistry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reg aliasent.Registry) (*aliasent.Registry, error) {
+			assert.Equal(t, "tenant-B", reg.Tenant)
+			return &reg, nil
+		})
+		_, err = newConnector("tenant-A", append(allPermissions, types.AdminTenant)...).CreateRegistry(ctx, aliasent.Registry{Name: registryName, Tenant: "tenant-B"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should describe the registry to the allowed tenants but only let the owner update it", func(t *testing.T) {
+		registry := &aliasent.Registry{Name: registryName, Tenant: "tenant-B", AllowedTenants: []string{"tenant-A"}}
+		registries.EXPECT().GetRegistry(gomock.Any(), registryName).Return(registry, nil).Times(3)
+
+		_, err := newConnector("tenant-A", allPermissions...).GetRegistry(ctx, registryName)
+		require.NoError(t, err)
+
+		_, err = newConnector("tenant-A", allPermissions...).UpdateRegistry(ctx, aliasent.Registry{Name: registryName, Tenant: "tenant-B"})
+		assert.True(t, errors.IsNotFoundError(err))
+
+		registries.EXPECT().UpdateRegistry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, reg aliasent.Registry) (*aliasent.Registry, error) {
+			return &reg, nil
+		})
+		_, err = newConnector("tenant-B", allPermissions...).UpdateRegistry(ctx, aliasent.Registry{Name: registryName, Tenant: "tenant-B", Description: "updated"})
+		require.NoError(t, err)
+	})
+
+	t.Run("should list the registries accessible to the tenant of the user", func(t *testing.T) {
+		registries.EXPECT().ListRegistries(gomock.Any(), []string{"tenant-A"}, "my-", uint64(10), uint64(0)).Return([]aliasent.Registry{}, nil)
+		_, err := newConnector("tenant-A", allPermissions...).ListRegistries(ctx, "my-", 10, 0)
+		require.NoError(t, err)
+
+		registries.EXPECT().ListRegistries(gomock.Any(), nil, "", uint64(0), uint64(0)).Return([]aliasent.Registry{}, nil)
+		_, err = newConnector("tenant-A", append(allPermissions, types.AdminTenant)...).ListRegistries(ctx, "", 0, 0)
+		require.NoError(t, err)
+
+		_, err = newConnector("tenant-A").ListRegistries(ctx, "", 0, 0)
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -1,18 +1,35 @@
 package aliasent
 
-// Alias allows the user to associates a RegistryName + a Key to 1 or more public keys stored
-// in Value. The Value has 2 formats:
-// - a JSON string if AliasKind is an AliasKindString.
-// - a JSON array of strings if AliasKind is an AliasKindArray.
+import "time"
+
+// Alias allows the user to associates a RegistryName + a Key to 1 or more values of the same kind stored in Value.
+// Every update of an alias creates a new version of it, the previous versions are kept.
 type Alias struct {
 	Key          AliasKey
 	RegistryName RegistryName
-	// Value is a JSON array containing Tessera/Orion keys base64 encoded in strings.
-	Value AliasValue
+	Value        AliasValue
+	Tags         map[string]string
+	Version      int
+
+	// CreatedBy and UpdatedBy are the usernames of the users who created and last updated the alias
+	CreatedBy string
+	UpdatedBy string
+	CreatedAt time.Time
+	UpdatedAt time.Time
 }
 
 type AliasKey string
 
-type AliasValue string
-
 type RegistryName string
+
+// Registry groups aliases, it is created explicitly or with the first alias created in it.
+type Registry struct {
+	Name        RegistryName
+	Description string
+	// Tenant owns the registry, a registry without tenant is accessible to all tenants
+	Tenant string
+	// AllowedTenants can manage the aliases of the registry in addition to the owner
+	AllowedTenants []string
+	CreatedBy      string
+	CreatedAt      time.Time
+}
@@ -2,6 +2,8 @@ package aliasent
 
 import (
 	"context"
+
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
 )
 
 //go:generate mockgen -source=backend.go -destination=mock/backend.go -package=mock
@@ -14,12 +16,52 @@ type AliasBackend interface {
 	GetAlias(ctx context.Context, registry RegistryName, aliasKey AliasKey) (*Alias, error)
 	// UpdateAlias updates an alias in the registry.
 	UpdateAlias(ctx context.Context, registry RegistryName, alias Alias) (*Alias, error)
+	// GetAliasVersion gets a version of an alias from the registry.
+	GetAliasVersion(ctx context.Context, registry RegistryName, aliasKey AliasKey, version int) (*Alias, error)
+	// ListAliasVersions lists all the versions of an alias from the registry, from the oldest to the current one.
+	ListAliasVersions(ctx context.Context, registry RegistryName, aliasKey AliasKey) ([]Alias, error)
 	// GetAlias deletes an alias from the registry.
 	DeleteAlias(ctx context.Context, registry RegistryName, aliasKey AliasKey) error
 
-	// ListAlias lists all aliases from a registry.
-	ListAliases(ctx context.Context, registry RegistryName) ([]Alias, error)
+	// ListAliases lists the aliases from a registry whose key starts with the prefix, all of them if limit is 0.
+	ListAliases(ctx context.Context, registry RegistryName, prefix string, limit, offset uint64) ([]Alias, error)
+
+	// ImportAliases replaces the aliases of a registry, aliases missing from the imported ones are deleted.
+	// The changes are only computed if dryRun is set
+	ImportAliases(ctx context.Context, registry RegistryName, aliases []Alias, dryRun bool) (*AliasesDiff, error)
 
 	// DeleteRegistry deletes a registry, with all the aliases it contained.
 	DeleteRegistry(ctx context.Context, registry RegistryName) error
 }
+
+// RegistryBackend handles the registries.
+type RegistryBackend interface {
+	// CreateRegistry creates a registry.
+	CreateRegistry(ctx context.Context, registry Registry) (*Registry, error)
+	// GetRegistry gets a registry.
+	GetRegistry(ctx context.Context, registry RegistryName) (*Registry, error)
+	// UpdateRegistry updates the description, the owner and the allowed tenants of a registry.
+	UpdateRegistry(ctx context.Context, registry Registry) (*Registry, error)
+	// ListRegistries lists the registries whose name starts with the prefix accessible to the tenants, all of them if tenants is nil.
+	ListRegistries(ctx context.Context, tenants []string, prefix string, limit, offset uint64) ([]Registry, error)
+}
+
+// Registries handles the registries accessible to a user.
+type Registries interface {
+	// CreateRegistry creates a registry.
+	CreateRegistry(ctx context.Context, registry Registry) (*Registry, error)
+	// GetRegistry gets a registry.
+	GetRegistry(ctx context.Context, registry RegistryName) (*Registry, error)
+	// UpdateRegistry updates the description, the owner and the allowed tenants of a registry.
+	UpdateRegistry(ctx context.Context, registry Registry) (*Registry, error)
+	// ListRegistries lists the registries whose name starts with the prefix, all of them if limit is 0.
+	ListRegistries(ctx context.Context, prefix string, limit, offset uint64) ([]Registry, error)
+}
+
+// Connector gives the users access to the aliases of the registries allowed by their permissions and tenant.
+type Connector interface {
+	// Aliases returns the aliases of the user.
+	Aliases(userInfo *authtypes.UserInfo) AliasBackend
+	// Registries returns the registries of the user.
+	Registries(userInfo *authtypes.UserInfo) Registries
+}
@@ -0,0 +1,84 @@
+package aliasent
+
+import (
+	"context"
+	"reflect"
+)
+
+// AliasesDiff lists the changes of an import of aliases into a registry.
+type AliasesDiff struct {
+	Created   []Alias
+	Updated   []Alias
+	Deleted   []Alias
+	Unchanged int
+}
+
+// DiffAliases computes the changes needed to replace the current aliases of a registry by the imported ones
+func DiffAliases(current, imported []Alias) *AliasesDiff {
+	diff := &AliasesDiff{
+		Created: []Alias{},
+		Updated: []Alias{},
+		Deleted: []Alias{},
+	}
+
+	existing := make(map[AliasKey]Alias, len(current))
+	for _, alias := range current {
+		existing[alias.Key] = alias
+	}
+
+	kept := make(map[AliasKey]bool, len(imported))
+	for _, alias := range imported {
+		kept[alias.Key] = true
+
+		prev, ok := existing[alias.Key]
+		switch {
+		case !ok:
+			diff.Created = append(diff.Created, alias)
+		case prev.Value.Kind != alias.Value.Kind || !reflect.DeepEqual(prev.Value.Values, alias.Value.Values) || !equalTags(prev.Tags, alias.Tags):
+			diff.Updated = append(diff.Updated, alias)
+		default:
+			diff.Unchanged++
+		}
+	}
+
+	for _, alias := range current {
+		if !kept[alias.Key] {
+			diff.Deleted = append(diff.Deleted, alias)
+		}
+	}
+
+	return diff
+}
+
+// ApplyAliasesDiff applies the changes of an import to the aliases of a registry
+func ApplyAliasesDiff(ctx context.Context, backend AliasBackend, registry RegistryName, diff *AliasesDiff) error {
+	for _, alias := range diff.Deleted {
+		err := backend.DeleteAlias(ctx, registry, alias.Key)
+		if err != nil {
+			return err
+		}
+	}
+	for _, alias := range diff.Updated {
+		_, err := backend.UpdateAlias(ctx, registry, alias)
+		if err != nil {
+			return err
+		}
+	}
+	for _, alias := range diff.Created {
+		_, err := backend.CreateAlias(ctx, registry, alias)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// equalTags considers missing and empty tags as equal
+func equalTags(a, b map[string]string) bool {
+	if len(a) == 0 && len(b) == 0 {
+		return true
+	}
+
+	return reflect.DeepEqual(a, b)
+}
@@ -0,0 +1,32 @@
+package aliasent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiffAliases(t *testing.T) {
+	tessera := func(keys ...string) AliasValue {
+		return AliasValue{Kind: KindTesseraKey, Values: keys}
+	}
+
+	current := []Alias{
+		{Key: "unchanged", Value: tessera("A"), Version: 1},
+		{Key: "updated-value", Value: tessera("A"), Version: 2},
+		{Key: "updated-tags", Value: tessera("A"), Version: 1},
+		{Key: "deleted", Value: tessera("A"), Version: 1},
+	}
+	imported := []Alias{
+		{Key: "unchanged", Value: tessera("A"), Tags: map[string]string{}},
+		{Key: "updated-value", Value: tessera("A", "B")},
+		{Key: "updated-tags", Value: tessera("A"), Tags: map[string]string{"env": "production"}},
+		{Key: "created", Value: tessera("C")},
+	}
+
+	diff := DiffAliases(current, imported)
+	assert.Equal(t, []Alias{imported[3]}, diff.Created)
+	assert.Equal(t, []Alias{imported[1], imported[2]}, diff.Updated)
+	assert.Equal(t, []Alias{current[3]}, diff.Deleted)
+	assert.Equal(t, 1, diff.Unchanged)
+}
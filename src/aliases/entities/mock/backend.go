@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	types "github.com/consensys/quorum-key-manager/src/auth/types"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -93,19 +94,64 @@ func (mr *MockAliasBackendMockRecorder) GetAlias(ctx, registry, aliasKey interfa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlias", reflect.TypeOf((*MockAliasBackend)(nil).GetAlias), ctx, registry, aliasKey)
 }
 
+// GetAliasVersion mocks base method.
+func (m *MockAliasBackend) GetAliasVersion(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey, version int) (*aliasent.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAliasVersion", ctx, registry, aliasKey, version)
+	ret0, _ := ret[0].(*aliasent.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAliasVersion indicates an expected call of GetAliasVersion.
+func (mr *MockAliasBackendMockRecorder) GetAliasVersion(ctx, registry, aliasKey, version interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAliasVersion", reflect.TypeOf((*MockAliasBackend)(nil).GetAliasVersion), ctx, registry, aliasKey, version)
+}
+
+// ImportAliases mocks base method.
+func (m *MockAliasBackend) ImportAliases(ctx context.Context, registry aliasent.RegistryName, aliases []aliasent.Alias, dryRun bool) (*aliasent.AliasesDiff, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportAliases", ctx, registry, aliases, dryRun)
+	ret0, _ := ret[0].(*aliasent.AliasesDiff)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportAliases indicates an expected call of ImportAliases.
+func (mr *MockAliasBackendMockRecorder) ImportAliases(ctx, registry, aliases, dryRun interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportAliases", reflect.TypeOf((*MockAliasBackend)(nil).ImportAliases), ctx, registry, aliases, dryRun)
+}
+
+// ListAliasVersions mocks base method.
+func (m *MockAliasBackend) ListAliasVersions(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) ([]aliasent.Alias, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAliasVersions", ctx, registry, aliasKey)
+	ret0, _ := ret[0].([]aliasent.Alias)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAliasVersions indicates an expected call of ListAliasVersions.
+func (mr *MockAliasBackendMockRecorder) ListAliasVersions(ctx, registry, aliasKey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliasVersions", reflect.TypeOf((*MockAliasBackend)(nil).ListAliasVersions), ctx, registry, aliasKey)
+}
+
 // ListAliases mocks base method.
-func (m *MockAliasBackend) ListAliases(ctx context.Context, registry aliasent.RegistryName) ([]aliasent.Alias, error) {
+func (m *MockAliasBackend) ListAliases(ctx context.Context, registry aliasent.RegistryName, prefix string, limit, offset uint64) ([]aliasent.Alias, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAliases", ctx, registry)
+	ret := m.ctrl.Call(m, "ListAliases", ctx, registry, prefix, limit, offset)
 	ret0, _ := ret[0].([]aliasent.Alias)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListAliases indicates an expected call of ListAliases.
-func (mr *MockAliasBackendMockRecorder) ListAliases(ctx, registry interface{}) *gomock.Call {
+func (mr *MockAliasBackendMockRecorder) ListAliases(ctx, registry, prefix, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockAliasBackend)(nil).ListAliases), ctx, registry)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAliases", reflect.TypeOf((*MockAliasBackend)(nil).ListAliases), ctx, registry, prefix, limit, offset)
 }
 
 // UpdateAlias mocks base method.
@@ -122,3 +168,220 @@ func (mr *MockAliasBackendMockRecorder) UpdateAlias(ctx, registry, alias interfa
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAlias", reflect.TypeOf((*MockAliasBackend)(nil).UpdateAlias), ctx, registry, alias)
 }
+
+// MockRegistryBackend is a mock of RegistryBackend interface.
+type MockRegistryBackend struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistryBackendMockRecorder
+}
+
+// MockRegistryBackendMockRecorder is the mock recorder for MockRegistryBackend.
+type MockRegistryBackendMockRecorder struct {
+	mock *MockRegistryBackend
+}
+
+// NewMockRegistryBackend creates a new mock instance.
+func NewMockRegistryBackend(ctrl *gomock.Controller) *MockRegistryBackend {
+	mock := &MockRegistryBackend{ctrl: ctrl}
+	mock.recorder = &MockRegistryBackendMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistryBackend) EXPECT() *MockRegistryBackendMockRecorder {
+	return m.recorder
+}
+
+// CreateRegistry mocks base method.
+func (m *MockRegistryBackend) CreateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRegistry indicates an expected call of CreateRegistry.
+func (mr *MockRegistryBackendMockRecorder) CreateRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistry", reflect.TypeOf((*MockRegistryBackend)(nil).CreateRegistry), ctx, registry)
+}
+
+// GetRegistry mocks base method.
+func (m *MockRegistryBackend) GetRegistry(ctx context.Context, registry aliasent.RegistryName) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockRegistryBackendMockRecorder) GetRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockRegistryBackend)(nil).GetRegistry), ctx, registry)
+}
+
+// ListRegistries mocks base method.
+func (m *MockRegistryBackend) ListRegistries(ctx context.Context, tenants []string, prefix string, limit, offset uint64) ([]aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistries", ctx, tenants, prefix, limit, offset)
+	ret0, _ := ret[0].([]aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistries indicates an expected call of ListRegistries.
+func (mr *MockRegistryBackendMockRecorder) ListRegistries(ctx, tenants, prefix, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistries", reflect.TypeOf((*MockRegistryBackend)(nil).ListRegistries), ctx, tenants, prefix, limit, offset)
+}
+
+// UpdateRegistry mocks base method.
+func (m *MockRegistryBackend) UpdateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRegistry indicates an expected call of UpdateRegistry.
+func (mr *MockRegistryBackendMockRecorder) UpdateRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistry", reflect.TypeOf((*MockRegistryBackend)(nil).UpdateRegistry), ctx, registry)
+}
+
+// MockRegistries is a mock of Registries interface.
+type MockRegistries struct {
+	ctrl     *gomock.Controller
+	recorder *MockRegistriesMockRecorder
+}
+
+// MockRegistriesMockRecorder is the mock recorder for MockRegistries.
+type MockRegistriesMockRecorder struct {
+	mock *MockRegistries
+}
+
+// NewMockRegistries creates a new mock instance.
+func NewMockRegistries(ctrl *gomock.Controller) *MockRegistries {
+	mock := &MockRegistries{ctrl: ctrl}
+	mock.recorder = &MockRegistriesMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRegistries) EXPECT() *MockRegistriesMockRecorder {
+	return m.recorder
+}
+
+// CreateRegistry mocks base method.
+func (m *MockRegistries) CreateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRegistry indicates an expected call of CreateRegistry.
+func (mr *MockRegistriesMockRecorder) CreateRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRegistry", reflect.TypeOf((*MockRegistries)(nil).CreateRegistry), ctx, registry)
+}
+
+// GetRegistry mocks base method.
+func (m *MockRegistries) GetRegistry(ctx context.Context, registry aliasent.RegistryName) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRegistry indicates an expected call of GetRegistry.
+func (mr *MockRegistriesMockRecorder) GetRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistry", reflect.TypeOf((*MockRegistries)(nil).GetRegistry), ctx, registry)
+}
+
+// ListRegistries mocks base method.
+func (m *MockRegistries) ListRegistries(ctx context.Context, prefix string, limit, offset uint64) ([]aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegistries", ctx, prefix, limit, offset)
+	ret0, _ := ret[0].([]aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegistries indicates an expected call of ListRegistries.
+func (mr *MockRegistriesMockRecorder) ListRegistries(ctx, prefix, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegistries", reflect.TypeOf((*MockRegistries)(nil).ListRegistries), ctx, prefix, limit, offset)
+}
+
+// UpdateRegistry mocks base method.
+func (m *MockRegistries) UpdateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegistry", ctx, registry)
+	ret0, _ := ret[0].(*aliasent.Registry)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRegistry indicates an expected call of UpdateRegistry.
+func (mr *MockRegistriesMockRecorder) UpdateRegistry(ctx, registry interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegistry", reflect.TypeOf((*MockRegistries)(nil).UpdateRegistry), ctx, registry)
+}
+
+// MockConnector is a mock of Connector interface.
+type MockConnector struct {
+	ctrl     *gomock.Controller
+	recorder *MockConnectorMockRecorder
+}
+
+// MockConnectorMockRecorder is the mock recorder for MockConnector.
+type MockConnectorMockRecorder struct {
+	mock *MockConnector
+}
+
+// NewMockConnector creates a new mock instance.
+func NewMockConnector(ctrl *gomock.Controller) *MockConnector {
+	mock := &MockConnector{ctrl: ctrl}
+	mock.recorder = &MockConnectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConnector) EXPECT() *MockConnectorMockRecorder {
+	return m.recorder
+}
+
+// Aliases mocks base method.
+func (m *MockConnector) Aliases(userInfo *types.UserInfo) aliasent.AliasBackend {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Aliases", userInfo)
+	ret0, _ := ret[0].(aliasent.AliasBackend)
+	return ret0
+}
+
+// Aliases indicates an expected call of Aliases.
+func (mr *MockConnectorMockRecorder) Aliases(userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Aliases", reflect.TypeOf((*MockConnector)(nil).Aliases), userInfo)
+}
+
+// Registries mocks base method.
+func (m *MockConnector) Registries(userInfo *types.UserInfo) aliasent.Registries {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Registries", userInfo)
+	ret0, _ := ret[0].(aliasent.Registries)
+	return ret0
+}
+
+// Registries indicates an expected call of Registries.
+func (mr *MockConnectorMockRecorder) Registries(userInfo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registries", reflect.TypeOf((*MockConnector)(nil).Registries), userInfo)
+}
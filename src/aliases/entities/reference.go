@@ -0,0 +1,25 @@
+package aliasent
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// referenceRegex matches a reference to an alias in the format {{registry:alias}}
+var referenceRegex = regexp.MustCompile(`^{{([^{}:]+):([^{}:]+)}}$`)
+
+// ParseReference returns the registry and the key of an alias reference {{registry:alias}}.
+// ok is false if s is not an alias reference
+func ParseReference(s string) (registry RegistryName, key AliasKey, ok bool) {
+	matches := referenceRegex.FindStringSubmatch(s)
+	if matches == nil {
+		return "", "", false
+	}
+
+	return RegistryName(matches[1]), AliasKey(matches[2]), true
+}
+
+// Reference returns the reference to the alias in the format {{registry:alias}}
+func Reference(registry RegistryName, key AliasKey) string {
+	return fmt.Sprintf("{{%s:%s}}", registry, key)
+}
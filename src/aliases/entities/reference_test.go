@@ -0,0 +1,24 @@
+package aliasent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseReference(t *testing.T) {
+	t.Run("should parse an alias reference", func(t *testing.T) {
+		registry, key, ok := ParseReference("{{my-registry:group-A}}")
+		assert.True(t, ok)
+		assert.Equal(t, RegistryName("my-registry"), registry)
+		assert.Equal(t, AliasKey("group-A"), key)
+		assert.Equal(t, "{{my-registry:group-A}}", Reference(registry, key))
+	})
+
+	t.Run("should not parse other strings", func(t *testing.T) {
+		for _, s := range []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", "{{my-registry}}", "{{:group-A}}", "{{my-registry:group-A}}suffix", "{{a:b:c}}"} {
+			_, _, ok := ParseReference(s)
+			assert.False(t, ok, s)
+		}
+	})
+}
@@ -0,0 +1,84 @@
+package aliasent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"regexp"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type AliasKind string
+
+const (
+	// KindTesseraKey is a Tessera/Orion public key encoded in base64
+	KindTesseraKey AliasKind = "tessera"
+	// KindEthAddress is an Ethereum address encoded in hexadecimal
+	KindEthAddress AliasKind = "ethereum"
+	// KindKeyReference references a key of the key manager in the format {store}/{key_id}
+	KindKeyReference AliasKind = "key"
+	// KindPrivacyGroupID is the ID of a privacy group encoded in base64
+	KindPrivacyGroupID AliasKind = "privacy_group"
+)
+
+// Tessera/Orion public keys and privacy group IDs are 32 bytes long
+const privacyIDLength = 32
+
+var keyReferenceRegex = regexp.MustCompile(`^[a-zA-Z0-9-_+]+/[a-zA-Z0-9-_+]+$`)
+
+// AliasValue is the value of an alias, it holds one or several values of the same kind.
+type AliasValue struct {
+	Kind   AliasKind
+	Values []string
+}
+
+// Validate checks that the alias value holds at least one value and that all of them are of its kind
+func (v *AliasValue) Validate() error {
+	if len(v.Values) == 0 {
+		return errors.InvalidParameterError("alias value must hold at least one value")
+	}
+
+	var isValid func(string) bool
+	switch v.Kind {
+	case KindTesseraKey, KindPrivacyGroupID:
+		isValid = isPrivacyID
+	case KindEthAddress:
+		isValid = ethcommon.IsHexAddress
+	case KindKeyReference:
+		isValid = keyReferenceRegex.MatchString
+	default:
+		return errors.InvalidParameterError("invalid alias kind %q", v.Kind)
+	}
+
+	for _, value := range v.Values {
+		if !isValid(value) {
+			return errors.InvalidParameterError("invalid %s value %q", v.Kind, value)
+		}
+	}
+
+	return nil
+}
+
+// ParseLegacyValue parses the untyped values of the aliases created before values were typed.
+// A legacy value holds Tessera keys in a JSON array of strings, a JSON string or a single raw key
+func ParseLegacyValue(s string) AliasValue {
+	value := AliasValue{Kind: KindTesseraKey}
+	if err := json.Unmarshal([]byte(s), &value.Values); err == nil {
+		return value
+	}
+
+	var key string
+	if err := json.Unmarshal([]byte(s), &key); err == nil {
+		value.Values = []string{key}
+		return value
+	}
+
+	value.Values = []string{s}
+	return value
+}
+
+func isPrivacyID(s string) bool {
+	b, err := base64.StdEncoding.DecodeString(s)
+	return err == nil && len(b) == privacyIDLength
+}
@@ -0,0 +1,40 @@
+package aliasent
+
+import (
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasValueValidate(t *testing.T) {
+	valid := []AliasValue{
+		{Kind: KindTesseraKey, Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="}},
+		{Kind: KindPrivacyGroupID, Values: []string{"kAbelwaVW7okoEn1+okO+AbA4Hhz/7DaCOWVQz9nx5M="}},
+		{Kind: KindEthAddress, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12a2"}},
+		{Kind: KindKeyReference, Values: []string{"my-store/my-key"}},
+	}
+	for _, v := range valid {
+		assert.NoError(t, v.Validate(), v.Kind)
+	}
+
+	invalid := []AliasValue{
+		{Kind: KindTesseraKey},
+		{Kind: KindTesseraKey, Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0B="}},
+		{Kind: KindTesseraKey, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12a2"}},
+		{Kind: KindPrivacyGroupID, Values: []string{"not base64"}},
+		{Kind: KindEthAddress, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12"}},
+		{Kind: KindKeyReference, Values: []string{"my-key"}},
+		{Kind: "unknown", Values: []string{"my-store/my-key"}},
+	}
+	for _, v := range invalid {
+		err := v.Validate()
+		assert.True(t, errors.IsInvalidParameterError(err), "%v", v)
+	}
+}
+
+func TestParseLegacyValue(t *testing.T) {
+	assert.Equal(t, AliasValue{Kind: KindTesseraKey, Values: []string{"key1", "key2"}}, ParseLegacyValue(`["key1","key2"]`))
+	assert.Equal(t, AliasValue{Kind: KindTesseraKey, Values: []string{"key1"}}, ParseLegacyValue(`"key1"`))
+	assert.Equal(t, AliasValue{Kind: KindTesseraKey, Values: []string{"A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="}}, ParseLegacyValue("A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="))
+}
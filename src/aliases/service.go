@@ -3,7 +3,9 @@ package aliases
 import (
 	"github.com/consensys/quorum-key-manager/pkg/app"
 	aliasapi "github.com/consensys/quorum-key-manager/src/aliases/api"
+	aliasconn "github.com/consensys/quorum-key-manager/src/aliases/connector"
 	aliaspg "github.com/consensys/quorum-key-manager/src/aliases/store/postgres"
+	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 )
@@ -23,7 +25,14 @@ func RegisterService(a *app.App, logger log.Logger) error {
 
 	db := aliaspg.NewDatabase(pgClient)
 
-	api := aliasapi.New(db.Alias())
+	// Load auth manager service
+	authManager := new(auth.Manager)
+	err = a.Service(authManager)
+	if err != nil {
+		return err
+	}
+
+	api := aliasapi.New(aliasconn.NewConnector(db, *authManager, logger))
 	api.Register(a.Router())
 
 	return nil
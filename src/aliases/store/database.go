@@ -1,6 +1,8 @@
 package aliasstore
 
 import (
+	"context"
+
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
 )
 
@@ -8,4 +10,6 @@ import (
 
 type Database interface {
 	Alias() aliasent.AliasBackend
+	Registry() aliasent.RegistryBackend
+	RunInTransaction(ctx context.Context, persist func(dbtx Database) error) error
 }
@@ -5,9 +5,11 @@
 package mock
 
 import (
+	context "context"
 	reflect "reflect"
 
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
 	gomock "github.com/golang/mock/gomock"
 )
 
@@ -47,3 +49,31 @@ func (mr *MockDatabaseMockRecorder) Alias() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Alias", reflect.TypeOf((*MockDatabase)(nil).Alias))
 }
+
+// Registry mocks base method.
+func (m *MockDatabase) Registry() aliasent.RegistryBackend {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Registry")
+	ret0, _ := ret[0].(aliasent.RegistryBackend)
+	return ret0
+}
+
+// Registry indicates an expected call of Registry.
+func (mr *MockDatabaseMockRecorder) Registry() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Registry", reflect.TypeOf((*MockDatabase)(nil).Registry))
+}
+
+// RunInTransaction mocks base method.
+func (m *MockDatabase) RunInTransaction(ctx context.Context, persist func(aliasstore.Database) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persist)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTransaction indicates an expected call of RunInTransaction.
+func (mr *MockDatabaseMockRecorder) RunInTransaction(ctx, persist interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockDatabase)(nil).RunInTransaction), ctx, persist)
+}
@@ -1,25 +1,54 @@
 package aliasmodels
 
-import aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+import (
+	"encoding/json"
+	"time"
 
-// Alias allows the user to associates a RegistryName + a Key to 1 or more public keys stored
-// in Value. The Value has 2 formats:
-// - a JSON string if AliasKind is an AliasKindString.
-// - a JSON array of strings if AliasKind is an AliasKindArray.
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+)
+
+// Alias allows the user to associates a RegistryName + a Key to 1 or more values of the same kind stored in Value.
 type Alias struct {
 	tableName struct{} `pg:"aliases"` // nolint:unused,structcheck // reason
 
 	Key          AliasKey     `pg:",pk"`
 	RegistryName RegistryName `pg:",pk"`
-	// Value is a JSON array containing Tessera/Orion keys base64 encoded in strings.
-	Value AliasValue
+	// Value is the JSON encoded typed value of the alias
+	Value   AliasValue
+	Tags    map[string]string
+	Version int
+
+	CreatedBy string
+	UpdatedBy string
+	CreatedAt time.Time `pg:"default:now()"`
+	UpdatedAt time.Time `pg:"default:now()"`
+}
+
+// AliasVersion is a version of an alias, a version is created at every update of the alias.
+type AliasVersion struct {
+	tableName struct{} `pg:"alias_versions"` // nolint:unused,structcheck // reason
+
+	Key          AliasKey     `pg:",pk"`
+	RegistryName RegistryName `pg:",pk"`
+	Version      int          `pg:",pk"`
+	Value        AliasValue
+	Tags         map[string]string
+
+	CreatedBy string
+	CreatedAt time.Time `pg:"default:now()"`
 }
 
 func AliasFromEntity(ent aliasent.Alias) Alias {
 	return Alias{
 		Key:          AliasKey(ent.Key),
 		RegistryName: RegistryName(ent.RegistryName),
-		Value:        AliasValue(ent.Value),
+		Value:        AliasValueFromEntity(ent.Value),
+		Tags:         ent.Tags,
+		Version:      ent.Version,
+		CreatedBy:    ent.CreatedBy,
+		UpdatedBy:    ent.UpdatedBy,
+		CreatedAt:    ent.CreatedAt,
+		UpdatedAt:    ent.UpdatedAt,
 	}
 }
 
@@ -27,7 +56,41 @@ func (a *Alias) ToEntity() *aliasent.Alias {
 	return &aliasent.Alias{
 		Key:          aliasent.AliasKey(a.Key),
 		RegistryName: aliasent.RegistryName(a.RegistryName),
-		Value:        aliasent.AliasValue(a.Value),
+		Value:        a.Value.ToEntity(),
+		Tags:         a.Tags,
+		Version:      a.Version,
+		CreatedBy:    a.CreatedBy,
+		UpdatedBy:    a.UpdatedBy,
+		CreatedAt:    a.CreatedAt,
+		UpdatedAt:    a.UpdatedAt,
+	}
+}
+
+// CurrentVersion returns the current version of the alias
+func (a *Alias) CurrentVersion() AliasVersion {
+	return AliasVersion{
+		Key:          a.Key,
+		RegistryName: a.RegistryName,
+		Version:      a.Version,
+		Value:        a.Value,
+		Tags:         a.Tags,
+		CreatedBy:    a.UpdatedBy,
+		CreatedAt:    a.UpdatedAt,
+	}
+}
+
+// ToEntity returns the alias as it was at this version
+func (v *AliasVersion) ToEntity(alias *Alias) *aliasent.Alias {
+	return &aliasent.Alias{
+		Key:          aliasent.AliasKey(v.Key),
+		RegistryName: aliasent.RegistryName(v.RegistryName),
+		Value:        v.Value.ToEntity(),
+		Tags:         v.Tags,
+		Version:      v.Version,
+		CreatedBy:    alias.CreatedBy,
+		UpdatedBy:    v.CreatedBy,
+		CreatedAt:    alias.CreatedAt,
+		UpdatedAt:    v.CreatedAt,
 	}
 }
 
@@ -45,3 +108,23 @@ type AliasKey string
 type AliasValue string
 
 type RegistryName string
+
+type aliasValue struct {
+	Kind   aliasent.AliasKind `json:"kind"`
+	Values []string           `json:"values"`
+}
+
+func AliasValueFromEntity(ent aliasent.AliasValue) AliasValue {
+	b, _ := json.Marshal(aliasValue{Kind: ent.Kind, Values: ent.Values})
+	return AliasValue(b)
+}
+
+// ToEntity decodes the value, the values stored before values were typed are parsed as Tessera keys
+func (v AliasValue) ToEntity() aliasent.AliasValue {
+	var value aliasValue
+	if err := json.Unmarshal([]byte(v), &value); err != nil || value.Kind == "" {
+		return aliasent.ParseLegacyValue(string(v))
+	}
+
+	return aliasent.AliasValue{Kind: value.Kind, Values: value.Values}
+}
@@ -0,0 +1,49 @@
+package aliasmodels
+
+import (
+	"time"
+
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+)
+
+type Registry struct {
+	tableName struct{} `pg:"registries"` // nolint:unused,structcheck // reason
+
+	Name           RegistryName `pg:",pk"`
+	Description    string
+	Tenant         string
+	AllowedTenants []string `pg:",array"`
+	CreatedBy      string
+	CreatedAt      time.Time `pg:"default:now()"`
+}
+
+func RegistryFromEntity(ent aliasent.Registry) Registry {
+	return Registry{
+		Name:           RegistryName(ent.Name),
+		Description:    ent.Description,
+		Tenant:         ent.Tenant,
+		AllowedTenants: ent.AllowedTenants,
+		CreatedBy:      ent.CreatedBy,
+		CreatedAt:      ent.CreatedAt,
+	}
+}
+
+func (r *Registry) ToEntity() *aliasent.Registry {
+	return &aliasent.Registry{
+		Name:           aliasent.RegistryName(r.Name),
+		Description:    r.Description,
+		Tenant:         r.Tenant,
+		AllowedTenants: r.AllowedTenants,
+		CreatedBy:      r.CreatedBy,
+		CreatedAt:      r.CreatedAt,
+	}
+}
+
+func RegistriesToEntity(registries []Registry) []aliasent.Registry {
+	var ents []aliasent.Registry
+	for _, v := range registries {
+		ent := v.ToEntity()
+		ents = append(ents, *ent)
+	}
+	return ents
+}
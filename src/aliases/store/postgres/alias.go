@@ -2,11 +2,12 @@ package aliaspg
 
 import (
 	"context"
+	"sort"
 
-	"github.com/consensys/quorum-key-manager/pkg/errors"
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
 	aliasmodels "github.com/consensys/quorum-key-manager/src/aliases/store/models"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
 )
 
 var _ aliasent.AliasBackend = &AliasStore{}
@@ -25,12 +26,21 @@ func NewAlias(pgClient postgres.Client) *AliasStore {
 func (s *AliasStore) CreateAlias(ctx context.Context, registry aliasent.RegistryName, alias aliasent.Alias) (*aliasent.Alias, error) {
 	a := aliasmodels.AliasFromEntity(alias)
 	a.RegistryName = aliasmodels.RegistryName(registry)
+	a.Version = 1
 
-	err := s.pgClient.Insert(ctx, &a)
+	err := s.pgClient.RunInTransaction(ctx, func(client postgres.Client) error {
+		err := client.Insert(ctx, &a)
+		if err != nil {
+			return err
+		}
+
+		v := a.CurrentVersion()
+		return client.Insert(ctx, &v)
+	})
 	if err != nil {
 		return nil, err
 	}
-	return &alias, nil
+	return a.ToEntity(), nil
 }
 
 func (s *AliasStore) GetAlias(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) (*aliasent.Alias, error) {
@@ -42,12 +52,94 @@ func (s *AliasStore) GetAlias(ctx context.Context, registry aliasent.RegistryNam
 	return a.ToEntity(), err
 }
 
+// The version is incremented first so the alias is locked until the new version is inserted
+const incrementAliasVersionQuery = `
+UPDATE aliases SET version = version + 1 WHERE registry_name = ? AND key = ?
+RETURNING version`
+
 func (s *AliasStore) UpdateAlias(ctx context.Context, registry aliasent.RegistryName, alias aliasent.Alias) (*aliasent.Alias, error) {
-	a := aliasmodels.AliasFromEntity(alias)
-	a.RegistryName = aliasmodels.RegistryName(registry)
+	a := aliasmodels.Alias{
+		Key:          aliasmodels.AliasKey(alias.Key),
+		RegistryName: aliasmodels.RegistryName(registry),
+	}
 
-	err := s.pgClient.UpdatePK(ctx, &a)
-	return a.ToEntity(), err
+	err := s.pgClient.RunInTransaction(ctx, func(client postgres.Client) error {
+		var version int
+		err := client.QueryOne(ctx, &version, incrementAliasVersionQuery, a.RegistryName, a.Key)
+		if err != nil {
+			return err
+		}
+
+		err = client.SelectPK(ctx, &a)
+		if err != nil {
+			return err
+		}
+
+		// The creation of the alias is kept, the previous value remains available in its version
+		a.Value = aliasmodels.AliasValueFromEntity(alias.Value)
+		a.Tags = alias.Tags
+		a.Version = version
+		a.UpdatedBy = alias.UpdatedBy
+		a.UpdatedAt = alias.UpdatedAt
+
+		err = client.UpdatePK(ctx, &a)
+		if err != nil {
+			return err
+		}
+
+		v := a.CurrentVersion()
+		return client.Insert(ctx, &v)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.ToEntity(), nil
+}
+
+func (s *AliasStore) GetAliasVersion(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey, version int) (*aliasent.Alias, error) {
+	a := aliasmodels.Alias{
+		Key:          aliasmodels.AliasKey(aliasKey),
+		RegistryName: aliasmodels.RegistryName(registry),
+	}
+	err := s.pgClient.SelectPK(ctx, &a)
+	if err != nil {
+		return nil, err
+	}
+
+	v := aliasmodels.AliasVersion{
+		Key:          a.Key,
+		RegistryName: a.RegistryName,
+		Version:      version,
+	}
+	err = s.pgClient.SelectPK(ctx, &v)
+	if err != nil {
+		return nil, err
+	}
+	return v.ToEntity(&a), nil
+}
+
+func (s *AliasStore) ListAliasVersions(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) ([]aliasent.Alias, error) {
+	a := aliasmodels.Alias{
+		Key:          aliasmodels.AliasKey(aliasKey),
+		RegistryName: aliasmodels.RegistryName(registry),
+	}
+	err := s.pgClient.SelectPK(ctx, &a)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions []aliasmodels.AliasVersion
+	err = s.pgClient.SelectWhere(ctx, &versions, "alias_version.registry_name = ? AND alias_version.key = ?", a.RegistryName, a.Key)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(versions, func(i, j int) bool { return versions[i].Version < versions[j].Version })
+
+	ents := make([]aliasent.Alias, 0, len(versions))
+	for i := range versions {
+		ents = append(ents, *versions[i].ToEntity(&a))
+	}
+	return ents, nil
 }
 
 func (s *AliasStore) DeleteAlias(ctx context.Context, registry aliasent.RegistryName, aliasKey aliasent.AliasKey) error {
@@ -58,19 +150,69 @@ func (s *AliasStore) DeleteAlias(ctx context.Context, registry aliasent.Registry
 	return s.pgClient.DeletePK(ctx, &a)
 }
 
-func (s *AliasStore) ListAliases(ctx context.Context, registry aliasent.RegistryName) ([]aliasent.Alias, error) {
+func (s *AliasStore) ListAliases(ctx context.Context, registry aliasent.RegistryName, prefix string, limit, offset uint64) ([]aliasent.Alias, error) {
 	reg := aliasmodels.RegistryName(registry)
 
+	// Keys are selected first so the aliases can be paginated in order of creation
+	var keys []string
+	query := searchQuery("key", "aliases", "registry_name = ? AND key LIKE ?", limit, offset)
+	err := s.pgClient.Query(ctx, &keys, query, reg, likePrefix(prefix))
+	if err != nil {
+		return nil, err
+	}
+
+	ents := []aliasent.Alias{}
+	if len(keys) == 0 {
+		return ents, nil
+	}
+
 	var als []aliasmodels.Alias
-	err := s.pgClient.SelectWhere(ctx, &als, "alias.registry_name = ?", reg)
+	err = s.pgClient.SelectWhere(ctx, &als, "alias.registry_name = ? AND alias.key IN (?)", reg, pg.In(keys))
+	if err != nil {
+		return nil, err
+	}
+
+	byKey := make(map[aliasmodels.AliasKey]*aliasmodels.Alias, len(als))
+	for i := range als {
+		byKey[als[i].Key] = &als[i]
+	}
+	for _, key := range keys {
+		if a, ok := byKey[aliasmodels.AliasKey(key)]; ok {
+			ents = append(ents, *a.ToEntity())
+		}
+	}
+
+	return ents, nil
+}
+
+// ImportAliases replaces the aliases of the registry in a single transaction
+func (s *AliasStore) ImportAliases(ctx context.Context, registry aliasent.RegistryName, aliases []aliasent.Alias, dryRun bool) (*aliasent.AliasesDiff, error) {
+	var diff *aliasent.AliasesDiff
+	err := s.pgClient.RunInTransaction(ctx, func(client postgres.Client) error {
+		store := NewAlias(client)
+		current, err := store.ListAliases(ctx, registry, "", 0, 0)
+		if err != nil {
+			return err
+		}
+
+		diff = aliasent.DiffAliases(current, aliases)
+		if dryRun {
+			return nil
+		}
+
+		return aliasent.ApplyAliasesDiff(ctx, store, registry, diff)
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	ents := aliasmodels.AliasesToEntity(als)
-	return ents, err
+	return diff, nil
 }
 
+// DeleteRegistry deletes a registry, its aliases are deleted in cascade
 func (s *AliasStore) DeleteRegistry(ctx context.Context, registry aliasent.RegistryName) error {
-	return errors.NotImplementedError("DeleteRegistry not implemented")
+	r := aliasmodels.Registry{
+		Name: aliasmodels.RegistryName(registry),
+	}
+	return s.pgClient.DeletePK(ctx, &r)
 }
@@ -1,20 +1,39 @@
 package aliaspg
 
 import (
+	"context"
+
 	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasstore "github.com/consensys/quorum-key-manager/src/aliases/store"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres"
 )
 
 type Database struct {
-	alias *AliasStore
+	pgClient postgres.Client
+	alias    *AliasStore
+	registry *RegistryStore
 }
 
+var _ aliasstore.Database = &Database{}
+
 func NewDatabase(pgClient postgres.Client) *Database {
 	return &Database{
-		alias: NewAlias(pgClient),
+		pgClient: pgClient,
+		alias:    NewAlias(pgClient),
+		registry: NewRegistry(pgClient),
 	}
 }
 
+func (db *Database) RunInTransaction(ctx context.Context, persist func(dbtx aliasstore.Database) error) error {
+	return db.pgClient.RunInTransaction(ctx, func(dbTx postgres.Client) error {
+		return persist(NewDatabase(dbTx))
+	})
+}
+
 func (db *Database) Alias() aliasent.AliasBackend {
 	return db.alias
 }
+
+func (db *Database) Registry() aliasent.RegistryBackend {
+	return db.registry
+}
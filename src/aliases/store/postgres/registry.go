@@ -0,0 +1,97 @@
+package aliaspg
+
+import (
+	"context"
+
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliasmodels "github.com/consensys/quorum-key-manager/src/aliases/store/models"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	"github.com/go-pg/pg/v10"
+)
+
+var _ aliasent.RegistryBackend = &RegistryStore{}
+
+// RegistryStore stores the registries in a postgres DB.
+type RegistryStore struct {
+	pgClient postgres.Client
+}
+
+func NewRegistry(pgClient postgres.Client) *RegistryStore {
+	return &RegistryStore{
+		pgClient: pgClient,
+	}
+}
+
+func (s *RegistryStore) CreateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	r := aliasmodels.RegistryFromEntity(registry)
+
+	err := s.pgClient.Insert(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.ToEntity(), nil
+}
+
+func (s *RegistryStore) GetRegistry(ctx context.Context, registry aliasent.RegistryName) (*aliasent.Registry, error) {
+	r := aliasmodels.Registry{
+		Name: aliasmodels.RegistryName(registry),
+	}
+	err := s.pgClient.SelectPK(ctx, &r)
+	if err != nil {
+		return nil, err
+	}
+	return r.ToEntity(), nil
+}
+
+func (s *RegistryStore) UpdateRegistry(ctx context.Context, registry aliasent.Registry) (*aliasent.Registry, error) {
+	r := aliasmodels.RegistryFromEntity(registry)
+
+	// The description and the allowed tenants can be emptied, so all the fields are updated
+	var name string
+	err := s.pgClient.QueryOne(ctx, &name, "UPDATE registries SET description = ?, tenant = ?, allowed_tenants = ? WHERE name = ? RETURNING name",
+		r.Description, r.Tenant, pg.Array(r.AllowedTenants), r.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.GetRegistry(ctx, registry.Name)
+}
+
+func (s *RegistryStore) ListRegistries(ctx context.Context, tenants []string, prefix string, limit, offset uint64) ([]aliasent.Registry, error) {
+	whereCond := "name LIKE ?"
+	whereArgs := []interface{}{likePrefix(prefix)}
+	if tenants != nil {
+		whereCond += " AND (tenant IS NULL OR tenant = '' OR tenant IN (?) OR allowed_tenants && ?)"
+		whereArgs = append(whereArgs, pg.In(tenants), pg.Array(tenants))
+	}
+
+	// Names are selected first so the registries can be paginated in order of creation
+	var names []string
+	err := s.pgClient.Query(ctx, &names, searchQuery("name", "registries", whereCond, limit, offset), whereArgs...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(names) == 0 {
+		return []aliasent.Registry{}, nil
+	}
+
+	var regs []aliasmodels.Registry
+	err = s.pgClient.SelectWhere(ctx, &regs, "registry.name IN (?)", pg.In(names))
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[aliasmodels.RegistryName]*aliasmodels.Registry, len(regs))
+	for i := range regs {
+		byName[regs[i].Name] = &regs[i]
+	}
+	ents := make([]aliasent.Registry, 0, len(names))
+	for _, name := range names {
+		if r, ok := byName[aliasmodels.RegistryName(name)]; ok {
+			ents = append(ents, *r.ToEntity())
+		}
+	}
+
+	return ents, nil
+}
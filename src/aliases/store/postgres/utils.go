@@ -0,0 +1,25 @@
+package aliaspg
+
+import (
+	"fmt"
+	"strings"
+)
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// likePrefix returns the LIKE pattern matching the strings starting with the prefix
+func likePrefix(prefix string) string {
+	return likeEscaper.Replace(prefix) + "%"
+}
+
+// searchQuery selects the ordered values of a column, a limit of 0 selecting all the values from the offset
+func searchQuery(column, table, whereCond string, limit, offset uint64) string {
+	switch {
+	case limit != 0:
+		return fmt.Sprintf("SELECT (array_agg(%s ORDER BY created_at ASC, %s ASC))[%d:%d] FROM %s WHERE %s", column, column, offset+1, offset+limit, table, whereCond)
+	case offset != 0:
+		return fmt.Sprintf("SELECT (array_agg(%s ORDER BY created_at ASC, %s ASC))[%d:] FROM %s WHERE %s", column, column, offset+1, table, whereCond)
+	default:
+		return fmt.Sprintf("SELECT array_agg(%s ORDER BY created_at ASC, %s ASC) FROM %s WHERE %s", column, column, table, whereCond)
+	}
+}
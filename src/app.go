@@ -6,12 +6,18 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/http/server"
 	"github.com/consensys/quorum-key-manager/src/aliases"
 	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/oidc"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	tokenapp "github.com/consensys/quorum-key-manager/src/auth/token/app"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	"github.com/consensys/quorum-key-manager/src/manifests"
 	manifestsmanager "github.com/consensys/quorum-key-manager/src/manifests/manager"
 	"github.com/consensys/quorum-key-manager/src/nodes"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	stores "github.com/consensys/quorum-key-manager/src/stores/app"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 	"github.com/justinas/alice"
 )
 
@@ -21,6 +27,10 @@ type Config struct {
 	Manifests *manifestsmanager.Config
 	Postgres  *client.Config
 	Auth      *auth.Config
+	RateLimit *limiter.Config
+	Token     *token.Config
+	Nonce     *nonce.Config
+	Txs       *transactions.Config
 }
 
 func New(cfg *Config, logger log.Logger) (*app.App, error) {
@@ -38,7 +48,17 @@ func New(cfg *Config, logger log.Logger) (*app.App, error) {
 		return nil, err
 	}
 
-	err = a.RegisterServiceConfig(&stores.Config{Postgres: cfg.Postgres})
+	err = a.RegisterServiceConfig(cfg.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.RegisterServiceConfig(&stores.Config{Postgres: cfg.Postgres, RateLimit: cfg.RateLimit})
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.RegisterServiceConfig(&nodes.Config{Postgres: cfg.Postgres, Nonce: cfg.Nonce, Txs: cfg.Txs})
 	if err != nil {
 		return nil, err
 	}
@@ -64,8 +84,18 @@ func New(cfg *Config, logger log.Logger) (*app.App, error) {
 		return nil, err
 	}
 
+	tokenIssuer, err := tokenapp.RegisterService(a, logger.WithComponent("token"))
+	if err != nil {
+		return nil, err
+	}
+
 	// Set Middleware
-	authmid, err := auth.Middleware(a, logger.WithComponent("auth-mid"))
+	var issuerKey oidc.PublicKeyResolver
+	if tokenIssuer != nil {
+		issuerKey = tokenIssuer
+	}
+
+	authmid, err := auth.Middleware(a, issuerKey, logger.WithComponent("auth-mid"))
 	if err != nil {
 		return nil, err
 	}
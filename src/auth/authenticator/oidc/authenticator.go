@@ -3,6 +3,7 @@ package oidc
 import (
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
@@ -20,12 +21,12 @@ type Authenticator struct {
 var _ authenticator.Authenticator = Authenticator{}
 
 func NewAuthenticator(cfg *Config) (*Authenticator, error) {
-	if len(cfg.Certificates) == 0 {
+	if len(cfg.Certificates) == 0 && cfg.Issuer == nil {
 		return nil, nil
 	}
 
 	auth := &Authenticator{
-		jwtChecker: NewJWTChecker(cfg.Certificates, cfg.Claims, false),
+		jwtChecker: NewJWTChecker(cfg.Certificates, cfg.Claims, false).WithIssuer(cfg.Issuer),
 	}
 
 	return auth, nil
@@ -54,6 +55,16 @@ func (a Authenticator) Authenticate(req *http.Request) (*types.UserInfo, error)
 	if jwtData.MapClaims[rolesClaim] != nil {
 		userInfo.Roles = utils.ExtractRoles(jwtData.MapClaims[rolesClaim].(string))
 	}
+	if stores, ok := jwtData.MapClaims[StoresClaim].(string); ok {
+		userInfo.Stores = strings.Fields(stores)
+	}
+	if addresses, ok := jwtData.MapClaims[AddressesClaim].(string); ok {
+		userInfo.Addresses = strings.Fields(addresses)
+	}
+	if exp, ok := jwtData.MapClaims["exp"].(float64); ok {
+		userInfo.ExpiresAt = time.Unix(int64(exp), 0).UTC()
+	}
+
 	return userInfo, nil
 }
 
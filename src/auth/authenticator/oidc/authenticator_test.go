@@ -88,6 +88,23 @@ func TestAuthenticator_RSAToken(t *testing.T) {
 		assert.Equal(t, []types.Permission{"read:key", "write:key"}, userInfo.Permissions)
 	})
 
+	t.Run("should extract stores and addresses restrictions successfully", func(t *testing.T) {
+		token, _ := generator.GenerateAccessToken(map[string]interface{}{
+			claimsCfg.Subject: "tenant|username",
+			claimsCfg.Scope:   "sign:ethereum",
+			StoresClaim:       "eth-accounts other-accounts",
+			AddressesClaim:    "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18",
+		}, time.Second)
+
+		req := httptest.NewRequest("GET", "http://test.url", nil)
+		req.Header.Add("Authorization", fmt.Sprintf("%s %s", BearerSchema, token))
+		userInfo, err := auth.Authenticate(req)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"eth-accounts", "other-accounts"}, userInfo.Stores)
+		assert.Equal(t, []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"}, userInfo.Addresses)
+		assert.WithinDuration(t, time.Now().Add(time.Second), userInfo.ExpiresAt, 2*time.Second)
+	})
+
 	t.Run("should reject request for invalid token", func(t *testing.T) {
 		token := "invalid-auth-token"
 		req := httptest.NewRequest("GET", "http://test.url", nil)
@@ -7,8 +7,17 @@ import (
 type Config struct {
 	Certificates []*x509.Certificate
 	Claims       *ClaimsConfig
+
+	// Issuer resolves the key of the tokens issued by the key manager itself, nil if token issuance is disabled
+	Issuer PublicKeyResolver
 }
 
+// StoresClaim and AddressesClaim restrict the stores and Ethereum accounts reachable with a token, values are space separated
+const (
+	StoresClaim    = "qkm.stores"
+	AddressesClaim = "qkm.addresses"
+)
+
 type ClaimsConfig struct {
 	Subject string
 	Scope   string
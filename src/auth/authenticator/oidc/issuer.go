@@ -0,0 +1,13 @@
+package oidc
+
+import (
+	"context"
+	"crypto/ecdsa"
+)
+
+//go:generate mockgen -source=issuer.go -destination=mock/issuer.go -package=mock
+
+// PublicKeyResolver resolves the public key of the tokens issued by the key manager itself
+type PublicKeyResolver interface {
+	PublicKey(ctx context.Context) (*ecdsa.PublicKey, error)
+}
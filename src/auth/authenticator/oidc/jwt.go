@@ -7,11 +7,13 @@ import (
 	"crypto/x509"
 	"fmt"
 
+	qkmjwt "github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/golang-jwt/jwt"
 )
 
 type JWTChecker struct {
 	certs     []*x509.Certificate
+	issuer    PublicKeyResolver
 	parser    *jwt.Parser
 	claimsCfg *ClaimsConfig
 }
@@ -26,8 +28,14 @@ func NewJWTChecker(certs []*x509.Certificate, claimsCfg *ClaimsConfig, skipClaim
 	}
 }
 
-func (checker *JWTChecker) Check(_ context.Context, bearerToken string) (*Claims, error) {
-	if len(checker.certs) == 0 {
+// WithIssuer accepts the ES256K tokens signed by the key manager itself
+func (checker *JWTChecker) WithIssuer(issuer PublicKeyResolver) *JWTChecker {
+	checker.issuer = issuer
+	return checker
+}
+
+func (checker *JWTChecker) Check(ctx context.Context, bearerToken string) (*Claims, error) {
+	if len(checker.certs) == 0 && checker.issuer == nil {
 		// If no certificate provided we deactivate authentication
 		return nil, nil
 	}
@@ -36,7 +44,9 @@ func (checker *JWTChecker) Check(_ context.Context, bearerToken string) (*Claims
 	token, err := checker.parser.ParseWithClaims(
 		bearerToken,
 		&Claims{cfg: checker.claimsCfg},
-		checker.keyFunc,
+		func(token *jwt.Token) (interface{}, error) {
+			return checker.keyFunc(ctx, token)
+		},
 	)
 	if err != nil {
 		return nil, err
@@ -47,7 +57,15 @@ func (checker *JWTChecker) Check(_ context.Context, bearerToken string) (*Claims
 	return token.Claims.(*Claims), nil
 }
 
-func (checker *JWTChecker) keyFunc(token *jwt.Token) (interface{}, error) {
+func (checker *JWTChecker) keyFunc(ctx context.Context, token *jwt.Token) (interface{}, error) {
+	if token.Method.Alg() == qkmjwt.ES256KAlg {
+		if checker.issuer == nil {
+			return nil, fmt.Errorf("unexpected method: %s", token.Method.Alg())
+		}
+
+		return checker.issuer.PublicKey(ctx)
+	}
+
 	for _, cert := range checker.certs {
 		if _, ok := token.Method.(*jwt.SigningMethodRSA); ok {
 			return cert.PublicKey.(*rsa.PublicKey), nil
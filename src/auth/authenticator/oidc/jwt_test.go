@@ -10,6 +10,9 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/consensys/quorum-key-manager/pkg/tls/certificate"
 	"github.com/consensys/quorum-key-manager/pkg/tls/testutils"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/oidc/mock"
+	"github.com/ethereum/go-ethereum/crypto"
+	jwtgo "github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -71,3 +74,69 @@ func TestJWTChecker_RSAToken(t *testing.T) {
 		assert.Empty(t, claims)
 	})
 }
+
+func TestJWTChecker_IssuedToken(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	ctx := context.Background()
+
+	claimsCfg := &ClaimsConfig{
+		Subject: "sub",
+		Scope:   "scope",
+	}
+
+	privKey, _ := crypto.GenerateKey()
+	issuer := mock.NewMockPublicKeyResolver(ctrl)
+	checker := NewJWTChecker(nil, claimsCfg, false).WithIssuer(issuer)
+
+	t.Run("should accept token signed by the issuer key successfully", func(t *testing.T) {
+		issuer.EXPECT().PublicKey(gomock.Any()).Return(&privKey.PublicKey, nil)
+
+		token, _ := jwtgo.NewWithClaims(jwt.SigningMethodSecp256k1, jwtgo.MapClaims{
+			"sub":   "tenant|username",
+			"scope": "sign:ethereum",
+			"exp":   time.Now().Add(time.Minute).Unix(),
+		}).SignedString(privKey)
+
+		claims, err := checker.Check(ctx, token)
+		require.NoError(t, err)
+		assert.Equal(t, "tenant|username", claims.Subject)
+		assert.Equal(t, []string{"sign:ethereum"}, claims.Scope)
+	})
+
+	t.Run("should reject token signed by another key", func(t *testing.T) {
+		issuer.EXPECT().PublicKey(gomock.Any()).Return(&privKey.PublicKey, nil)
+
+		otherKey, _ := crypto.GenerateKey()
+		token, _ := jwtgo.NewWithClaims(jwt.SigningMethodSecp256k1, jwtgo.MapClaims{
+			"sub": "tenant|username",
+		}).SignedString(otherKey)
+
+		_, err := checker.Check(ctx, token)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject expired token", func(t *testing.T) {
+		issuer.EXPECT().PublicKey(gomock.Any()).Return(&privKey.PublicKey, nil)
+
+		token, _ := jwtgo.NewWithClaims(jwt.SigningMethodSecp256k1, jwtgo.MapClaims{
+			"sub": "tenant|username",
+			"exp": time.Now().Add(-time.Minute).Unix(),
+		}).SignedString(privKey)
+
+		_, err := checker.Check(ctx, token)
+		assert.Error(t, err)
+	})
+
+	t.Run("should reject ES256K token if no issuer is configured", func(t *testing.T) {
+		cert, _ := certificate.X509KeyPair([]byte(testutils.RSACertPEM), []byte(testutils.RSAKeyPEM))
+		certChecker := NewJWTChecker([]*x509.Certificate{cert.Leaf}, claimsCfg, false)
+
+		token, _ := jwtgo.NewWithClaims(jwt.SigningMethodSecp256k1, jwtgo.MapClaims{
+			"sub": "tenant|username",
+		}).SignedString(privKey)
+
+		_, err := certChecker.Check(ctx, token)
+		assert.Error(t, err)
+	})
+}
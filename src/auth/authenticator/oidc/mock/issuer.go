@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: issuer.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	ecdsa "crypto/ecdsa"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPublicKeyResolver is a mock of PublicKeyResolver interface.
+type MockPublicKeyResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockPublicKeyResolverMockRecorder
+}
+
+// MockPublicKeyResolverMockRecorder is the mock recorder for MockPublicKeyResolver.
+type MockPublicKeyResolverMockRecorder struct {
+	mock *MockPublicKeyResolver
+}
+
+// NewMockPublicKeyResolver creates a new mock instance.
+func NewMockPublicKeyResolver(ctrl *gomock.Controller) *MockPublicKeyResolver {
+	mock := &MockPublicKeyResolver{ctrl: ctrl}
+	mock.recorder = &MockPublicKeyResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPublicKeyResolver) EXPECT() *MockPublicKeyResolverMockRecorder {
+	return m.recorder
+}
+
+// PublicKey mocks base method.
+func (m *MockPublicKeyResolver) PublicKey(ctx context.Context) (*ecdsa.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKey", ctx)
+	ret0, _ := ret[0].(*ecdsa.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKey indicates an expected call of PublicKey.
+func (mr *MockPublicKeyResolverMockRecorder) PublicKey(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKey", reflect.TypeOf((*MockPublicKeyResolver)(nil).PublicKey), ctx)
+}
@@ -2,13 +2,12 @@ package tls
 
 import (
 	"crypto/x509"
+	"fmt"
 	"net/http"
 
 	"github.com/consensys/quorum-key-manager/pkg/tls"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
-
-	"github.com/consensys/quorum-key-manager/src/auth/authenticator/utils"
 	"github.com/consensys/quorum-key-manager/src/auth/types"
 )
 
@@ -16,15 +15,47 @@ const AuthMode = "Tls"
 
 type Authenticator struct {
 	rootCAs *x509.CertPool
+	crl     *crlChecker
+	ocsp    *ocspChecker
+	mapping *Mapping
 }
 
 func NewAuthenticator(cfg *Config) (*Authenticator, error) {
 	if cfg.CAs == nil {
 		return nil, nil
 	}
+
 	auth := &Authenticator{
 		rootCAs: cfg.CAs,
+		mapping: cfg.Mapping,
+	}
+
+	if auth.mapping == nil {
+		auth.mapping = DefaultMapping()
+	} else if auth.mapping.Username == nil {
+		return nil, errors.ConfigError("username certificate field is required")
+	}
+
+	if err := auth.mapping.validate(); err != nil {
+		return nil, errors.ConfigError(err.Error())
+	}
+
+	if cfg.CRLFile != "" {
+		crl, err := newCRLChecker(cfg.CRLFile, cfg.CRLReloadInterval)
+		if err != nil {
+			return nil, errors.ConfigError(err.Error())
+		}
+		auth.crl = crl
+	}
+
+	switch cfg.OCSP {
+	case OCSPDisabled:
+	case OCSPOptional, OCSPRequired:
+		auth.ocsp = newOCSPChecker(cfg.OCSP, http.DefaultClient)
+	default:
+		return nil, errors.ConfigError("invalid OCSP mode %q", cfg.OCSP)
 	}
+
 	return auth, nil
 }
 
@@ -40,21 +71,49 @@ func (auth Authenticator) Authenticate(req *http.Request) (*types.UserInfo, erro
 		return nil, errors.UnauthorizedError("request must complete valid handshake")
 	}
 
-	err := tls.VerifyCertificateAuthority(req.TLS.PeerCertificates, req.TLS.ServerName, auth.rootCAs, true)
+	chains, err := tls.VerifyCertificateChains(req.TLS.PeerCertificates, req.TLS.ServerName, auth.rootCAs, true)
+	if err != nil {
+		return nil, errors.UnauthorizedError(err.Error())
+	}
+
+	// first array element is the leaf
+	clientCert := req.TLS.PeerCertificates[0]
+
+	err = auth.checkRevocation(req, clientCert, issuerOf(chains))
 	if err != nil {
 		return nil, errors.UnauthorizedError(err.Error())
 	}
 
 	// UserInfo returned is retrieved from cert contents
-	userInfo := &types.UserInfo{
-		AuthMode: AuthMode,
+	return auth.mapping.userInfo(clientCert), nil
+}
+
+func (auth Authenticator) checkRevocation(req *http.Request, cert, issuer *x509.Certificate) error {
+	if auth.crl != nil {
+		if err := auth.crl.check(cert, issuer); err != nil {
+			return err
+		}
 	}
 
-	// first array element is the leaf
-	clientCert := req.TLS.PeerCertificates[0]
-	userInfo.Username, userInfo.Tenant = utils.ExtractUsernameAndTenant(clientCert.Subject.CommonName)
-	userInfo.Permissions = utils.ExtractPermissions(clientCert.Subject.OrganizationalUnit)
-	userInfo.Roles = clientCert.Subject.Organization
+	switch {
+	case auth.ocsp == nil:
+	case cert == issuer && auth.ocsp.mode == OCSPRequired:
+		return fmt.Errorf("cannot check status of self-signed certificate")
+	case cert != issuer:
+		if err := auth.ocsp.check(req.Context(), cert, issuer, req.TLS.OCSPResponse); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// issuerOf returns the issuer of the leaf of the first verified chain, the leaf itself if it is a root CA
+func issuerOf(chains [][]*x509.Certificate) *x509.Certificate {
+	chain := chains[0]
+	if len(chain) > 1 {
+		return chain[1]
+	}
 
-	return userInfo, nil
+	return chain[0]
 }
@@ -2,10 +2,36 @@ package tls
 
 import (
 	"crypto/x509"
+	"time"
 )
 
+type OCSPMode string
+
+const (
+	// OCSPDisabled skips OCSP checks
+	OCSPDisabled OCSPMode = ""
+	// OCSPOptional rejects revoked certificates but accepts certificates whose status cannot be retrieved
+	OCSPOptional OCSPMode = "optional"
+	// OCSPRequired rejects certificates whose status cannot be retrieved
+	OCSPRequired OCSPMode = "required"
+)
+
+const DefaultCRLReloadInterval = 30 * time.Second
+
 type Config struct {
 	CAs *x509.CertPool
+
+	// CRLFile is the path of a file of PEM or DER encoded certificate revocation lists, reloaded when modified
+	CRLFile string
+
+	// CRLReloadInterval is the minimum interval between two checks of the CRL file modification
+	CRLReloadInterval time.Duration
+
+	// OCSP checks the status of the client certificates using the stapled OCSP response or the responder of the certificate
+	OCSP OCSPMode
+
+	// Mapping maps the certificate fields to the user info, nil to use the default mapping
+	Mapping *Mapping
 }
 
 func NewConfig(cas *x509.CertPool) *Config {
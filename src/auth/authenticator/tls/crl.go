@@ -0,0 +1,147 @@
+package tls
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+const crlPEMType = "X509 CRL"
+
+// crlChecker checks certificates against the revocation lists of a file, reloaded when modified
+type crlChecker struct {
+	path           string
+	reloadInterval time.Duration
+	now            func() time.Time
+
+	mux       sync.RWMutex
+	crls      []*pkix.CertificateList
+	modTime   time.Time
+	checkedAt time.Time
+}
+
+func newCRLChecker(path string, reloadInterval time.Duration) (*crlChecker, error) {
+	if reloadInterval <= 0 {
+		reloadInterval = DefaultCRLReloadInterval
+	}
+
+	checker := &crlChecker{
+		path:           path,
+		reloadInterval: reloadInterval,
+		now:            time.Now,
+	}
+
+	// The CRL file must be valid at startup
+	if err := checker.reload(); err != nil {
+		return nil, err
+	}
+
+	return checker, nil
+}
+
+// check fails if the certificate is revoked by the CRL of its issuer. Certificates whose issuer has no CRL are accepted
+func (c *crlChecker) check(cert, issuer *x509.Certificate) error {
+	c.reloadIfModified()
+
+	c.mux.RLock()
+	defer c.mux.RUnlock()
+
+	for _, crl := range c.crls {
+		if crl.TBSCertList.Issuer.String() != issuer.Subject.ToRDNSequence().String() {
+			continue
+		}
+
+		if err := issuer.CheckCRLSignature(crl); err != nil {
+			return fmt.Errorf("invalid CRL signature: %w", err)
+		}
+		if crl.HasExpired(c.now()) {
+			return fmt.Errorf("CRL of %q has expired", issuer.Subject.String())
+		}
+
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return fmt.Errorf("certificate has been revoked")
+			}
+		}
+	}
+
+	return nil
+}
+
+// reloadIfModified reloads the CRL file if it has been modified. The previous lists are kept if the new file is invalid
+func (c *crlChecker) reloadIfModified() {
+	c.mux.RLock()
+	due := c.now().Sub(c.checkedAt) >= c.reloadInterval
+	c.mux.RUnlock()
+	if !due {
+		return
+	}
+
+	_ = c.reload()
+}
+
+func (c *crlChecker) reload() error {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.checkedAt = c.now()
+
+	info, err := os.Stat(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read CRL file: %w", err)
+	}
+	if c.crls != nil && info.ModTime().Equal(c.modTime) {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(c.path)
+	if err != nil {
+		return fmt.Errorf("failed to read CRL file: %w", err)
+	}
+
+	crls, err := parseCRLs(content)
+	if err != nil {
+		return err
+	}
+
+	c.crls = crls
+	c.modTime = info.ModTime()
+
+	return nil
+}
+
+func parseCRLs(content []byte) ([]*pkix.CertificateList, error) {
+	if len(content) == 0 {
+		return []*pkix.CertificateList{}, nil
+	}
+
+	// DER encoded file containing a single CRL
+	if block, _ := pem.Decode(content); block == nil {
+		crl, err := x509.ParseDERCRL(content)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CRL: %w", err)
+		}
+
+		return []*pkix.CertificateList{crl}, nil
+	}
+
+	crls := []*pkix.CertificateList{}
+	for block, rest := pem.Decode(content); block != nil; block, rest = pem.Decode(rest) {
+		if block.Type != crlPEMType {
+			continue
+		}
+
+		crl, err := x509.ParseDERCRL(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse CRL: %w", err)
+		}
+		crls = append(crls, crl)
+	}
+
+	return crls, nil
+}
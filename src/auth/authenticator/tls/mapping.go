@@ -0,0 +1,229 @@
+package tls
+
+import (
+	"crypto/x509"
+	"encoding/asn1"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/utils"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+)
+
+// Sources of the certificate fields. Custom OIDs of the subject or the extensions are selected with the prefix "oid:" (ie. "oid:1.3.6.1.4.1.99999.1")
+const (
+	CommonNameSource         = "cn"
+	OrganizationSource       = "o"
+	OrganizationalUnitSource = "ou"
+	URISANSource             = "uri-san"
+	DNSSANSource             = "dns-san"
+	EmailSANSource           = "email-san"
+	OIDSourcePrefix          = "oid:"
+)
+
+// Field selects the values of a certificate field. If Pattern is set, only the matching values are kept and the first capture group, if any, is extracted
+type Field struct {
+	Source  string
+	Pattern *regexp.Regexp
+
+	oid asn1.ObjectIdentifier
+}
+
+// Mapping maps the certificate fields to the user info
+type Mapping struct {
+	Username *Field
+	// Tenant is extracted from the username ("tenant|username") if not set
+	Tenant      *Field
+	Roles       *Field
+	Permissions *Field
+}
+
+// DefaultMapping extracts username and tenant from the common name, roles from the organization and permissions from the organizational unit
+func DefaultMapping() *Mapping {
+	return &Mapping{
+		Username:    &Field{Source: CommonNameSource},
+		Roles:       &Field{Source: OrganizationSource},
+		Permissions: &Field{Source: OrganizationalUnitSource},
+	}
+}
+
+// ParseField parses a field with format "<source>[=<pattern>]" (ie. "uri-san=^spiffe://example.org/ns/[^/]+/sa/([^/]+)$")
+func ParseField(s string) (*Field, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	field := &Field{Source: s}
+	if i := strings.Index(s, "="); i >= 0 {
+		pattern, err := regexp.Compile(s[i+1:])
+		if err != nil {
+			return nil, fmt.Errorf("invalid pattern of certificate field %q: %w", s, err)
+		}
+
+		field.Source, field.Pattern = s[:i], pattern
+	}
+
+	if err := field.validate(); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func (m *Mapping) validate() error {
+	for _, f := range []*Field{m.Username, m.Tenant, m.Roles, m.Permissions} {
+		if f == nil {
+			continue
+		}
+
+		if err := f.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Mapping) userInfo(cert *x509.Certificate) *types.UserInfo {
+	userInfo := &types.UserInfo{
+		AuthMode: AuthMode,
+	}
+
+	if username := first(m.Username.values(cert)); m.Tenant == nil {
+		userInfo.Username, userInfo.Tenant = utils.ExtractUsernameAndTenant(username)
+	} else {
+		userInfo.Username = username
+		userInfo.Tenant = first(m.Tenant.values(cert))
+	}
+
+	userInfo.Roles = m.Roles.values(cert)
+	userInfo.Permissions = utils.ExtractPermissions(m.Permissions.values(cert))
+
+	return userInfo
+}
+
+func (f *Field) validate() error {
+	switch {
+	case f.Source == CommonNameSource, f.Source == OrganizationSource, f.Source == OrganizationalUnitSource,
+		f.Source == URISANSource, f.Source == DNSSANSource, f.Source == EmailSANSource:
+		return nil
+	case strings.HasPrefix(f.Source, OIDSourcePrefix):
+		oid, err := parseOID(strings.TrimPrefix(f.Source, OIDSourcePrefix))
+		if err != nil {
+			return fmt.Errorf("invalid certificate field %q: %w", f.Source, err)
+		}
+
+		f.oid = oid
+		return nil
+	default:
+		return fmt.Errorf("unknown certificate field %q", f.Source)
+	}
+}
+
+func (f *Field) values(cert *x509.Certificate) []string {
+	if f == nil {
+		return nil
+	}
+
+	var raw []string
+	switch f.Source {
+	case CommonNameSource:
+		if cert.Subject.CommonName != "" {
+			raw = []string{cert.Subject.CommonName}
+		}
+	case OrganizationSource:
+		raw = cert.Subject.Organization
+	case OrganizationalUnitSource:
+		raw = cert.Subject.OrganizationalUnit
+	case URISANSource:
+		for _, uri := range cert.URIs {
+			raw = append(raw, uri.String())
+		}
+	case DNSSANSource:
+		raw = cert.DNSNames
+	case EmailSANSource:
+		raw = cert.EmailAddresses
+	default:
+		raw = oidValues(cert, f.oid)
+	}
+
+	if f.Pattern == nil {
+		return raw
+	}
+
+	var values []string
+	for _, v := range raw {
+		match := f.Pattern.FindStringSubmatch(v)
+		switch {
+		case match == nil:
+			continue
+		case len(match) > 1:
+			values = append(values, match[1])
+		default:
+			values = append(values, match[0])
+		}
+	}
+
+	return values
+}
+
+// oidValues returns the string values of the subject attributes and of the extensions with the given OID
+func oidValues(cert *x509.Certificate, oid asn1.ObjectIdentifier) []string {
+	if oid == nil {
+		return nil
+	}
+
+	var values []string
+	for _, name := range cert.Subject.Names {
+		if v, ok := name.Value.(string); ok && name.Type.Equal(oid) {
+			values = append(values, v)
+		}
+	}
+
+	for _, ext := range cert.Extensions {
+		if !ext.Id.Equal(oid) {
+			continue
+		}
+
+		var v string
+		if _, err := asn1.Unmarshal(ext.Value, &v); err == nil {
+			values = append(values, v)
+			continue
+		}
+
+		var vs []string
+		if _, err := asn1.Unmarshal(ext.Value, &vs); err == nil {
+			values = append(values, vs...)
+		}
+	}
+
+	return values
+}
+
+func parseOID(s string) (asn1.ObjectIdentifier, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("OID must have at least two components")
+	}
+
+	oid := make(asn1.ObjectIdentifier, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid OID component %q", part)
+		}
+		oid[i] = n
+	}
+
+	return oid, nil
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,135 @@
+package tls
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	ocspRequestContentType = "application/ocsp-request"
+	ocspRequestTimeout     = 5 * time.Second
+	// ocspDefaultCacheTTL is used for responses without next update
+	ocspDefaultCacheTTL = 5 * time.Minute
+)
+
+// ocspChecker checks the status of certificates with the stapled OCSP response or the responder of the certificate. Responses are cached until their next update
+type ocspChecker struct {
+	mode   OCSPMode
+	client *http.Client
+	now    func() time.Time
+
+	mux   sync.RWMutex
+	cache map[string]*ocspEntry
+}
+
+type ocspEntry struct {
+	status    int
+	expiresAt time.Time
+}
+
+func newOCSPChecker(mode OCSPMode, client *http.Client) *ocspChecker {
+	return &ocspChecker{
+		mode:   mode,
+		client: client,
+		now:    time.Now,
+		cache:  make(map[string]*ocspEntry),
+	}
+}
+
+// check fails if the certificate is revoked or, in required mode, if its status cannot be established
+func (c *ocspChecker) check(ctx context.Context, cert, issuer *x509.Certificate, stapled []byte) error {
+	status, err := c.status(ctx, cert, issuer, stapled)
+	switch {
+	case err != nil && c.mode == OCSPRequired:
+		return fmt.Errorf("failed to check certificate status: %w", err)
+	case err != nil:
+		return nil
+	case status == ocsp.Revoked:
+		return fmt.Errorf("certificate has been revoked")
+	case status != ocsp.Good && c.mode == OCSPRequired:
+		return fmt.Errorf("unknown certificate status")
+	default:
+		return nil
+	}
+}
+
+func (c *ocspChecker) status(ctx context.Context, cert, issuer *x509.Certificate, stapled []byte) (int, error) {
+	key := string(issuer.RawSubjectPublicKeyInfo) + cert.SerialNumber.String()
+
+	c.mux.RLock()
+	entry, ok := c.cache[key]
+	c.mux.RUnlock()
+	if ok && c.now().Before(entry.expiresAt) {
+		return entry.status, nil
+	}
+
+	var resp *ocsp.Response
+	var err error
+	if len(stapled) > 0 {
+		resp, err = ocsp.ParseResponseForCert(stapled, cert, issuer)
+	} else {
+		resp, err = c.query(ctx, cert, issuer)
+	}
+	if err != nil {
+		return ocsp.Unknown, err
+	}
+
+	if resp.NextUpdate.IsZero() {
+		entry = &ocspEntry{status: resp.Status, expiresAt: c.now().Add(ocspDefaultCacheTTL)}
+	} else if c.now().After(resp.NextUpdate) {
+		return ocsp.Unknown, fmt.Errorf("OCSP response has expired")
+	} else {
+		entry = &ocspEntry{status: resp.Status, expiresAt: resp.NextUpdate}
+	}
+
+	c.mux.Lock()
+	c.cache[key] = entry
+	c.mux.Unlock()
+
+	return entry.status, nil
+}
+
+func (c *ocspChecker) query(ctx context.Context, cert, issuer *x509.Certificate) (*ocsp.Response, error) {
+	if len(cert.OCSPServer) == 0 {
+		return nil, fmt.Errorf("certificate has no OCSP responder")
+	}
+
+	body, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ocspRequestTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, cert.OCSPServer[0], bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", ocspRequestContentType)
+
+	httpResp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("call to OCSP responder failed: %w", err)
+	}
+	defer httpResp.Body.Close()
+
+	if httpResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("OCSP responder returned status %d", httpResp.StatusCode)
+	}
+
+	respBody, err := ioutil.ReadAll(httpResp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	return ocsp.ParseResponseForCert(respBody, cert, issuer)
+}
@@ -0,0 +1,304 @@
+package tls
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  crypto.Signer
+}
+
+func newTestCA(t *testing.T) *testCA {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "test-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, key.Public(), key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return &testCA{cert: cert, key: key}
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64, tmpl *x509.Certificate) *x509.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	tmpl.SerialNumber = big.NewInt(serial)
+	tmpl.NotBefore = time.Now().Add(-time.Hour)
+	tmpl.NotAfter = time.Now().Add(time.Hour)
+	tmpl.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, key.Public(), ca.key)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func (ca *testCA) pool() *x509.CertPool {
+	pool := x509.NewCertPool()
+	pool.AddCert(ca.cert)
+	return pool
+}
+
+func (ca *testCA) writeCRL(t *testing.T, path string, revoked ...*x509.Certificate) {
+	var revokedCerts []pkix.RevokedCertificate
+	for _, cert := range revoked {
+		revokedCerts = append(revokedCerts, pkix.RevokedCertificate{SerialNumber: cert.SerialNumber, RevocationTime: time.Now()})
+	}
+
+	der, err := x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:              big.NewInt(time.Now().UnixNano()),
+		ThisUpdate:          time.Now().Add(-time.Minute),
+		NextUpdate:          time.Now().Add(time.Hour),
+		RevokedCertificates: revokedCerts,
+	}, ca.cert, ca.key)
+	require.NoError(t, err)
+
+	err = ioutil.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: crlPEMType, Bytes: der}), 0600)
+	require.NoError(t, err)
+}
+
+func newTLSRequest(cert *x509.Certificate) *http.Request {
+	req := httptest.NewRequest("GET", "https://test.url", nil)
+	req.TLS = &tls.ConnectionState{
+		PeerCertificates:  []*x509.Certificate{cert},
+		HandshakeComplete: true,
+	}
+
+	return req
+}
+
+func TestAuthenticator_CRL(t *testing.T) {
+	ca := newTestCA(t)
+	alice := ca.issue(t, 2, &x509.Certificate{Subject: pkix.Name{CommonName: "alice"}})
+	bob := ca.issue(t, 3, &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}})
+
+	crlFile := filepath.Join(t.TempDir(), "ca.crl")
+	ca.writeCRL(t, crlFile, bob)
+
+	auth, err := NewAuthenticator(&Config{CAs: ca.pool(), CRLFile: crlFile})
+	require.NoError(t, err)
+
+	t.Run("should accept certificate not revoked", func(t *testing.T) {
+		userInfo, err := auth.Authenticate(newTLSRequest(alice))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", userInfo.Username)
+	})
+
+	t.Run("should reject revoked certificate", func(t *testing.T) {
+		_, err := auth.Authenticate(newTLSRequest(bob))
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should reload CRL file when modified", func(t *testing.T) {
+		ca.writeCRL(t, crlFile, alice)
+		later := time.Now().Add(time.Minute)
+		require.NoError(t, os.Chtimes(crlFile, later, later))
+		auth.crl.now = func() time.Time { return later }
+
+		_, err := auth.Authenticate(newTLSRequest(alice))
+		assert.True(t, errors.IsUnauthorizedError(err))
+
+		userInfo, err := auth.Authenticate(newTLSRequest(bob))
+		require.NoError(t, err)
+		assert.Equal(t, "bob", userInfo.Username)
+	})
+
+	t.Run("should fail to create authenticator if CRL file is invalid", func(t *testing.T) {
+		invalidFile := filepath.Join(t.TempDir(), "invalid.crl")
+		require.NoError(t, ioutil.WriteFile(invalidFile, []byte("invalid"), 0600))
+
+		_, err := NewAuthenticator(&Config{CAs: ca.pool(), CRLFile: invalidFile})
+		assert.Error(t, err)
+	})
+}
+
+func TestAuthenticator_OCSP(t *testing.T) {
+	ca := newTestCA(t)
+
+	var revokedSerial int64 = 3
+	responder := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		body, _ := ioutil.ReadAll(req.Body)
+		ocspReq, err := ocsp.ParseRequest(body)
+		if err != nil {
+			rw.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		status := ocsp.Good
+		if ocspReq.SerialNumber.Int64() == revokedSerial {
+			status = ocsp.Revoked
+		}
+
+		resp, _ := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: ocspReq.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+			RevokedAt:    time.Now().Add(-time.Minute),
+		}, ca.key)
+		_, _ = rw.Write(resp)
+	}))
+	defer responder.Close()
+
+	alice := ca.issue(t, 2, &x509.Certificate{Subject: pkix.Name{CommonName: "alice"}, OCSPServer: []string{responder.URL}})
+	bob := ca.issue(t, revokedSerial, &x509.Certificate{Subject: pkix.Name{CommonName: "bob"}, OCSPServer: []string{responder.URL}})
+	eve := ca.issue(t, 4, &x509.Certificate{Subject: pkix.Name{CommonName: "eve"}, OCSPServer: []string{"http://127.0.0.1:1"}})
+
+	t.Run("should accept good certificate", func(t *testing.T) {
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: OCSPRequired})
+		require.NoError(t, err)
+
+		userInfo, err := auth.Authenticate(newTLSRequest(alice))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", userInfo.Username)
+	})
+
+	t.Run("should reject revoked certificate", func(t *testing.T) {
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: OCSPOptional})
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(newTLSRequest(bob))
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should use stapled OCSP response", func(t *testing.T) {
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: OCSPRequired})
+		require.NoError(t, err)
+
+		stapled, err := ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       ocsp.Good,
+			SerialNumber: eve.SerialNumber,
+			ThisUpdate:   time.Now().Add(-time.Minute),
+			NextUpdate:   time.Now().Add(time.Hour),
+		}, ca.key)
+		require.NoError(t, err)
+
+		req := newTLSRequest(eve)
+		req.TLS.OCSPResponse = stapled
+		userInfo, err := auth.Authenticate(req)
+		require.NoError(t, err)
+		assert.Equal(t, "eve", userInfo.Username)
+	})
+
+	t.Run("should accept certificate if responder is unavailable in optional mode", func(t *testing.T) {
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: OCSPOptional})
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(newTLSRequest(eve))
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject certificate if responder is unavailable in required mode", func(t *testing.T) {
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: OCSPRequired})
+		require.NoError(t, err)
+
+		_, err = auth.Authenticate(newTLSRequest(eve))
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should fail to create authenticator with invalid OCSP mode", func(t *testing.T) {
+		_, err := NewAuthenticator(&Config{CAs: ca.pool(), OCSP: "invalid"})
+		assert.Error(t, err)
+	})
+}
+
+func TestAuthenticator_Mapping(t *testing.T) {
+	ca := newTestCA(t)
+	spiffeID, _ := url.Parse("spiffe://example.org/ns/tenantOne/sa/alice")
+	oid := []int{1, 3, 6, 1, 4, 1, 99999, 1}
+	cert := ca.issue(t, 2, &x509.Certificate{
+		Subject: pkix.Name{
+			CommonName:         "meaningless",
+			OrganizationalUnit: []string{"read:keys"},
+			ExtraNames:         []pkix.AttributeTypeAndValue{{Type: oid, Value: "signer"}},
+		},
+		URIs:     []*url.URL{spiffeID},
+		DNSNames: []string{"alice.tenantOne.svc.cluster.local"},
+	})
+
+	t.Run("should map SPIFFE ID, custom OID and OU successfully", func(t *testing.T) {
+		username, _ := ParseField(`uri-san=^spiffe://example.org/ns/[^/]+/sa/([^/]+)$`)
+		tenant, _ := ParseField(`uri-san=^spiffe://example.org/ns/([^/]+)/`)
+		roles, _ := ParseField("oid:1.3.6.1.4.1.99999.1")
+		permissions, _ := ParseField("ou")
+
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), Mapping: &Mapping{
+			Username:    username,
+			Tenant:      tenant,
+			Roles:       roles,
+			Permissions: permissions,
+		}})
+		require.NoError(t, err)
+
+		userInfo, err := auth.Authenticate(newTLSRequest(cert))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", userInfo.Username)
+		assert.Equal(t, "tenantOne", userInfo.Tenant)
+		assert.Equal(t, []string{"signer"}, userInfo.Roles)
+		assert.Equal(t, []types.Permission{types.ReadKey}, userInfo.Permissions)
+	})
+
+	t.Run("should map DNS SAN successfully", func(t *testing.T) {
+		username, _ := ParseField(`dns-san=^([^.]+)\.`)
+
+		auth, err := NewAuthenticator(&Config{CAs: ca.pool(), Mapping: &Mapping{Username: username}})
+		require.NoError(t, err)
+
+		userInfo, err := auth.Authenticate(newTLSRequest(cert))
+		require.NoError(t, err)
+		assert.Equal(t, "alice", userInfo.Username)
+		assert.Empty(t, userInfo.Tenant)
+		assert.Nil(t, userInfo.Roles)
+	})
+
+	t.Run("should fail to parse unknown field", func(t *testing.T) {
+		_, err := ParseField("unknown")
+		assert.Error(t, err)
+
+		_, err = ParseField("oid:invalid")
+		assert.Error(t, err)
+
+		_, err = ParseField("cn=(")
+		assert.Error(t, err)
+	})
+
+	t.Run("should fail to create authenticator without username field", func(t *testing.T) {
+		_, err := NewAuthenticator(&Config{CAs: ca.pool(), Mapping: &Mapping{}})
+		assert.Error(t, err)
+	})
+}
@@ -8,4 +8,13 @@ import "github.com/consensys/quorum-key-manager/src/auth/types"
 type Authorizator interface {
 	CheckPermission(ops ...*types.Operation) error
 	CheckAccess(allowedTenants []string) error
+
+	// CheckOwnership checks that the user can access an item created by the given tenant. Shared items and items without tenant are accessible to all tenants
+	CheckOwnership(tenant string, shared bool) error
+
+	// Tenant returns the tenant of the user, to be recorded on the items it creates
+	Tenant() string
+
+	// AccessibleTenants returns the tenants whose items the user can access, nil if the user can access the items of all tenants
+	AccessibleTenants() []string
 }
@@ -63,6 +63,33 @@ func (auth *Authorizator) CheckAccess(allowedTenants []string) error {
 	return errors.NotFoundError(errMessage)
 }
 
+func (auth *Authorizator) CheckOwnership(tenant string, shared bool) error {
+	if tenant == "" || shared || tenant == auth.tenant || auth.isAdmin() {
+		return nil
+	}
+
+	errMessage := "resource not found"
+	auth.logger.With("tenant", auth.tenant, "owner", tenant).Error(errMessage)
+	return errors.NotFoundError(errMessage)
+}
+
+func (auth *Authorizator) Tenant() string {
+	return auth.tenant
+}
+
+func (auth *Authorizator) AccessibleTenants() []string {
+	if auth.isAdmin() {
+		return nil
+	}
+
+	return []string{auth.tenant}
+}
+
+func (auth *Authorizator) isAdmin() bool {
+	_, ok := auth.permissions[types.AdminTenant]
+	return ok
+}
+
 func buildPermission(action types.OpAction, resource types.OpResource) types.Permission {
 	return types.Permission(fmt.Sprintf("%s:%s", action, resource))
 }
@@ -0,0 +1,41 @@
+package authorizator
+
+import (
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckOwnership(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+
+	t.Run("should allow access to resources of the same tenant, shared or without tenant", func(t *testing.T) {
+		auth := New(types.ListWildcardPermission("*:*"), "tenantOne", logger)
+
+		assert.NoError(t, auth.CheckOwnership("tenantOne", false))
+		assert.NoError(t, auth.CheckOwnership("tenantTwo", true))
+		assert.NoError(t, auth.CheckOwnership("", false))
+	})
+
+	t.Run("should not allow cross-tenant access with wildcard permissions only", func(t *testing.T) {
+		auth := New(types.ListWildcardPermission("*:*"), "tenantOne", logger)
+
+		err := auth.CheckOwnership("tenantTwo", false)
+
+		assert.Error(t, err)
+		assert.Equal(t, []string{"tenantOne"}, auth.AccessibleTenants())
+	})
+
+	t.Run("should allow cross-tenant access with an explicit admin permission", func(t *testing.T) {
+		auth := New([]types.Permission{types.AdminTenant}, "tenantOne", logger)
+
+		assert.NoError(t, auth.CheckOwnership("tenantTwo", false))
+		assert.Nil(t, auth.AccessibleTenants())
+	})
+}
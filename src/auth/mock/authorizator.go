@@ -5,35 +5,78 @@
 package mock
 
 import (
+	reflect "reflect"
+
 	types "github.com/consensys/quorum-key-manager/src/auth/types"
 	gomock "github.com/golang/mock/gomock"
-	reflect "reflect"
 )
 
-// MockAuthorizator is a mock of Authorizator interface
+// MockAuthorizator is a mock of Authorizator interface.
 type MockAuthorizator struct {
 	ctrl     *gomock.Controller
 	recorder *MockAuthorizatorMockRecorder
 }
 
-// MockAuthorizatorMockRecorder is the mock recorder for MockAuthorizator
+// MockAuthorizatorMockRecorder is the mock recorder for MockAuthorizator.
 type MockAuthorizatorMockRecorder struct {
 	mock *MockAuthorizator
 }
 
-// NewMockAuthorizator creates a new mock instance
+// NewMockAuthorizator creates a new mock instance.
 func NewMockAuthorizator(ctrl *gomock.Controller) *MockAuthorizator {
 	mock := &MockAuthorizator{ctrl: ctrl}
 	mock.recorder = &MockAuthorizatorMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockAuthorizator) EXPECT() *MockAuthorizatorMockRecorder {
 	return m.recorder
 }
 
-// CheckPermission mocks base method
+// AccessibleTenants mocks base method.
+func (m *MockAuthorizator) AccessibleTenants() []string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AccessibleTenants")
+	ret0, _ := ret[0].([]string)
+	return ret0
+}
+
+// AccessibleTenants indicates an expected call of AccessibleTenants.
+func (mr *MockAuthorizatorMockRecorder) AccessibleTenants() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AccessibleTenants", reflect.TypeOf((*MockAuthorizator)(nil).AccessibleTenants))
+}
+
+// CheckAccess mocks base method.
+func (m *MockAuthorizator) CheckAccess(allowedTenants []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckAccess", allowedTenants)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckAccess indicates an expected call of CheckAccess.
+func (mr *MockAuthorizatorMockRecorder) CheckAccess(allowedTenants interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockAuthorizator)(nil).CheckAccess), allowedTenants)
+}
+
+// CheckOwnership mocks base method.
+func (m *MockAuthorizator) CheckOwnership(tenant string, shared bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckOwnership", tenant, shared)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckOwnership indicates an expected call of CheckOwnership.
+func (mr *MockAuthorizatorMockRecorder) CheckOwnership(tenant, shared interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckOwnership", reflect.TypeOf((*MockAuthorizator)(nil).CheckOwnership), tenant, shared)
+}
+
+// CheckPermission mocks base method.
 func (m *MockAuthorizator) CheckPermission(ops ...*types.Operation) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{}
@@ -45,22 +88,22 @@ func (m *MockAuthorizator) CheckPermission(ops ...*types.Operation) error {
 	return ret0
 }
 
-// CheckPermission indicates an expected call of CheckPermission
+// CheckPermission indicates an expected call of CheckPermission.
 func (mr *MockAuthorizatorMockRecorder) CheckPermission(ops ...interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckPermission", reflect.TypeOf((*MockAuthorizator)(nil).CheckPermission), ops...)
 }
 
-// CheckAccess mocks base method
-func (m *MockAuthorizator) CheckAccess(allowedTenants []string) error {
+// Tenant mocks base method.
+func (m *MockAuthorizator) Tenant() string {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CheckAccess", allowedTenants)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Tenant")
+	ret0, _ := ret[0].(string)
 	return ret0
 }
 
-// CheckAccess indicates an expected call of CheckAccess
-func (mr *MockAuthorizatorMockRecorder) CheckAccess(allowedTenants interface{}) *gomock.Call {
+// Tenant indicates an expected call of Tenant.
+func (mr *MockAuthorizatorMockRecorder) Tenant() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckAccess", reflect.TypeOf((*MockAuthorizator)(nil).CheckAccess), allowedTenants)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Tenant", reflect.TypeOf((*MockAuthorizator)(nil).Tenant))
 }
@@ -33,7 +33,8 @@ func RegisterService(a *app.App, logger log.Logger) error {
 	return nil
 }
 
-func Middleware(a *app.App, logger log.Logger) (func(http.Handler) http.Handler, error) {
+// Middleware creates the authentication middleware. Tokens issued by the key manager are verified with the issuer key if not nil
+func Middleware(a *app.App, issuer oidc.PublicKeyResolver, logger log.Logger) (func(http.Handler) http.Handler, error) {
 	// Load configuration
 	cfg := new(Config)
 	err := a.ServiceConfig(cfg)
@@ -50,7 +51,9 @@ func Middleware(a *app.App, logger log.Logger) (func(http.Handler) http.Handler,
 
 	var auths []authenticator.Authenticator
 	if cfg.OIDC != nil {
-		oidcAuth, err := oidc.NewAuthenticator(cfg.OIDC)
+		oidcCfg := *cfg.OIDC
+		oidcCfg.Issuer = issuer
+		oidcAuth, err := oidc.NewAuthenticator(&oidcCfg)
 		if err != nil {
 			return nil, err
 		} else if oidcAuth != nil {
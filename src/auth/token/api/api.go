@@ -0,0 +1,21 @@
+package api
+
+import (
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/consensys/quorum-key-manager/src/auth/token/api/handlers"
+	"github.com/gorilla/mux"
+)
+
+type TokenAPI struct {
+	issuer token.Issuer
+}
+
+func New(issuer token.Issuer) *TokenAPI {
+	return &TokenAPI{
+		issuer: issuer,
+	}
+}
+
+func (api *TokenAPI) Register(r *mux.Router) {
+	handlers.NewTokenHandler(api.issuer).Register(r)
+}
@@ -0,0 +1,93 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/consensys/quorum-key-manager/src/auth/token/api/types"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/gorilla/mux"
+)
+
+const bearerTokenType = "Bearer"
+
+type TokenHandler struct {
+	issuer token.Issuer
+}
+
+// NewTokenHandler creates a http.Handler to be served on /auth
+func NewTokenHandler(issuer token.Issuer) *TokenHandler {
+	return &TokenHandler{
+		issuer: issuer,
+	}
+}
+
+func (h *TokenHandler) Register(r *mux.Router) {
+	r.Methods(http.MethodPost).Path("/auth/token").HandlerFunc(h.create)
+}
+
+// @Summary Issue a short-lived token
+// @Description Exchange the credentials of the caller for a short-lived JWT restricted to a subset of its permissions, stores and Ethereum accounts
+// @Tags Auth
+// @Accept json
+// @Produce json
+// @Param request body types.CreateTokenRequest true "Create token request"
+// @Success 200 {object} types.TokenResponse "Issued token"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /auth/token [post]
+func (h *TokenHandler) create(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	createTokenRequest := &types.CreateTokenRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, createTokenRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	scope := &token.Scope{
+		Stores:    createTokenRequest.Stores,
+		Addresses: createTokenRequest.Addresses,
+	}
+	for _, p := range createTokenRequest.Permissions {
+		scope.Permissions = append(scope.Permissions, authtypes.Permission(p))
+	}
+	if createTokenRequest.TTL != nil {
+		scope.TTL = createTokenRequest.TTL.Duration
+	}
+
+	tkn, err := h.issuer.Issue(ctx, authenticator.UserInfoContextFromContext(ctx), scope)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	// Tokens must not be cached by intermediaries
+	rw.Header().Set("Cache-Control", "no-store")
+	_ = json.NewEncoder(rw).Encode(formatTokenResponse(tkn))
+}
+
+func formatTokenResponse(tkn *token.Token) *types.TokenResponse {
+	resp := &types.TokenResponse{
+		AccessToken: tkn.Value,
+		TokenType:   bearerTokenType,
+		ExpiresAt:   tkn.ExpiresAt,
+		Permissions: []string{},
+		Stores:      tkn.Stores,
+		Addresses:   tkn.Addresses,
+	}
+	for _, p := range tkn.Permissions {
+		resp.Permissions = append(resp.Permissions, string(p))
+	}
+
+	return resp
+}
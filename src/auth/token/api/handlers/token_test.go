@@ -0,0 +1,94 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/consensys/quorum-key-manager/src/auth/token/api/types"
+	"github.com/consensys/quorum-key-manager/src/auth/token/mock"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var tokenUserInfo = &authtypes.UserInfo{
+	AuthMode:    "ApiKey",
+	Username:    "username",
+	Tenant:      "tenant",
+	Permissions: []authtypes.Permission{"read:ethereum", "sign:ethereum"},
+}
+
+func TestTokenHandler(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	issuer := mock.NewMockIssuer(ctrl)
+	router := mux.NewRouter()
+	NewTokenHandler(issuer).Register(router)
+
+	ctx := authenticator.WithUserContext(context.Background(), authenticator.NewUserContext(tokenUserInfo))
+
+	t.Run("should execute request successfully", func(t *testing.T) {
+		requestBytes := []byte(`{"permissions":["sign:ethereum"],"stores":["eth-accounts"],"addresses":["0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"],"ttl":"10m"}`)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/auth/token", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		expiresAt := time.Now().Add(10 * time.Minute).UTC()
+		issuer.EXPECT().Issue(gomock.Any(), tokenUserInfo, &token.Scope{
+			Permissions: []authtypes.Permission{"sign:ethereum"},
+			Stores:      []string{"eth-accounts"},
+			Addresses:   []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+			TTL:         10 * time.Minute,
+		}).Return(&token.Token{
+			Value:       "my-token",
+			ExpiresAt:   expiresAt,
+			Permissions: []authtypes.Permission{"sign:ethereum"},
+			Stores:      []string{"eth-accounts"},
+			Addresses:   []string{"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"},
+		}, nil)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		require.Equal(t, http.StatusOK, rw.Code)
+		assert.Equal(t, "no-store", rw.Header().Get("Cache-Control"))
+		resp := &types.TokenResponse{}
+		require.NoError(t, json.Unmarshal(rw.Body.Bytes(), resp))
+		assert.Equal(t, "my-token", resp.AccessToken)
+		assert.Equal(t, "Bearer", resp.TokenType)
+		assert.True(t, expiresAt.Equal(resp.ExpiresAt))
+		assert.Equal(t, []string{"sign:ethereum"}, resp.Permissions)
+		assert.Equal(t, []string{"eth-accounts"}, resp.Stores)
+	})
+
+	t.Run("should fail with 400 if address is invalid", func(t *testing.T) {
+		requestBytes := []byte(`{"addresses":["invalid-address"]}`)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/auth/token", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusBadRequest, rw.Code)
+	})
+
+	t.Run("should fail with 403 if permissions are not granted", func(t *testing.T) {
+		requestBytes := []byte(`{"permissions":["write:ethereum"]}`)
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/auth/token", bytes.NewReader(requestBytes)).WithContext(ctx)
+
+		issuer.EXPECT().Issue(gomock.Any(), tokenUserInfo, gomock.Any()).Return(nil, errors.ForbiddenError("error"))
+
+		router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(t, http.StatusForbidden, rw.Code)
+	})
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/json"
+)
+
+type CreateTokenRequest struct {
+	Permissions []string       `json:"permissions,omitempty" example:"read:ethereum,sign:ethereum"`
+	Stores      []string       `json:"stores,omitempty" example:"eth-accounts"`
+	Addresses   []string       `json:"addresses,omitempty" validate:"omitempty,dive,isHexAddress" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+	TTL         *json.Duration `json:"ttl,omitempty" swaggertype:"string" example:"5m"`
+}
+
+type TokenResponse struct {
+	AccessToken string    `json:"accessToken" example:"eyJhbGciOiJFUzI1NksiLCJraWQiOiJ0b2tlbi1rZXkiLCJ0eXAiOiJKV1QifQ..."`
+	TokenType   string    `json:"tokenType" example:"Bearer"`
+	ExpiresAt   time.Time `json:"expiresAt" example:"2020-07-09T12:35:42.115395Z"`
+	Permissions []string  `json:"permissions" example:"read:ethereum,sign:ethereum"`
+	Stores      []string  `json:"stores,omitempty" example:"eth-accounts"`
+	Addresses   []string  `json:"addresses,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18"`
+}
@@ -0,0 +1,55 @@
+package app
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/app"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	tokenapi "github.com/consensys/quorum-key-manager/src/auth/token/api"
+	"github.com/consensys/quorum-key-manager/src/auth/token/issuer"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+)
+
+// RegisterService creates the token issuer and registers its API. It returns nil if no signing key is configured
+func RegisterService(a *app.App, logger log.Logger) (token.Issuer, error) {
+	cfg := new(token.Config)
+	err := a.ServiceConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cfg.Enabled() {
+		return nil, nil
+	}
+
+	authCfg := new(auth.Config)
+	err = a.ServiceConfig(authCfg)
+	if err != nil {
+		return nil, err
+	}
+	if authCfg.OIDC == nil || authCfg.OIDC.Claims == nil {
+		return nil, errors.ConfigError("token issuance requires the OIDC claims configuration")
+	}
+
+	// Load stores service
+	storesManager := new(stores.Manager)
+	err = a.Service(storesManager)
+	if err != nil {
+		return nil, err
+	}
+
+	// Load auth manager service
+	authManager := new(auth.Manager)
+	err = a.Service(authManager)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenIssuer := issuer.New(cfg, authCfg.OIDC.Claims, *storesManager, *authManager, logger)
+
+	// Create and register token API
+	tokenapi.New(tokenIssuer).Register(a.Router())
+
+	return tokenIssuer, nil
+}
@@ -0,0 +1,46 @@
+package token
+
+import "time"
+
+const (
+	DefaultTTL    = 5 * time.Minute
+	DefaultMaxTTL = time.Hour
+)
+
+// Config locates the key used to sign the tokens issued by the key manager. Issuance is disabled if no key is set
+type Config struct {
+	// StoreName is the name of the key store holding the signing key
+	StoreName string
+
+	// KeyID is the identifier of the signing key, it must be an ECDSA key on the secp256k1 curve
+	KeyID string
+
+	// DefaultTTL is the lifetime of the tokens when none is requested
+	DefaultTTL time.Duration
+
+	// MaxTTL is the maximum lifetime that can be requested
+	MaxTTL time.Duration
+}
+
+// Enabled indicates whether a signing key is configured
+func (cfg *Config) Enabled() bool {
+	return cfg != nil && cfg.StoreName != "" && cfg.KeyID != ""
+}
+
+// GetDefaultTTL returns the configured default lifetime or DefaultTTL
+func (cfg *Config) GetDefaultTTL() time.Duration {
+	if cfg.DefaultTTL <= 0 {
+		return DefaultTTL
+	}
+
+	return cfg.DefaultTTL
+}
+
+// GetMaxTTL returns the configured maximum lifetime or DefaultMaxTTL
+func (cfg *Config) GetMaxTTL() time.Duration {
+	if cfg.MaxTTL <= 0 {
+		return DefaultMaxTTL
+	}
+
+	return cfg.MaxTTL
+}
@@ -0,0 +1,264 @@
+package issuer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	qkmjwt "github.com/consensys/quorum-key-manager/pkg/jwt"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/oidc"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/utils"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt"
+)
+
+const issuerName = "quorum-key-manager"
+
+// Issuer issues tokens signed by a key of a key store. Tokens are validated by the OIDC authenticator
+type Issuer struct {
+	cfg         *token.Config
+	claimsCfg   *oidc.ClaimsConfig
+	stores      stores.Manager
+	authManager auth.Manager
+	logger      log.Logger
+
+	mux    sync.Mutex
+	pubKey *ecdsa.PublicKey
+	algo   *entities.Algorithm
+}
+
+var _ token.Issuer = &Issuer{}
+var _ oidc.PublicKeyResolver = &Issuer{}
+
+func New(cfg *token.Config, claimsCfg *oidc.ClaimsConfig, storesManager stores.Manager, authManager auth.Manager, logger log.Logger) *Issuer {
+	return &Issuer{
+		cfg:         cfg,
+		claimsCfg:   claimsCfg,
+		stores:      storesManager,
+		authManager: authManager,
+		logger:      logger,
+	}
+}
+
+func (i *Issuer) Issue(ctx context.Context, userInfo *types.UserInfo, scope *token.Scope) (*token.Token, error) {
+	if userInfo == nil || userInfo.AuthMode == "" {
+		errMessage := "tokens can only be issued to authenticated users"
+		i.logger.Error(errMessage)
+		return nil, errors.UnauthorizedError(errMessage)
+	}
+	logger := i.logger.With("username", userInfo.Username, "tenant", userInfo.Tenant)
+
+	ttl := scope.TTL
+	if ttl == 0 {
+		ttl = i.cfg.GetDefaultTTL()
+	}
+	if ttl < 0 || ttl > i.cfg.GetMaxTTL() {
+		errMessage := "invalid token lifetime"
+		logger.With("ttl", ttl, "max_ttl", i.cfg.GetMaxTTL()).Error(errMessage)
+		return nil, errors.InvalidParameterError("%s, it must be positive and lower than %s", errMessage, i.cfg.GetMaxTTL())
+	}
+
+	permissions, err := i.permissions(userInfo, scope.Permissions)
+	if err != nil {
+		logger.WithError(err).Error("failed to scope token permissions")
+		return nil, err
+	}
+
+	storeNames, err := restrict(userInfo.Stores, scope.Stores, func(s string) (string, bool) { return s, true })
+	if err != nil {
+		logger.WithError(err).Error("failed to scope token stores")
+		return nil, err
+	}
+
+	addresses, err := restrict(userInfo.Addresses, scope.Addresses, func(addr string) (string, bool) {
+		return common.HexToAddress(addr).Hex(), common.IsHexAddress(addr)
+	})
+	if err != nil {
+		logger.WithError(err).Error("failed to scope token addresses")
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	expiresAt := now.Add(ttl)
+	// Tokens must not outlive the credentials they are issued from, such as a token issued from another token
+	if !userInfo.ExpiresAt.IsZero() && expiresAt.After(userInfo.ExpiresAt) {
+		expiresAt = userInfo.ExpiresAt
+	}
+	claims := jwt.MapClaims{
+		"iss":               issuerName,
+		"iat":               now.Unix(),
+		"nbf":               now.Unix(),
+		"exp":               expiresAt.Unix(),
+		i.claimsCfg.Subject: subject(userInfo),
+		i.claimsCfg.Scope:   joinPermissions(permissions),
+	}
+	if len(storeNames) > 0 {
+		claims[oidc.StoresClaim] = strings.Join(storeNames, " ")
+	}
+	if len(addresses) > 0 {
+		claims[oidc.AddressesClaim] = strings.Join(addresses, " ")
+	}
+
+	value, err := i.sign(ctx, claims)
+	if err != nil {
+		logger.WithError(err).Error("failed to sign token")
+		return nil, err
+	}
+
+	logger.With("expires_at", expiresAt).Info("token issued successfully")
+	return &token.Token{
+		Value:       value,
+		ExpiresAt:   time.Unix(expiresAt.Unix(), 0).UTC(),
+		Permissions: permissions,
+		Stores:      storeNames,
+		Addresses:   addresses,
+	}, nil
+}
+
+func (i *Issuer) PublicKey(ctx context.Context) (*ecdsa.PublicKey, error) {
+	i.mux.Lock()
+	defer i.mux.Unlock()
+
+	if i.pubKey != nil {
+		return i.pubKey, nil
+	}
+
+	keyStore, err := i.keyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := keyStore.Get(ctx, i.cfg.KeyID)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.Algo == nil || key.Algo.Type != entities.Ecdsa || key.Algo.EllipticCurve != entities.Secp256k1 {
+		errMessage := "token signing key must be an ECDSA key on the secp256k1 curve"
+		i.logger.With("store_name", i.cfg.StoreName, "key_id", i.cfg.KeyID).Error(errMessage)
+		return nil, errors.ConfigError(errMessage)
+	}
+
+	pubKey, err := crypto.UnmarshalPubkey(key.PublicKey)
+	if err != nil {
+		errMessage := "failed to parse token signing public key"
+		i.logger.With("store_name", i.cfg.StoreName, "key_id", i.cfg.KeyID).WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	i.pubKey = pubKey
+	i.algo = key.Algo
+
+	return pubKey, nil
+}
+
+func (i *Issuer) sign(ctx context.Context, claims jwt.MapClaims) (string, error) {
+	// Resolving the public key ensures the signing key exists and uses a supported algorithm
+	_, err := i.PublicKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	keyStore, err := i.keyStore(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	jwtToken := jwt.NewWithClaims(qkmjwt.SigningMethodSecp256k1, claims)
+	jwtToken.Header["kid"] = i.cfg.KeyID
+
+	return qkmjwt.SignedStringES256K(jwtToken, func(digest []byte) ([]byte, error) {
+		return keyStore.Sign(ctx, i.cfg.KeyID, digest, i.algo)
+	})
+}
+
+func (i *Issuer) keyStore(ctx context.Context) (stores.KeyStore, error) {
+	return i.stores.Stores().GetKeyStore(ctx, i.cfg.StoreName, types.WildcardUser)
+}
+
+// permissions returns the requested permissions if they are all granted to the user, or all the user permissions if none is requested
+func (i *Issuer) permissions(userInfo *types.UserInfo, requested []types.Permission) ([]types.Permission, error) {
+	granted := map[types.Permission]bool{}
+	var all []types.Permission
+	for _, p := range i.authManager.UserPermissions(userInfo) {
+		if !granted[p] {
+			granted[p] = true
+			all = append(all, p)
+		}
+	}
+
+	if len(requested) == 0 {
+		return all, nil
+	}
+
+	var claims []string
+	for _, p := range requested {
+		claims = append(claims, string(p))
+	}
+
+	var permissions []types.Permission
+	for _, p := range utils.ExtractPermissions(claims) {
+		if !granted[p] {
+			return nil, errors.ForbiddenError("permission %q is not granted to the user", p)
+		}
+		permissions = append(permissions, p)
+	}
+
+	return permissions, nil
+}
+
+// restrict returns the requested values if they are all allowed, or the allowed values if none is requested. Nil allowed values mean no restriction.
+// Invalid allowed values are ignored and invalid requested values are rejected
+func restrict(allowed, requested []string, normalize func(string) (string, bool)) ([]string, error) {
+	if len(requested) == 0 {
+		return allowed, nil
+	}
+
+	allowedMap := map[string]bool{}
+	for _, v := range allowed {
+		if n, ok := normalize(v); ok {
+			allowedMap[n] = true
+		}
+	}
+
+	var values []string
+	for _, v := range requested {
+		n, ok := normalize(v)
+		if !ok {
+			return nil, errors.InvalidParameterError("invalid value %q", v)
+		}
+		if len(allowed) > 0 && !allowedMap[n] {
+			return nil, errors.ForbiddenError("%q is not accessible to the user", n)
+		}
+		values = append(values, n)
+	}
+
+	return values, nil
+}
+
+func subject(userInfo *types.UserInfo) string {
+	if userInfo.Tenant == "" {
+		return userInfo.Username
+	}
+
+	return userInfo.Tenant + "|" + userInfo.Username
+}
+
+func joinPermissions(permissions []types.Permission) string {
+	var values []string
+	for _, p := range permissions {
+		values = append(values, string(p))
+	}
+
+	return strings.Join(values, " ")
+}
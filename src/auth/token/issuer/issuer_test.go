@@ -0,0 +1,209 @@
+package issuer
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	qkmjwt "github.com/consensys/quorum-key-manager/pkg/jwt"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator/oidc"
+	authmock "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/token"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	tokenStoreName = "token-store"
+	tokenKeyID     = "token-key"
+)
+
+func TestIssuer(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	privKey, _ := crypto.GenerateKey()
+	key := testutils2.FakeKey()
+	key.ID = tokenKeyID
+	key.PublicKey = crypto.FromECDSAPub(&privKey.PublicKey)
+
+	storesManager := mock.NewMockManager(ctrl)
+	storesConnector := mock.NewMockStores(ctrl)
+	keyStore := mock.NewMockKeyConnector(ctrl)
+	authManager := authmock.NewMockManager(ctrl)
+
+	storesManager.EXPECT().Stores().Return(storesConnector).AnyTimes()
+	storesConnector.EXPECT().GetKeyStore(gomock.Any(), tokenStoreName, types.WildcardUser).Return(keyStore, nil).AnyTimes()
+	keyStore.EXPECT().Get(gomock.Any(), tokenKeyID).Return(key, nil).Times(1)
+	keyStore.EXPECT().Sign(gomock.Any(), tokenKeyID, gomock.Any(), key.Algo).DoAndReturn(
+		func(_ context.Context, _ string, digest []byte, _ *entities.Algorithm) ([]byte, error) {
+			sig, err := crypto.Sign(digest, privKey)
+			return sig[:64], err
+		}).AnyTimes()
+
+	claimsCfg := &oidc.ClaimsConfig{Subject: "sub", Scope: "scope", Roles: "qkm.roles"}
+	cfg := &token.Config{StoreName: tokenStoreName, KeyID: tokenKeyID, DefaultTTL: time.Minute, MaxTTL: time.Hour}
+	issuer := New(cfg, claimsCfg, storesManager, authManager, testutils.NewMockLogger(ctrl))
+
+	userInfo := &types.UserInfo{
+		AuthMode:    "ApiKey",
+		Tenant:      "tenantOne",
+		Username:    "alice",
+		Permissions: []types.Permission{types.ReadEth, types.SignEth, types.ReadKey},
+	}
+	authManager.EXPECT().UserPermissions(userInfo).Return(userInfo.Permissions).AnyTimes()
+
+	parse := func(t *testing.T, value string) jwt.MapClaims {
+		parsed, err := jwt.Parse(value, func(tkn *jwt.Token) (interface{}, error) {
+			assert.Equal(t, qkmjwt.ES256KAlg, tkn.Method.Alg())
+			assert.Equal(t, tokenKeyID, tkn.Header["kid"])
+			return &privKey.PublicKey, nil
+		})
+		require.NoError(t, err)
+		return parsed.Claims.(jwt.MapClaims)
+	}
+
+	t.Run("should issue a token with all the user permissions successfully", func(t *testing.T) {
+		tkn, err := issuer.Issue(ctx, userInfo, &token.Scope{})
+		require.NoError(t, err)
+
+		claims := parse(t, tkn.Value)
+		assert.Equal(t, "tenantOne|alice", claims["sub"])
+		assert.Equal(t, "read:ethereum sign:ethereum read:keys", claims["scope"])
+		assert.Nil(t, claims[oidc.StoresClaim])
+		assert.Nil(t, claims[oidc.AddressesClaim])
+		assert.Equal(t, userInfo.Permissions, tkn.Permissions)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), tkn.ExpiresAt, 2*time.Second)
+	})
+
+	t.Run("should issue a token restricted to the requested scope successfully", func(t *testing.T) {
+		tkn, err := issuer.Issue(ctx, userInfo, &token.Scope{
+			Permissions: []types.Permission{types.SignEth},
+			Stores:      []string{"eth-accounts"},
+			Addresses:   []string{"0x905b88eff8bda1543d4d6f4aa05afef143d27e18"},
+			TTL:         10 * time.Minute,
+		})
+		require.NoError(t, err)
+
+		claims := parse(t, tkn.Value)
+		assert.Equal(t, "sign:ethereum", claims["scope"])
+		assert.Equal(t, "eth-accounts", claims[oidc.StoresClaim])
+		assert.Equal(t, "0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18", claims[oidc.AddressesClaim])
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), tkn.ExpiresAt, 2*time.Second)
+	})
+
+	t.Run("should fail with ForbiddenError if a wildcard permission is not fully granted to the user", func(t *testing.T) {
+		_, err := issuer.Issue(ctx, userInfo, &token.Scope{Permissions: []types.Permission{"*:ethereum"}})
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if a permission is not granted to the user", func(t *testing.T) {
+		_, err := issuer.Issue(ctx, userInfo, &token.Scope{Permissions: []types.Permission{types.WriteEth}})
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if a store is out of the user scope", func(t *testing.T) {
+		scopedUser := *userInfo
+		scopedUser.Stores = []string{"eth-accounts"}
+		authManager.EXPECT().UserPermissions(&scopedUser).Return(userInfo.Permissions)
+
+		_, err := issuer.Issue(ctx, &scopedUser, &token.Scope{Stores: []string{"other-accounts"}})
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should not issue a token outliving the credentials of the user", func(t *testing.T) {
+		tokenUser := *userInfo
+		tokenUser.ExpiresAt = time.Now().Add(5 * time.Minute).UTC()
+		authManager.EXPECT().UserPermissions(&tokenUser).Return(userInfo.Permissions)
+
+		tkn, err := issuer.Issue(ctx, &tokenUser, &token.Scope{TTL: time.Hour})
+		require.NoError(t, err)
+
+		claims := parse(t, tkn.Value)
+		assert.Equal(t, float64(tokenUser.ExpiresAt.Unix()), claims["exp"])
+		assert.Equal(t, time.Unix(tokenUser.ExpiresAt.Unix(), 0).UTC(), tkn.ExpiresAt)
+	})
+
+	t.Run("should ignore malformed allowed addresses and reject malformed requested addresses", func(t *testing.T) {
+		scopedUser := *userInfo
+		scopedUser.Addresses = []string{"not-an-address"}
+		authManager.EXPECT().UserPermissions(&scopedUser).Return(userInfo.Permissions)
+
+		_, err := issuer.Issue(ctx, &scopedUser, &token.Scope{Addresses: []string{"0x0000000000000000000000000000000000000000"}})
+		assert.True(t, errors.IsForbiddenError(err))
+
+		_, err = issuer.Issue(ctx, userInfo, &token.Scope{Addresses: []string{"0x905b88eff8bda1543d4d6f4aa05afef143d27e1"}})
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if ttl exceeds the maximum", func(t *testing.T) {
+		_, err := issuer.Issue(ctx, userInfo, &token.Scope{TTL: 2 * time.Hour})
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with UnauthorizedError if user is not authenticated", func(t *testing.T) {
+		_, err := issuer.Issue(ctx, types.AnonymousUser, &token.Scope{})
+		assert.True(t, errors.IsUnauthorizedError(err))
+	})
+
+	t.Run("should return the public key of the signing key", func(t *testing.T) {
+		pubKey, err := issuer.PublicKey(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, privKey.PublicKey.X, pubKey.X)
+		assert.Equal(t, privKey.PublicKey.Y, pubKey.Y)
+	})
+}
+
+func TestIssuer_InvalidKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	storesManager := mock.NewMockManager(ctrl)
+	storesConnector := mock.NewMockStores(ctrl)
+	keyStore := mock.NewMockKeyConnector(ctrl)
+	authManager := authmock.NewMockManager(ctrl)
+
+	storesManager.EXPECT().Stores().Return(storesConnector).AnyTimes()
+	storesConnector.EXPECT().GetKeyStore(gomock.Any(), tokenStoreName, types.WildcardUser).Return(keyStore, nil).AnyTimes()
+
+	cfg := &token.Config{StoreName: tokenStoreName, KeyID: tokenKeyID}
+	issuer := New(cfg, &oidc.ClaimsConfig{Subject: "sub", Scope: "scope"}, storesManager, authManager, testutils.NewMockLogger(ctrl))
+
+	t.Run("should fail with ConfigError if key is not a secp256k1 ECDSA key", func(t *testing.T) {
+		key := testutils2.FakeKey()
+		key.Algo = &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Babyjubjub}
+		keyStore.EXPECT().Get(gomock.Any(), tokenKeyID).Return(key, nil)
+
+		_, err := issuer.PublicKey(ctx)
+		assert.Equal(t, errors.Config, errors.FromError(err).GetCode())
+	})
+
+	t.Run("should fail with same error if key cannot be retrieved", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		keyStore.EXPECT().Get(gomock.Any(), tokenKeyID).Return(nil, expectedErr)
+
+		_, err := issuer.PublicKey(ctx)
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should not sign tokens if key cannot be retrieved", func(t *testing.T) {
+		keyStore.EXPECT().Get(gomock.Any(), tokenKeyID).Return(nil, errors.NotFoundError("error"))
+		authManager.EXPECT().UserPermissions(gomock.Any()).Return(nil)
+
+		tkn, err := issuer.Issue(ctx, &types.UserInfo{AuthMode: "Tls", Username: "bob"}, &token.Scope{})
+		assert.Nil(t, tkn)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+}
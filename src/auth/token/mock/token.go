@@ -0,0 +1,68 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: token.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	ecdsa "crypto/ecdsa"
+	reflect "reflect"
+
+	token "github.com/consensys/quorum-key-manager/src/auth/token"
+	types "github.com/consensys/quorum-key-manager/src/auth/types"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockIssuer is a mock of Issuer interface.
+type MockIssuer struct {
+	ctrl     *gomock.Controller
+	recorder *MockIssuerMockRecorder
+}
+
+// MockIssuerMockRecorder is the mock recorder for MockIssuer.
+type MockIssuerMockRecorder struct {
+	mock *MockIssuer
+}
+
+// NewMockIssuer creates a new mock instance.
+func NewMockIssuer(ctrl *gomock.Controller) *MockIssuer {
+	mock := &MockIssuer{ctrl: ctrl}
+	mock.recorder = &MockIssuerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIssuer) EXPECT() *MockIssuerMockRecorder {
+	return m.recorder
+}
+
+// Issue mocks base method.
+func (m *MockIssuer) Issue(ctx context.Context, userInfo *types.UserInfo, scope *token.Scope) (*token.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Issue", ctx, userInfo, scope)
+	ret0, _ := ret[0].(*token.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Issue indicates an expected call of Issue.
+func (mr *MockIssuerMockRecorder) Issue(ctx, userInfo, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Issue", reflect.TypeOf((*MockIssuer)(nil).Issue), ctx, userInfo, scope)
+}
+
+// PublicKey mocks base method.
+func (m *MockIssuer) PublicKey(ctx context.Context) (*ecdsa.PublicKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicKey", ctx)
+	ret0, _ := ret[0].(*ecdsa.PublicKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublicKey indicates an expected call of PublicKey.
+func (mr *MockIssuerMockRecorder) PublicKey(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKey", reflect.TypeOf((*MockIssuer)(nil).PublicKey), ctx)
+}
@@ -0,0 +1,37 @@
+package token
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+)
+
+//go:generate mockgen -source=token.go -destination=mock/token.go -package=mock
+
+// Issuer issues short-lived tokens scoped down from the credentials of a user
+type Issuer interface {
+	// Issue issues a token restricted to the given scope
+	Issue(ctx context.Context, userInfo *types.UserInfo, scope *Scope) (*Token, error)
+
+	// PublicKey returns the public key verifying the issued tokens
+	PublicKey(ctx context.Context) (*ecdsa.PublicKey, error)
+}
+
+// Scope is the subset of the permissions, stores and Ethereum accounts of a user granted to a token
+type Scope struct {
+	Permissions []types.Permission
+	Stores      []string
+	Addresses   []string
+	TTL         time.Duration
+}
+
+// Token is a short-lived token issued by the key manager
+type Token struct {
+	Value       string
+	ExpiresAt   time.Time
+	Permissions []types.Permission
+	Stores      []string
+	Addresses   []string
+}
@@ -10,12 +10,14 @@ var ActionEncrypt OpAction = "encrypt"
 var ActionDelete OpAction = "delete"
 var ActionDestroy OpAction = "destroy"
 var ActionProxy OpAction = "proxy"
+var ActionExport OpAction = "export"
 
 var ResourceKey OpResource = "keys"
 var ResourceSecret OpResource = "secrets"
 var ResourceEthAccount OpResource = "ethereum"
 var ResourceStore OpResource = "stores"
 var ResourceNode OpResource = "nodes"
+var ResourceAlias OpResource = "aliases"
 
 type Operation struct {
 	Action   OpAction
@@ -18,6 +18,7 @@ const DeleteKey Permission = "delete:keys"
 const DestroyKey Permission = "destroy:keys"
 const SignKey Permission = "sign:keys"
 const EncryptKey Permission = "encrypt:keys"
+const ExportKey Permission = "export:keys"
 
 const ReadEth Permission = "read:ethereum"
 const WriteEth Permission = "write:ethereum"
@@ -25,9 +26,16 @@ const DeleteEth Permission = "delete:ethereum"
 const DestroyEth Permission = "destroy:ethereum"
 const SignEth Permission = "sign:ethereum"
 const EncryptEth Permission = "encrypt:ethereum"
+const ExportEth Permission = "export:ethereum"
 
 const ProxyNode Permission = "proxy:nodes"
 
+const ReadAlias Permission = "read:aliases"
+const WriteAlias Permission = "write:aliases"
+const DeleteAlias Permission = "delete:aliases"
+
+const AdminTenant Permission = "admin:tenants"
+
 func ListPermissions() []Permission {
 	return []Permission{
 		ReadSecret,
@@ -40,26 +48,42 @@ func ListPermissions() []Permission {
 		DestroyKey,
 		SignKey,
 		EncryptKey,
+		ExportKey,
 		ReadEth,
 		WriteEth,
 		DeleteEth,
 		DestroyEth,
 		SignEth,
 		EncryptEth,
+		ExportEth,
 		ProxyNode,
+		ReadAlias,
+		WriteAlias,
+		DeleteAlias,
+		AdminTenant,
 	}
 }
 
+// restrictedPermissions are never granted by a wildcard on the action, they need to be named explicitly (e.g. "export:keys" or "export:*")
+var restrictedPermissions = map[Permission]bool{
+	ExportKey:   true,
+	ExportEth:   true,
+	AdminTenant: true,
+}
+
 func ListWildcardPermission(p string) []Permission {
-	all := ListPermissions()
 	parts := strings.Split(p, ":")
 	action, resource := parts[0], parts[1]
-	if action == "*" && resource == "*" {
-		return all
-	}
 
 	var included []Permission
-	for _, ip := range all {
+	for _, ip := range ListPermissions() {
+		if action == "*" && restrictedPermissions[ip] {
+			continue
+		}
+		if action == "*" && resource == "*" {
+			included = append(included, ip)
+			continue
+		}
 		if action == "*" && strings.Contains(string(ip), fmt.Sprintf(":%s", resource)) {
 			included = append(included, ip)
 		}
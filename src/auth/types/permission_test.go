@@ -8,11 +8,29 @@ import (
 
 func TestListWildcardPermission(t *testing.T) {
 	list := ListWildcardPermission("*:*")
-	assert.Equal(t, list, ListPermissions())
+	assert.Len(t, list, len(ListPermissions())-3)
+	assert.NotContains(t, list, ExportKey)
+	assert.NotContains(t, list, ExportEth)
+	assert.NotContains(t, list, AdminTenant)
+
+	list = ListWildcardPermission("*:tenants")
+	assert.Empty(t, list)
+
+	list = ListWildcardPermission("admin:*")
+	assert.Equal(t, list, []Permission{AdminTenant})
 
 	list = ListWildcardPermission("read:*")
-	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth})
+	assert.Equal(t, list, []Permission{ReadSecret, ReadKey, ReadEth, ReadAlias})
+
+	list = ListWildcardPermission("*:aliases")
+	assert.Equal(t, list, []Permission{ReadAlias, WriteAlias, DeleteAlias})
 
 	list = ListWildcardPermission("*:ethereum")
 	assert.Equal(t, list, []Permission{ReadEth, WriteEth, DeleteEth, DestroyEth, SignEth, EncryptEth})
+
+	list = ListWildcardPermission("*:keys")
+	assert.NotContains(t, list, ExportKey)
+
+	list = ListWildcardPermission("export:*")
+	assert.Equal(t, list, []Permission{ExportKey, ExportEth})
 }
@@ -1,5 +1,7 @@
 package types
 
+import "time"
+
 // UserInfo are extracted from request credentials by authentication middleware
 type UserInfo struct {
 	// AuthMode records the mode that succeeded to Authenticate the request ('tls', 'api-key', 'oidc' or '')
@@ -16,6 +18,15 @@ type UserInfo struct {
 
 	// Permissions specify
 	Permissions []Permission
+
+	// Stores restricts the stores the user can access, nil if not restricted
+	Stores []string
+
+	// Addresses restricts the Ethereum accounts the user can access, nil if not restricted
+	Addresses []string
+
+	// ExpiresAt is the expiry of the credentials of the user, zero if they do not expire
+	ExpiresAt time.Time
 }
 
 var WildcardUser = &UserInfo{
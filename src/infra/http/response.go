@@ -3,6 +3,7 @@ package http
 import (
 	"encoding/json"
 	"fmt"
+	"math"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -26,6 +27,11 @@ func WriteHTTPErrorResponse(rw http.ResponseWriter, err error) {
 		writeErrorResponse(rw, http.StatusUnauthorized, err)
 	case errors.IsForbiddenError(err):
 		writeErrorResponse(rw, http.StatusForbidden, err)
+	case errors.IsTooManyRequestsError(err):
+		if retryAfter := errors.FromError(err).GetRetryAfter(); retryAfter > 0 {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		}
+		writeErrorResponse(rw, http.StatusTooManyRequests, err)
 	case errors.IsInvalidFormatError(err):
 		writeErrorResponse(rw, http.StatusBadRequest, err)
 	case errors.IsInvalidParameterError(err), errors.IsEncodingError(err):
@@ -0,0 +1,71 @@
+package validator
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	manifest "github.com/consensys/quorum-key-manager/src/manifests/entities"
+	nodesmanager "github.com/consensys/quorum-key-manager/src/nodes/manager"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	eth "github.com/consensys/quorum-key-manager/src/stores/manager/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/manager/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/manager/secrets"
+)
+
+// lister is implemented by all the secret and key stores, listing one item is enough to check the connection to the vault
+type lister interface {
+	List(ctx context.Context, limit, offset uint64) ([]string, error)
+}
+
+// checkConnectivity connects to the backend of valid specs. Local key stores and Ethereum stores are checked through their inner store
+func checkConnectivity(ctx context.Context, kind manifest.Kind, specs interface{}, logger log.Logger) error {
+	var store lister
+	var err error
+
+	switch kind {
+	case manifest.HashicorpSecrets:
+		spec := &secrets.HashicorpSecretSpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = secrets.NewHashicorpSecretStore(spec, nil, logger)
+	case manifest.AKVSecrets:
+		spec := &secrets.AkvSecretSpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = secrets.NewAkvSecretStore(spec, logger)
+	case manifest.AWSSecrets:
+		spec := &secrets.AwsSecretSpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = secrets.NewAwsSecretStore(spec, logger)
+	case manifest.HashicorpKeys:
+		spec := &keys.HashicorpKeySpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = keys.NewHashicorpKeyStore(spec, logger)
+	case manifest.AKVKeys:
+		spec := &keys.AkvKeySpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = keys.NewAkvKeyStore(spec, logger)
+	case manifest.AWSKeys:
+		spec := &keys.AwsKeySpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		store, err = keys.NewAwsKeyStore(spec, logger)
+	case manifest.LocalKeys:
+		spec := &keys.LocalKeySpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		return checkConnectivity(ctx, spec.SecretStore, spec.Specs, logger)
+	case manifest.Ethereum:
+		spec := &eth.LocalEthSpecs{}
+		_ = manifest.UnmarshalSpecs(specs, spec)
+		return checkConnectivity(ctx, spec.Keystore, spec.Specs, logger)
+	case nodesmanager.NodeKind:
+		cfg := &proxynode.Config{}
+		_ = manifest.UnmarshalSpecs(specs, cfg)
+		return proxynode.CheckConnectivity(ctx, cfg.SetDefault(), logger)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = store.List(ctx, 1, 0)
+	return err
+}
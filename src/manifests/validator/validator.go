@@ -0,0 +1,289 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	authmanager "github.com/consensys/quorum-key-manager/src/auth/manager"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	manifest "github.com/consensys/quorum-key-manager/src/manifests/entities"
+	nodesmanager "github.com/consensys/quorum-key-manager/src/nodes/manager"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	eth "github.com/consensys/quorum-key-manager/src/stores/manager/ethereum"
+	"github.com/consensys/quorum-key-manager/src/stores/manager/keys"
+	"github.com/consensys/quorum-key-manager/src/stores/manager/secrets"
+	"github.com/go-playground/validator/v10"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	secretStoreKinds = []manifest.Kind{manifest.HashicorpSecrets, manifest.AKVSecrets, manifest.AWSSecrets}
+	keyStoreKinds    = []manifest.Kind{manifest.HashicorpKeys, manifest.AKVKeys, manifest.AWSKeys, manifest.LocalKeys}
+)
+
+// Item is a manifest read from a file
+type Item struct {
+	File     string
+	Manifest *manifest.Manifest
+}
+
+// Issue is a problem found in a manifest file
+type Issue struct {
+	File string
+	Kind manifest.Kind
+	Name string
+	Err  error
+}
+
+func (i *Issue) Error() string {
+	if i.Name == "" {
+		return fmt.Sprintf("%s: %v", i.File, i.Err)
+	}
+
+	return fmt.Sprintf("%s: %s %q: %v", i.File, i.Kind, i.Name, i.Err)
+}
+
+func newIssue(item *Item, err error) *Issue {
+	return &Issue{File: item.File, Kind: item.Manifest.Kind, Name: item.Manifest.Name, Err: err}
+}
+
+// Load reads the manifests of the YAML file at path, or of all the YAML files of the folder at path
+func Load(path string) ([]*Item, []*Issue, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var items []*Item
+	var issues []*Issue
+	if !info.IsDir() {
+		items, issues = loadFile(path)
+		return items, issues, nil
+	}
+
+	err = filepath.Walk(path, func(fp string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() || (filepath.Ext(fp) != ".yml" && filepath.Ext(fp) != ".yaml") {
+			return nil
+		}
+
+		fileItems, fileIssues := loadFile(fp)
+		items = append(items, fileItems...)
+		issues = append(issues, fileIssues...)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return items, issues, nil
+}
+
+// loadFile reads a file holding either a single manifest or a list of manifests, unknown fields are rejected
+func loadFile(fp string) ([]*Item, []*Issue) {
+	data, err := ioutil.ReadFile(fp)
+	if err != nil {
+		return nil, []*Issue{{File: fp, Err: err}}
+	}
+
+	var content interface{}
+	if err = yaml.Unmarshal(data, &content); err != nil {
+		return nil, []*Issue{{File: fp, Err: err}}
+	}
+
+	var mnfs []*manifest.Manifest
+	if _, ok := content.([]interface{}); ok {
+		err = yaml.UnmarshalStrict(data, &mnfs)
+	} else {
+		mnf := &manifest.Manifest{}
+		err = yaml.UnmarshalStrict(data, mnf)
+		mnfs = append(mnfs, mnf)
+	}
+	if err != nil {
+		return nil, []*Issue{{File: fp, Err: err}}
+	}
+
+	val := validator.New()
+	var items []*Item
+	var issues []*Issue
+	for i, mnf := range mnfs {
+		if mnf == nil {
+			issues = append(issues, &Issue{File: fp, Err: fmt.Errorf("manifest %d is empty", i)})
+			continue
+		}
+
+		item := &Item{File: fp, Manifest: mnf}
+		if err := val.Struct(mnf); err != nil {
+			issues = append(issues, newIssue(item, err))
+			continue
+		}
+
+		items = append(items, item)
+	}
+
+	return items, issues
+}
+
+// Validate checks the specs of each manifest against the specs of its kind and detects the duplicate names
+func Validate(items []*Item) []*Issue {
+	var issues []*Issue
+
+	names := make(map[string]*Item)
+	for _, item := range items {
+		if err := validateSpecs(item.Manifest.Kind, item.Manifest.Specs); err != nil {
+			issues = append(issues, newIssue(item, err))
+		}
+
+		key := fmt.Sprintf("%s/%s", nameGroup(item.Manifest.Kind), item.Manifest.Name)
+		if prev, ok := names[key]; ok {
+			issues = append(issues, newIssue(item, fmt.Errorf("duplicate name, already defined by a %s manifest in %s", prev.Manifest.Kind, prev.File)))
+			continue
+		}
+		names[key] = item
+	}
+
+	return issues
+}
+
+// CheckConnectivity connects to the backend of each manifest, the manifests must be valid
+func CheckConnectivity(ctx context.Context, items []*Item, logger log.Logger) []*Issue {
+	var issues []*Issue
+	for _, item := range items {
+		if err := checkConnectivity(ctx, item.Manifest.Kind, item.Manifest.Specs, logger); err != nil {
+			issues = append(issues, newIssue(item, err))
+		}
+	}
+
+	return issues
+}
+
+// nameGroup returns the group of kinds whose manifests must have distinct names
+func nameGroup(kind manifest.Kind) string {
+	switch {
+	case hasKind(secretStoreKinds, kind):
+		return "secrets"
+	case hasKind(keyStoreKinds, kind):
+		return "keys"
+	default:
+		return string(kind)
+	}
+}
+
+func validateSpecs(kind manifest.Kind, specs interface{}) error {
+	switch kind {
+	case manifest.HashicorpSecrets:
+		return unmarshalSpecs(specs, &secrets.HashicorpSecretSpecs{})
+	case manifest.AKVSecrets:
+		return unmarshalSpecs(specs, &secrets.AkvSecretSpecs{})
+	case manifest.AWSSecrets:
+		return unmarshalSpecs(specs, &secrets.AwsSecretSpecs{})
+	case manifest.HashicorpKeys:
+		return unmarshalSpecs(specs, &keys.HashicorpKeySpecs{})
+	case manifest.AKVKeys:
+		return unmarshalSpecs(specs, &keys.AkvKeySpecs{})
+	case manifest.AWSKeys:
+		return unmarshalSpecs(specs, &keys.AwsKeySpecs{})
+	case manifest.LocalKeys:
+		spec := &keys.LocalKeySpecs{}
+		if err := unmarshalSpecs(specs, spec); err != nil {
+			return err
+		}
+
+		if !hasKind(secretStoreKinds, spec.SecretStore) {
+			return fmt.Errorf("invalid secret store kind %q, expected one of %s", spec.SecretStore, joinKinds(secretStoreKinds))
+		}
+
+		if err := validateSpecs(spec.SecretStore, spec.Specs); err != nil {
+			return fmt.Errorf("invalid %s specs: %v", spec.SecretStore, err)
+		}
+	case manifest.Ethereum:
+		spec := &eth.LocalEthSpecs{}
+		if err := unmarshalSpecs(specs, spec); err != nil {
+			return err
+		}
+
+		if !hasKind(keyStoreKinds, spec.Keystore) {
+			return fmt.Errorf("invalid keystore kind %q, expected one of %s", spec.Keystore, joinKinds(keyStoreKinds))
+		}
+
+		if err := validateSpecs(spec.Keystore, spec.Specs); err != nil {
+			return fmt.Errorf("invalid %s specs: %v", spec.Keystore, err)
+		}
+	case nodesmanager.NodeKind:
+		return unmarshalSpecs(specs, &proxynode.Config{})
+	case authmanager.RoleKind:
+		spec := &authmanager.RoleSpecs{}
+		if err := unmarshalSpecs(specs, spec); err != nil {
+			return err
+		}
+
+		for _, permission := range spec.Permissions {
+			if !isPermission(permission) {
+				return fmt.Errorf("invalid permission %q", permission)
+			}
+		}
+	default:
+		return fmt.Errorf("unknown kind")
+	}
+
+	return nil
+}
+
+// unmarshalSpecs decodes specs into dest, rejecting unknown fields and checking required fields
+func unmarshalSpecs(specs, dest interface{}) error {
+	bdata, err := json.Marshal(jsonutils.RecursiveToJSON(specs))
+	if err != nil {
+		return err
+	}
+
+	return jsonutils.UnmarshalBody(bytes.NewReader(bdata), dest)
+}
+
+func isPermission(permission types.Permission) bool {
+	parts := strings.Split(string(permission), ":")
+	if len(parts) != 2 {
+		return false
+	}
+
+	if parts[0] == "*" || parts[1] == "*" {
+		return len(types.ListWildcardPermission(string(permission))) > 0
+	}
+
+	for _, p := range types.ListPermissions() {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasKind(kinds []manifest.Kind, kind manifest.Kind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func joinKinds(kinds []manifest.Kind) string {
+	strs := make([]string, len(kinds))
+	for i, kind := range kinds {
+		strs[i] = string(kind)
+	}
+
+	return strings.Join(strs, ", ")
+}
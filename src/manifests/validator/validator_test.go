@@ -0,0 +1,241 @@
+package validator
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const validManifests = `
+- kind: HashicorpKeys
+  name: hashicorp-keys
+  specs:
+    mountPoint: quorum
+    address: http://hashicorp:8200
+- kind: LocalKeys
+  name: local-keys
+  specs:
+    secretStore: AWSSecrets
+    specs:
+      region: eu-west-3
+      accessID: my-id
+      secretKey: my-key
+- kind: Ethereum
+  name: eth-accounts
+  specs:
+    keystore: LocalKeys
+    specs:
+      secretStore: HashicorpSecrets
+      specs:
+        mountPoint: secret
+        address: http://hashicorp:8200
+- kind: Node
+  name: quorum-node
+  specs:
+    rpc:
+      addr: http://quorum1:8545
+- kind: Role
+  name: signer
+  specs:
+    permission:
+      - "read:*"
+      - "sign:ethereum"
+`
+
+func writeManifests(t *testing.T, dir, name, content string) string {
+	fp := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(fp, []byte(content), 0600))
+
+	return fp
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should load the manifests of all the YAML files of a folder", func(t *testing.T) {
+		dir := t.TempDir()
+		writeManifests(t, dir, "stores.yml", validManifests)
+		writeManifests(t, dir, "role.yaml", "kind: Role\nname: guest\nspecs:\n  permission: [\"read:*\"]\n")
+		writeManifests(t, dir, "README.md", "not a manifest")
+
+		items, issues, err := Load(dir)
+
+		require.NoError(t, err)
+		assert.Empty(t, issues)
+		assert.Len(t, items, 6)
+	})
+
+	t.Run("should report unknown fields and missing fields of manifests", func(t *testing.T) {
+		dir := t.TempDir()
+		fp := writeManifests(t, dir, "invalid.yml", `
+- kind: Role
+  name: guest
+  allowedTenant: tenant
+  specs:
+    permission: ["read:*"]
+- kind: Role
+  specs:
+    permission: ["read:*"]
+`)
+
+		items, issues, err := Load(fp)
+
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		require.Len(t, issues, 1)
+		assert.Equal(t, fp, issues[0].File)
+	})
+
+	t.Run("should report manifests without name", func(t *testing.T) {
+		dir := t.TempDir()
+		fp := writeManifests(t, dir, "invalid.yml", "kind: Role\nspecs:\n  permission: [\"read:*\"]\n")
+
+		items, issues, err := Load(fp)
+
+		require.NoError(t, err)
+		assert.Empty(t, items)
+		assert.Len(t, issues, 1)
+	})
+
+	t.Run("should fail if the path does not exist", func(t *testing.T) {
+		_, _, err := Load(filepath.Join(t.TempDir(), "missing"))
+
+		assert.Error(t, err)
+	})
+}
+
+func TestValidate(t *testing.T) {
+	validate := func(t *testing.T, content string) []*Issue {
+		fp := writeManifests(t, t.TempDir(), "manifests.yml", content)
+		items, issues, err := Load(fp)
+		require.NoError(t, err)
+		require.Empty(t, issues)
+
+		return Validate(items)
+	}
+
+	t.Run("should accept valid manifests", func(t *testing.T) {
+		assert.Empty(t, validate(t, validManifests))
+	})
+
+	t.Run("should report unknown fields in specs", func(t *testing.T) {
+		issues := validate(t, `
+- kind: AWSKeys
+  name: aws-keys
+  specs:
+    region: eu-west-3
+    accessID: my-id
+    secretKey: my-key
+    secretkeyID: my-key-id
+`)
+
+		require.Len(t, issues, 1)
+		assert.Equal(t, "aws-keys", issues[0].Name)
+	})
+
+	t.Run("should report missing required fields in specs", func(t *testing.T) {
+		issues := validate(t, `
+- kind: AKVSecrets
+  name: akv-secrets
+  specs:
+    vaultName: my-vault
+`)
+
+		require.Len(t, issues, 1)
+		assert.Contains(t, issues[0].Error(), "TenantID")
+	})
+
+	t.Run("should accept AKV stores authenticated without client secret", func(t *testing.T) {
+		issues := validate(t, `
+- kind: AKVKeys
+  name: akv-certificate
+  specs:
+    vaultName: my-vault
+    tenantID: my-tenant
+    clientID: my-client
+    certificatePath: /certificates/akv.pfx
+    certificatePassword: my-password
+- kind: AKVSecrets
+  name: akv-username
+  specs:
+    vaultName: my-vault
+    tenantID: my-tenant
+    clientID: my-client
+    username: my-user
+    password: my-password
+- kind: AKVKeys
+  name: akv-msi
+  specs:
+    vaultName: my-vault
+    tenantID: my-tenant
+`)
+
+		assert.Empty(t, issues)
+	})
+
+	t.Run("should report invalid inner kinds and specs", func(t *testing.T) {
+		issues := validate(t, `
+- kind: LocalKeys
+  name: local-keys
+  specs:
+    secretStore: HashicorpKeys
+    specs:
+      mountPoint: secret
+      address: http://hashicorp:8200
+- kind: Ethereum
+  name: eth-accounts
+  specs:
+    keystore: HashicorpKeys
+    specs:
+      mountPoint: secret
+`)
+
+		require.Len(t, issues, 2)
+		assert.Contains(t, issues[0].Error(), "invalid secret store kind")
+		assert.Contains(t, issues[1].Error(), "invalid HashicorpKeys specs")
+	})
+
+	t.Run("should report unknown kinds and invalid permissions", func(t *testing.T) {
+		issues := validate(t, `
+- kind: Group
+  name: admins
+  specs:
+    policies: [admin]
+- kind: Role
+  name: anonymous
+  specs:
+    permission: ["read:nodes"]
+`)
+
+		require.Len(t, issues, 2)
+		assert.Contains(t, issues[0].Error(), "unknown kind")
+		assert.Contains(t, issues[1].Error(), "read:nodes")
+	})
+
+	t.Run("should report duplicate names of the same group of kinds", func(t *testing.T) {
+		issues := validate(t, `
+- kind: HashicorpKeys
+  name: my-store
+  specs:
+    mountPoint: quorum
+    address: http://hashicorp:8200
+- kind: HashicorpSecrets
+  name: my-store
+  specs:
+    mountPoint: secret
+    address: http://hashicorp:8200
+- kind: LocalKeys
+  name: my-store
+  specs:
+    secretStore: HashicorpSecrets
+    specs:
+      mountPoint: secret
+      address: http://hashicorp:8200
+`)
+
+		require.Len(t, issues, 1)
+		assert.Equal(t, "LocalKeys", string(issues[0].Kind))
+		assert.Contains(t, issues[0].Error(), "duplicate name")
+	})
+}
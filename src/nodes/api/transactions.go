@@ -0,0 +1,173 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/nodes/api/types"
+	nodesmanager "github.com/consensys/quorum-key-manager/src/nodes/manager"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/gorilla/mux"
+)
+
+type TransactionsAPI struct {
+	txs         transactions.Store
+	nodes       nodesmanager.Manager
+	authManager auth.Manager
+	logger      log.Logger
+}
+
+// NewTransactionsAPI creates a http.Handler to list the transactions sent through the nodes
+func NewTransactionsAPI(txs transactions.Store, nodes nodesmanager.Manager, authManager auth.Manager, logger log.Logger) *TransactionsAPI {
+	return &TransactionsAPI{
+		txs:         txs,
+		nodes:       nodes,
+		authManager: authManager,
+		logger:      logger,
+	}
+}
+
+func (h *TransactionsAPI) Register(router *mux.Router) {
+	router.HandleFunc("/transactions", h.list).Methods(http.MethodGet)
+}
+
+// @Summary List transactions
+// @Description List the transactions signed and sent through the nodes, the most recent first.
+// @Description Only the transactions sent by the tenant of the user through the nodes the user can access are listed
+// @Tags Nodes
+// @Produce json
+// @Param node query string false "node name"
+// @Param from query string false "sender address"
+// @Param hash query string false "transaction hash"
+// @Param status query string false "transaction status" Enums(pending, mined, failed, dropped, replaced)
+// @Param limit query int false "page size"
+// @Param page query int false "page number"
+// @Success 200 {array} PageResponse "Transaction list"
+// @Failure 400 {object} ErrorResponse "Invalid filter"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /transactions [get]
+func (h *TransactionsAPI) list(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+
+	resolver := authorizator.New(h.authManager.UserPermissions(userInfo), userInfo.Tenant, h.logger)
+	err := resolver.CheckPermission(&authtypes.Operation{Action: authtypes.ActionProxy, Resource: authtypes.ResourceNode})
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	filter, err := parseFilter(request)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+	filter.Tenants = resolver.AccessibleTenants()
+
+	// Only the transactions of the nodes accessible to the user are listed
+	nodes, err := h.nodes.List(ctx, userInfo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+	filter.Nodes = []string{}
+	node := request.URL.Query().Get("node")
+	for _, n := range nodes {
+		if node == "" || node == n {
+			filter.Nodes = append(filter.Nodes, n)
+		}
+	}
+
+	txs := []*transactions.Transaction{}
+	if len(filter.Nodes) > 0 {
+		txs, err = h.txs.List(ctx, filter)
+		if err != nil {
+			http2.WriteHTTPErrorResponse(rw, err)
+			return
+		}
+	}
+
+	resps := make([]*types.TransactionResponse, len(txs))
+	for i, tx := range txs {
+		resps[i] = formatTransactionResponse(tx)
+	}
+
+	_ = http2.WritePagingResponse(rw, request, resps)
+}
+
+func parseFilter(request *http.Request) (*transactions.Filter, error) {
+	query := request.URL.Query()
+	filter := new(transactions.Filter)
+
+	if from := query.Get("from"); from != "" {
+		if !ethcommon.IsHexAddress(from) {
+			return nil, errors.InvalidFormatError("invalid from address")
+		}
+		addr := ethcommon.HexToAddress(from)
+		filter.From = &addr
+	}
+
+	if hash := query.Get("hash"); hash != "" {
+		txHash := new(ethcommon.Hash)
+		if err := txHash.UnmarshalText([]byte(hash)); err != nil {
+			return nil, errors.InvalidFormatError("invalid transaction hash")
+		}
+		filter.Hash = txHash
+	}
+
+	switch status := transactions.Status(query.Get("status")); status {
+	case "", transactions.StatusPending, transactions.StatusMined, transactions.StatusFailed, transactions.StatusDropped, transactions.StatusReplaced:
+		filter.Status = status
+	default:
+		return nil, errors.InvalidFormatError("invalid transaction status")
+	}
+
+	limit := query.Get("limit")
+	if limit == "" {
+		limit = http2.DefaultPageSize
+	}
+	var err error
+	filter.Limit, err = strconv.ParseUint(limit, 10, 64)
+	if err != nil {
+		return nil, errors.InvalidFormatError("invalid limit value")
+	}
+
+	if page := query.Get("page"); page != "" {
+		iPage, err := strconv.ParseUint(page, 10, 64)
+		if err != nil {
+			return nil, errors.InvalidFormatError("invalid page value")
+		}
+		filter.Offset = iPage * filter.Limit
+	}
+
+	return filter, nil
+}
+
+func formatTransactionResponse(tx *transactions.Transaction) *types.TransactionResponse {
+	return &types.TransactionResponse{
+		Hash:        tx.Hash,
+		Node:        tx.Node,
+		Kind:        string(tx.Kind),
+		From:        tx.From,
+		To:          tx.To,
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Tenant:      tx.Tenant,
+		Username:    tx.Username,
+		Status:      string(tx.Status),
+		BlockNumber: tx.BlockNumber,
+		ReplacedBy:  tx.ReplacedBy,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+}
@@ -0,0 +1,25 @@
+package types
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+type TransactionResponse struct {
+	Hash        common.Hash     `json:"hash" example:"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778" swaggertype:"string"`
+	Node        string          `json:"node" example:"quorum-node"`
+	Kind        string          `json:"kind" example:"public"`
+	From        common.Address  `json:"from" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6" swaggertype:"string"`
+	To          *common.Address `json:"to,omitempty" example:"0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18" swaggertype:"string"`
+	Nonce       uint64          `json:"nonce" example:"1"`
+	Raw         hexutil.Bytes   `json:"raw" example:"0xf85380839896808252088083989680808216b4a0d35c752d3498e6f5ca1630d264802a992a141ca4b6a3f439d673c75e944e5fb0a05278aaa5fabbeac362c321b54e298dedae2d31471e432c26ea36a8d49cf08f1e" swaggertype:"string"`
+	Tenant      string          `json:"tenant,omitempty" example:"tenant-one"`
+	Username    string          `json:"username,omitempty" example:"alice"`
+	Status      string          `json:"status" example:"mined"`
+	BlockNumber *uint64         `json:"blockNumber,omitempty" example:"436"`
+	ReplacedBy  *common.Hash    `json:"replacedBy,omitempty" example:"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778" swaggertype:"string"`
+	CreatedAt   time.Time       `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
+	UpdatedAt   time.Time       `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
+}
@@ -0,0 +1,13 @@
+package nodes
+
+import (
+	pg "github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
+)
+
+type Config struct {
+	Postgres *pg.Config
+	Nonce    *nonce.Config
+	Txs      *transactions.Config
+}
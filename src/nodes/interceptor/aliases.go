@@ -0,0 +1,99 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+)
+
+// WithAliases resolves the alias references {{registry:alias}} of privateFrom, privateFor and privacyGroupId with the aliases accessible to the users
+func (i *Interceptor) WithAliases(aliases aliasent.Connector) *Interceptor {
+	i.aliases = aliases
+	return i
+}
+
+// replaceAliases replaces the alias references of the private arguments by the values they hold.
+// A privateFor alias can hold several Tessera keys while a privateFrom alias must hold exactly one,
+// a privacyGroupId alias must hold exactly one privacy group ID
+func (i *Interceptor) replaceAliases(ctx context.Context, args *ethereum.PrivateArgs) error {
+	if args.PrivateFrom != nil {
+		keys, err := i.resolveAlias(ctx, *args.PrivateFrom, aliasent.KindTesseraKey)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) != 1 {
+			errMessage := "privateFrom alias must hold exactly one key"
+			i.logger.Error(errMessage, "private_from", *args.PrivateFrom)
+			return jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+		}
+
+		args.PrivateFrom = &keys[0]
+	}
+
+	if args.PrivateFor != nil {
+		privateFor := []string{}
+		for _, s := range *args.PrivateFor {
+			keys, err := i.resolveAlias(ctx, s, aliasent.KindTesseraKey)
+			if err != nil {
+				return err
+			}
+
+			privateFor = append(privateFor, keys...)
+		}
+
+		args.PrivateFor = &privateFor
+	}
+
+	if args.PrivacyGroupID != nil {
+		ids, err := i.resolveAlias(ctx, *args.PrivacyGroupID, aliasent.KindPrivacyGroupID)
+		if err != nil {
+			return err
+		}
+
+		if len(ids) != 1 {
+			errMessage := "privacyGroupId alias must hold exactly one privacy group ID"
+			i.logger.Error(errMessage, "privacy_group_id", *args.PrivacyGroupID)
+			return jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+		}
+
+		args.PrivacyGroupID = &ids[0]
+	}
+
+	return nil
+}
+
+// resolveAlias returns the values held by an alias reference of the expected kind, any other string is returned as is
+func (i *Interceptor) resolveAlias(ctx context.Context, s string, kind aliasent.AliasKind) ([]string, error) {
+	registry, key, ok := aliasent.ParseReference(s)
+	if !ok {
+		return []string{s}, nil
+	}
+
+	if i.aliases == nil {
+		errMessage := "aliases are not supported"
+		i.logger.Error(errMessage, "alias", s)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	alias, err := i.aliases.Aliases(userInfo).GetAlias(ctx, registry, key)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to get alias", "alias", s)
+		if errors.IsNotFoundError(err) {
+			return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError("alias %s not found", s))
+		}
+		return nil, err
+	}
+
+	if alias.Value.Kind != kind {
+		i.logger.Error("alias holds values of an unexpected kind", "alias", s, "kind", alias.Value.Kind)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError("alias %s must hold values of kind %s", s, kind))
+	}
+
+	return alias.Value.Values, nil
+}
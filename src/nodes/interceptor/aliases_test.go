@@ -0,0 +1,128 @@
+package interceptor
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/common"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	mockaliases "github.com/consensys/quorum-key-manager/src/aliases/entities/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestEEASendTransactionWithAliases(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	i, stores := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+
+	userInfo := &types.UserInfo{
+		Username:    "username",
+		Permissions: []types.Permission{"sign:key"},
+	}
+
+	// Aliases are resolved with the permissions of the user
+	aliases := mockaliases.NewMockAliasBackend(ctrl)
+	aliasConnector := mockaliases.NewMockConnector(ctrl)
+	aliasConnector.EXPECT().Aliases(userInfo).Return(aliases).AnyTimes()
+	i.WithAliases(aliasConnector)
+	session := proxynode.NewMockSession(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	cller := mockethereum.NewMockCaller(ctrl)
+	eeaCaller := mockethereum.NewMockEEACaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	privCaller := mockethereum.NewMockPrivCaller(ctrl)
+	cller.EXPECT().EEA().Return(eeaCaller).AnyTimes()
+	cller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+	cller.EXPECT().Priv().Return(privCaller).AnyTimes()
+
+	session.EXPECT().EthCaller().Return(cller).AnyTimes()
+
+	expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Transaction with aliases in privateFrom and privateFor",
+			handler: i,
+			reqBody: []byte(`{"jsonrpc":"2.0","method":"eea_sendTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9184e72a000","privateFrom":"{{my-registry:me}}","privateFor":["{{my-registry:group-A}}","eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="]}],"id":"abcd"}`),
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+
+				// Resolve aliases
+				aliases.EXPECT().GetAlias(gomock.Any(), aliasent.RegistryName("my-registry"), aliasent.AliasKey("me")).
+					Return(&aliasent.Alias{Value: aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY="}}}, nil)
+				aliases.EXPECT().GetAlias(gomock.Any(), aliasent.RegistryName("my-registry"), aliasent.AliasKey("group-A")).
+					Return(&aliasent.Alias{Value: aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="}}}, nil)
+
+				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
+				ethCaller.EXPECT().GasPrice(gomock.Any()).Return(big.NewInt(1000000000), nil)
+				ethCaller.EXPECT().EstimateGas(gomock.Any(), gomock.Any()).Return(uint64(21000), nil)
+
+				expectedPrivateFor := []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo=", "eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="}
+				privCaller.EXPECT().GetEeaTransactionCount(gomock.Any(), expectedFrom, "GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=", expectedPrivateFor).Return(uint64(5), nil)
+
+				expectedPrivateArgs := (&ethereum.PrivateArgs{PrivateType: common.ToPtr(ethereum.PrivateTypeRestricted).(*ethereum.PrivateType)}).WithPrivateFrom("GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=").WithPrivateFor(expectedPrivateFor)
+				accountsStore.EXPECT().SignEEA(gomock.Any(), expectedFrom, big.NewInt(1998), gomock.Any(), expectedPrivateArgs).Return(ethcommon.FromHex("0xa6122e27"), nil)
+
+				eeaCaller.EXPECT().SendRawTransaction(gomock.Any(), ethcommon.FromHex("0xa6122e27")).Return(ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778"), nil)
+			},
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778","error":null,"id":"abcd"}`),
+		},
+		{
+			desc:    "Transaction with unknown alias",
+			handler: i,
+			reqBody: []byte(`{"jsonrpc":"2.0","method":"eea_sendTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9184e72a000","privateFrom":"GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=","privateFor":["{{my-registry:unknown}}"]}],"id":"abcd"}`),
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				aliases.EXPECT().GetAlias(gomock.Any(), aliasent.RegistryName("my-registry"), aliasent.AliasKey("unknown")).Return(nil, errors.NotFoundError("alias not found"))
+			},
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: alias {{my-registry:unknown}} not found"}},"id":"abcd"}`),
+		},
+		{
+			desc:    "Transaction with privateFrom alias holding several keys",
+			handler: i,
+			reqBody: []byte(`{"jsonrpc":"2.0","method":"eea_sendTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9184e72a000","privateFrom":"{{my-registry:group-A}}","privateFor":["eLb69r4K8/9WviwlfDiZ4jf97P9czyS3DkKu0QYGLjg="]}],"id":"abcd"}`),
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				aliases.EXPECT().GetAlias(gomock.Any(), aliasent.RegistryName("my-registry"), aliasent.AliasKey("group-A")).
+					Return(&aliasent.Alias{Value: aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"KkOjNLmCI6r+mICrC6l+XuEDjFEzQllaMQMpWLl4y1s=", "A1aVtMxLCUHmBVHXoZzzBgPbW/wj5axDpW9X8l91SGo="}}}, nil)
+			},
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: privateFrom alias must hold exactly one key"}},"id":"abcd"}`),
+		},
+		{
+			desc:    "Transaction with privateFor alias holding Ethereum addresses",
+			handler: i,
+			reqBody: []byte(`{"jsonrpc":"2.0","method":"eea_sendTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9184e72a000","privateFrom":"GGilEkXLaQ9yhhtbpBT03Me9iYa7U/mWXxrJhnbl1XY=","privateFor":["{{my-registry:accounts}}"]}],"id":"abcd"}`),
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				aliases.EXPECT().GetAlias(gomock.Any(), aliasent.RegistryName("my-registry"), aliasent.AliasKey("accounts")).
+					Return(&aliasent.Alias{Value: aliasent.AliasValue{Kind: aliasent.KindEthAddress, Values: []string{"0x78e6e236592597c09d5c137c2af40aecd42d12a2"}}}, nil)
+			},
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: alias {{my-registry:accounts}} must hold values of kind tessera"}},"id":"abcd"}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
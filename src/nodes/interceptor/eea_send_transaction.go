@@ -9,6 +9,8 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
@@ -18,36 +20,22 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 
 	userInfo := authenticator.UserInfoContextFromContext(ctx)
 	// Get store for from
-	store, err := i.stores.GetEthStoreByAddr(ctx, msg.From, userInfo)
+	store, err := i.getEthStoreByAddr(ctx, msg.From, userInfo)
 	if err != nil {
 		return nil, err
 	}
 
 	sess := proxynode.SessionFromContext(ctx)
 
-	if msg.Nonce == nil {
-		var n uint64
-		if msg.PrivacyGroupID != nil {
-			n, err = sess.EthCaller().Priv().GetTransactionCount(ctx, msg.From, *msg.PrivacyGroupID)
-		} else {
-			if msg.PrivateFor == nil {
-				errMessage := "missing privateFor"
-				i.logger.Error(errMessage)
-				return nil, errors.InvalidFormatError(errMessage)
-			}
-
-			var privateFrom string
-			if msg.PrivateFrom != nil {
-				privateFrom = *msg.PrivateFrom
-			}
-			n, err = sess.EthCaller().Priv().GetEeaTransactionCount(ctx, msg.From, privateFrom, *msg.PrivateFor)
-		}
-		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch transaction count (EEA transaction)")
-			return nil, errors.BlockchainNodeError(err.Error())
-		}
+	err = i.replaceAliases(ctx, &msg.PrivateArgs)
+	if err != nil {
+		return nil, err
+	}
 
-		msg.Nonce = &n
+	if msg.Nonce == nil && msg.PrivacyGroupID == nil && msg.PrivateFor == nil {
+		errMessage := "missing privateFor"
+		i.logger.Error(errMessage)
+		return nil, errors.InvalidFormatError(errMessage)
 	}
 
 	if msg.GasPrice == nil {
@@ -83,28 +71,54 @@ func (i *Interceptor) eeaSendTransaction(ctx context.Context, msg *ethereum.Send
 		msg.PrivateType = common.ToPtr(ethereum.PrivateTypeRestricted).(*ethereum.PrivateType)
 	}
 
-	// Get ChainID from Node
-	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to fetch chainID (EEA transaction)")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
+	scope := eeaNonceScope(msg.PrivacyGroupID, msg.PrivateFrom, msg.PrivateFor)
+	return i.sendWithNonce(ctx, sess, msg.From, scope, &msg.Nonce, i.fetchEEANonce(sess, msg), func() (*ethcommon.Hash, error) {
+		// Get ChainID from Node
+		chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+		if err != nil {
+			i.logger.WithError(err).Error("failed to fetch chainID (EEA transaction)")
+			return nil, errors.BlockchainNodeError(err.Error())
+		}
 
-	// Sign
-	sig, err := store.SignEEA(ctx, msg.From, chainID, msg.TxData(), &msg.PrivateArgs)
-	if err != nil {
-		return nil, err
-	}
+		// Sign
+		sig, err := store.SignEEA(ctx, msg.From, chainID, msg.TxData(), &msg.PrivateArgs)
+		if err != nil {
+			return nil, err
+		}
 
-	// Submit transaction to downstream node
-	hash, err := sess.EthCaller().EEA().SendRawTransaction(ctx, sig)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw EEA transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
+		// Submit transaction to downstream node
+		hash, err := sess.EthCaller().EEA().SendRawTransaction(ctx, sig)
+		if err != nil {
+			i.logger.WithError(err).Error("failed to send raw EEA transaction")
+			return nil, sendTxError(err)
+		}
+
+		i.logger.Info("EEA transaction sent successfully", "tx_hash", hash)
+		i.trackTransaction(ctx, transactions.KindEEAPrivate, msg.From, msg.To, *msg.Nonce, sig, hash)
+		return &hash, nil
+	})
+}
 
-	i.logger.Info("EEA transaction sent successfully", "tx_hash", hash)
-	return &hash, nil
+func (i *Interceptor) fetchEEANonce(sess proxynode.Session, msg *ethereum.SendEEATxMsg) nonce.FetchFunc {
+	return func(ctx context.Context) (uint64, error) {
+		var n uint64
+		var err error
+		if msg.PrivacyGroupID != nil {
+			n, err = sess.EthCaller().Priv().GetTransactionCount(ctx, msg.From, *msg.PrivacyGroupID)
+		} else {
+			var privateFrom string
+			if msg.PrivateFrom != nil {
+				privateFrom = *msg.PrivateFrom
+			}
+			n, err = sess.EthCaller().Priv().GetEeaTransactionCount(ctx, msg.From, privateFrom, *msg.PrivateFor)
+		}
+		if err != nil {
+			i.logger.WithError(err).Error("failed to fetch transaction count (EEA transaction)")
+			return 0, errors.BlockchainNodeError(err.Error())
+		}
+
+		return n, nil
+	}
 }
 
 func (i *Interceptor) EEASendTransaction() jsonrpc.Handler {
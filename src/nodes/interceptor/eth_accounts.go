@@ -12,7 +12,7 @@ func (i *Interceptor) ethAccounts(ctx context.Context) ([]ethcommon.Address, err
 	i.logger.Debug("listing ETH accounts")
 
 	userInfo := authenticator.UserInfoContextFromContext(ctx)
-	addresses, err := i.stores.ListAllAccounts(ctx, userInfo)
+	addresses, err := i.listAccounts(ctx, userInfo)
 	if err != nil {
 		return nil, err
 	}
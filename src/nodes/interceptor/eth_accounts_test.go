@@ -4,9 +4,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
 	"github.com/consensys/quorum-key-manager/src/auth/types"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/golang/mock/gomock"
 )
@@ -50,3 +52,45 @@ func TestEthAccounts(t *testing.T) {
 		})
 	}
 }
+
+func TestEthAccountsRestrictedStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	userInfo := &types.UserInfo{
+		Username: "username",
+		Tenant:   "tenantOne",
+	}
+
+	ctx := authenticator.WithUserContext(context.TODO(), &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	cfg := new(proxynode.Config).SetDefault()
+	cfg.EthStores = []string{"eth-store", "eth-store-other-tenant"}
+	i, stores := newInterceptorWithConfig(ctrl, cfg)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Accounts of allowed stores",
+			handler: i,
+			ctx:     ctx,
+			prepare: func() {
+				accts := []ethcommon.Address{
+					ethcommon.HexToAddress("0xfe3b557e8fb62b89f4916b721be55ceb828dbd73"),
+				}
+				stores.EXPECT().GetEthStore(gomock.Any(), "eth-store", userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().List(gomock.Any(), uint64(0), uint64(0)).Return(accts, nil)
+				stores.EXPECT().GetEthStore(gomock.Any(), "eth-store-other-tenant", userInfo).Return(nil, errors.NotFoundError("not found"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_accounts","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":["0xfe3b557e8fb62b89f4916b721be55ceb828dbd73"],"error":null,"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
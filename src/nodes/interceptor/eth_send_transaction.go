@@ -8,20 +8,35 @@ import (
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+const (
+	// Number of blocks and reward percentile of the fee history used to suggest a miner tip
+	feeHistoryBlocks           = 10
+	feeHistoryRewardPercentile = 50
 )
 
 func (i *Interceptor) ethSendTransaction(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
+	if !msg.IsPrivate() {
+		if err := i.checkAccessList(msg); err != nil {
+			return nil, err
+		}
+	}
+
 	switch {
 	case msg.IsPrivate():
 		return i.sendPrivateTx(ctx, msg)
-	case msg.IsLegacy():
-		return i.sendLegacyTx(ctx, msg)
-	default:
+	case msg.TxType() == types.DynamicFeeTxType:
 		// If the node is a pre-london fork, the tx will be reverted to legacy, and we will retrieve the gasPrice from the node
-		// If the node is a post-london fork, the tx will be filled with a default value for maxFeePerGas (currently maxFee = baseFee)
+		// If the node is a post-london fork, the tx will be filled with a miner tip suggested from the fee history
+		// and a maxFeePerGas leaving room for the base fee to double (maxFee = 2 * baseFee + tip)
 		// pre-london = 'baseFeePerGas' field does not exist in the block header.
 		return i.sendTx(ctx, msg)
+	default:
+		return i.sendLegacyTx(ctx, msg)
 	}
 }
 
@@ -30,6 +45,11 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 
 	sess := proxynode.SessionFromContext(ctx)
 
+	err := i.replaceAliases(ctx, &msg.PrivateArgs)
+	if err != nil {
+		return nil, err
+	}
+
 	if msg.GasPrice == nil {
 		gasPrice, err := sess.EthCaller().Eth().GasPrice(ctx)
 		if err != nil {
@@ -40,12 +60,7 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 		msg.GasPrice = gasPrice
 	}
 
-	err := i.fillGas(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
-
-	err = i.fillNonce(ctx, sess, msg)
+	err = i.fillGas(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -63,19 +78,26 @@ func (i *Interceptor) sendPrivateTx(ctx context.Context, msg *ethereum.SendTxMsg
 	// Switch message data
 	*msg.Data = key
 
-	raw, err := i.ethSignTransaction(ctx, msg)
-	if err != nil {
-		return nil, err
-	}
+	return i.sendWithNonce(ctx, sess, msg.From, "", &msg.Nonce, i.fetchNonce(sess, msg.From), func() (*ethcommon.Hash, error) {
+		raw, err := i.ethSignTransaction(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 
-	hash, err := sess.EthCaller().Eth().SendRawPrivateTransaction(ctx, *raw, &msg.PrivateArgs)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw quorum private transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
+		hash, err := sess.EthCaller().Eth().SendRawPrivateTransaction(ctx, *raw, &msg.PrivateArgs)
+		if knownHash, ok := knownTxHash(err, *raw); ok {
+			i.logger.Warn("transaction already known by the node", "tx_hash", knownHash)
+			return knownHash, nil
+		}
+		if err != nil {
+			i.logger.WithError(err).Error("failed to send raw quorum private transaction")
+			return nil, sendTxError(err)
+		}
 
-	i.logger.Info("quorum private transaction sent successfully", "tx_hash", hash)
-	return &hash, nil
+		i.logger.Info("quorum private transaction sent successfully", "tx_hash", hash)
+		i.trackTransaction(ctx, transactions.KindQuorumPrivate, msg.From, msg.To, *msg.Nonce, *raw, hash)
+		return &hash, nil
+	})
 }
 
 func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
@@ -93,29 +115,33 @@ func (i *Interceptor) sendLegacyTx(ctx context.Context, msg *ethereum.SendTxMsg)
 		msg.GasPrice = gasPrice
 	}
 
-	err := i.fillGas(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
+	i.fillAccessList(ctx, sess, msg)
 
-	err = i.fillNonce(ctx, sess, msg)
+	err := i.fillGas(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	raw, err := i.ethSignTransaction(ctx, msg)
-	if err != nil {
-		return nil, err
-	}
+	return i.sendWithNonce(ctx, sess, msg.From, "", &msg.Nonce, i.fetchNonce(sess, msg.From), func() (*ethcommon.Hash, error) {
+		raw, err := i.ethSignTransaction(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
 
-	hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw legacy transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
+		hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
+		if knownHash, ok := knownTxHash(err, *raw); ok {
+			i.logger.Warn("transaction already known by the node", "tx_hash", knownHash)
+			return knownHash, nil
+		}
+		if err != nil {
+			i.logger.WithError(err).Error("failed to send raw legacy transaction")
+			return nil, sendTxError(err)
+		}
 
-	i.logger.Info("legacy transaction sent successfully", "tx_hash", hash)
-	return &hash, nil
+		i.logger.Info("legacy transaction sent successfully", "tx_hash", hash)
+		i.trackTransaction(ctx, transactions.KindPublic, msg.From, msg.To, *msg.Nonce, *raw, hash)
+		return &hash, nil
+	})
 }
 
 func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*ethcommon.Hash, error) {
@@ -131,58 +157,57 @@ func (i *Interceptor) sendTx(ctx context.Context, msg *ethereum.SendTxMsg) (*eth
 
 	if baseFee == nil {
 		i.logger.Warn("cannot send a dynamic fee transaction to a pre-London node, reverting to legacy tx")
+		msg.Type = nil
 		return i.sendLegacyTx(ctx, msg)
 	}
 
-	if msg.GasFeeCap == nil {
-		var maxPriorityFeePerGas = big.NewInt(0)
-		if msg.GasTipCap != nil {
-			maxPriorityFeePerGas = msg.GasTipCap
+	if msg.GasTipCap == nil {
+		msg.GasTipCap = i.suggestGasTipCap(ctx, sess)
+		if msg.GasFeeCap != nil && msg.GasTipCap.Cmp(msg.GasFeeCap) > 0 {
+			msg.GasTipCap = new(big.Int).Set(msg.GasFeeCap)
 		}
-		msg.GasFeeCap = new(big.Int).Add(baseFee, maxPriorityFeePerGas)
-		i.logger.
-			With("max_fee_per_gas", msg.GasFeeCap, "base_fee", baseFee, "max_priority_fee_per_gas", maxPriorityFeePerGas).
-			Debug("'maxFeePerGas' set with previous block 'baseFeePerGas' + miner tip")
+		i.logger.With("max_priority_fee_per_gas", msg.GasTipCap).Debug("'maxPriorityFeePerGas' set from fee history")
 	}
 
-	err = i.fillGas(ctx, sess, msg)
-	if err != nil {
-		return nil, err
+	if msg.GasFeeCap == nil {
+		msg.GasFeeCap = new(big.Int).Add(new(big.Int).Mul(baseFee, big.NewInt(2)), msg.GasTipCap)
+		i.logger.
+			With("max_fee_per_gas", msg.GasFeeCap, "base_fee", baseFee, "max_priority_fee_per_gas", msg.GasTipCap).
+			Debug("'maxFeePerGas' set with 2 * previous block 'baseFeePerGas' + miner tip")
 	}
 
-	err = i.fillNonce(ctx, sess, msg)
-	if err != nil {
-		return nil, err
-	}
+	i.fillAccessList(ctx, sess, msg)
 
-	raw, err := i.ethSignTransaction(ctx, msg)
+	err = i.fillGas(ctx, sess, msg)
 	if err != nil {
 		return nil, err
 	}
 
-	hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
-	if err != nil {
-		i.logger.WithError(err).Error("failed to send raw transaction")
-		return nil, errors.BlockchainNodeError(err.Error())
-	}
+	return i.sendWithNonce(ctx, sess, msg.From, "", &msg.Nonce, i.fetchNonce(sess, msg.From), func() (*ethcommon.Hash, error) {
+		raw, err := i.ethSignTransaction(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, err := sess.EthCaller().Eth().SendRawTransaction(ctx, *raw)
+		if knownHash, ok := knownTxHash(err, *raw); ok {
+			i.logger.Warn("transaction already known by the node", "tx_hash", knownHash)
+			return knownHash, nil
+		}
+		if err != nil {
+			i.logger.WithError(err).Error("failed to send raw transaction")
+			return nil, sendTxError(err)
+		}
 
-	i.logger.Info("ETH transaction sent successfully", "tx_hash", hash)
-	return &hash, nil
+		i.logger.Info("ETH transaction sent successfully", "tx_hash", hash)
+		i.trackTransaction(ctx, transactions.KindPublic, msg.From, msg.To, *msg.Nonce, *raw, hash)
+		return &hash, nil
+	})
 }
 
 func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) error {
 	if msg.Gas == nil {
-		callMsg := &ethereum.CallMsg{
-			From:       &msg.From,
-			To:         msg.To,
-			Value:      msg.Value,
-			Data:       msg.Data,
-			GasPrice:   msg.GasPrice,
-			GasTipCap:  msg.GasTipCap,
-			GasFeeCap:  msg.GasFeeCap,
-			AccessList: msg.AccessList,
-		}
-		gas, err := sess.EthCaller().Eth().EstimateGas(ctx, callMsg)
+		gas, err := sess.EthCaller().Eth().EstimateGas(ctx, toCallMsg(msg))
 		if err != nil {
 			i.logger.WithError(err).With("gas_price", msg.GasPrice).Error("failed to estimate gas")
 			return errors.BlockchainNodeError(err.Error())
@@ -194,18 +219,53 @@ func (i *Interceptor) fillGas(ctx context.Context, sess proxynode.Session, msg *
 	return nil
 }
 
-func (i *Interceptor) fillNonce(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) error {
-	if msg.Nonce == nil {
-		n, err := sess.EthCaller().Eth().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber)
-		if err != nil {
-			i.logger.WithError(err).Error("failed to fetch nonce", "from_account", msg.From)
-			return errors.BlockchainNodeError(err.Error())
-		}
+// fillAccessList generates the access list of a typed transaction which does not provide one.
+// The access list only saves gas, so the transaction is still sent without it if the node fails to create it
+func (i *Interceptor) fillAccessList(ctx context.Context, sess proxynode.Session, msg *ethereum.SendTxMsg) {
+	if msg.AccessList != nil || msg.Type == nil || *msg.Type == types.LegacyTxType {
+		return
+	}
 
-		msg.Nonce = &n
+	res, err := sess.EthCaller().Eth().CreateAccessList(ctx, toCallMsg(msg), ethereum.LatestBlockNumber)
+	if err != nil {
+		i.logger.WithError(err).Warn("failed to create access list, sending transaction without it")
+		return
 	}
 
-	return nil
+	if res.Error != "" {
+		i.logger.With("error", res.Error).Warn("transaction reverts with the created access list, sending transaction without it")
+		return
+	}
+
+	msg.AccessList = res.AccessList
+}
+
+// suggestGasTipCap returns the median miner tip paid over the last blocks, or 0 if the node cannot provide its fee history
+func (i *Interceptor) suggestGasTipCap(ctx context.Context, sess proxynode.Session) *big.Int {
+	history, err := sess.EthCaller().Eth().FeeHistory(ctx, feeHistoryBlocks, ethereum.LatestBlockNumber, []float64{feeHistoryRewardPercentile})
+	if err != nil {
+		i.logger.WithError(err).Warn("failed to fetch fee history, 'maxPriorityFeePerGas' set to 0")
+		return big.NewInt(0)
+	}
+
+	if tip := history.MedianReward(); tip != nil {
+		return tip
+	}
+
+	return big.NewInt(0)
+}
+
+func toCallMsg(msg *ethereum.SendTxMsg) *ethereum.CallMsg {
+	return &ethereum.CallMsg{
+		From:       &msg.From,
+		To:         msg.To,
+		Value:      msg.Value,
+		Data:       msg.Data,
+		GasPrice:   msg.GasPrice,
+		GasTipCap:  msg.GasTipCap,
+		GasFeeCap:  msg.GasFeeCap,
+		AccessList: msg.AccessList,
+	}
 }
 
 func (i *Interceptor) EthSendTransaction() jsonrpc.Handler {
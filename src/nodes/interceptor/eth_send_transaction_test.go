@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 	"math/big"
 	"testing"
 
@@ -17,6 +18,8 @@ import (
 	mocktessera "github.com/consensys/quorum-key-manager/pkg/tessera/mock"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
 )
 
@@ -50,7 +53,7 @@ func TestEthSendTransaction(t *testing.T) {
 	session.EXPECT().ClientPrivTxManager().Return(tesseraClient).AnyTimes()
 	stores.EXPECT().GetEthStoreByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil).AnyTimes()
 
-	i := New(stores, testutils.NewMockLogger(ctrl))
+	i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl))
 
 	t.Run("should send a private tx successfully", func(t *testing.T) {
 		privateArgs := (&ethereum.PrivateArgs{}).
@@ -117,19 +120,24 @@ func TestEthSendTransaction(t *testing.T) {
 			From:  from,
 			Value: value,
 		}
+		tip := big.NewInt(3)
 		expectedEstimateGasCall := &ethereum.CallMsg{
 			From:       &msg.From,
 			To:         msg.To,
 			Value:      value,
 			Data:       msg.Data,
-			GasFeeCap:  new(big.Int).Add(gasPrice, big.NewInt(0)),
-			GasTipCap:  msg.GasTipCap,
+			GasFeeCap:  big.NewInt(2*38 + 3),
+			GasTipCap:  tip,
 			AccessList: msg.AccessList,
 		}
 		expectedSignedTx := []byte("mysignature")
 		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
 
 		ethCaller.EXPECT().BaseFeePerGas(ctx, ethereum.LatestBlockNumber).Return(gasPrice, nil)
+		ethCaller.EXPECT().FeeHistory(ctx, uint64(feeHistoryBlocks), ethereum.LatestBlockNumber, []float64{feeHistoryRewardPercentile}).Return(&ethereum.FeeHistory{
+			GasUsedRatio: []float64{0.5, 0, 0.7, 0.2},
+			Reward:       [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(5))}, {(*hexutil.Big)(big.NewInt(0))}, {(*hexutil.Big)(tip)}, {(*hexutil.Big)(big.NewInt(1))}},
+		}, nil)
 		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
 		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
 		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
@@ -142,6 +150,78 @@ func TestEthSendTransaction(t *testing.T) {
 		assert.Equal(t, hash.Hex(), expectedHash.Hex())
 	})
 
+	t.Run("should send a dynamic fee tx without miner tip if fee history is not available", func(t *testing.T) {
+		msg := &ethereum.SendTxMsg{
+			From:      from,
+			Value:     value,
+			GasFeeCap: big.NewInt(100),
+		}
+		expectedEstimateGasCall := &ethereum.CallMsg{
+			From:      &msg.From,
+			Value:     value,
+			GasFeeCap: big.NewInt(100),
+			GasTipCap: big.NewInt(0),
+		}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		ethCaller.EXPECT().BaseFeePerGas(ctx, ethereum.LatestBlockNumber).Return(gasPrice, nil)
+		ethCaller.EXPECT().FeeHistory(ctx, uint64(feeHistoryBlocks), ethereum.LatestBlockNumber, gomock.Any()).Return(nil, fmt.Errorf("method not found"))
+		ethCaller.EXPECT().EstimateGas(ctx, expectedEstimateGasCall).Return(uint64(21000), nil)
+		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
+		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).Return(expectedSignedTx, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
+
+	t.Run("should fill the access list of an access list tx", func(t *testing.T) {
+		txType := uint64(ethtypes.AccessListTxType)
+		to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+		msg := &ethereum.SendTxMsg{
+			Type:     &txType,
+			From:     from,
+			To:       &to,
+			Value:    value,
+			GasPrice: gasPrice,
+		}
+		accessList := ethtypes.AccessList{{Address: to, StorageKeys: []ethcommon.Hash{ethcommon.HexToHash("0x1")}}}
+		expectedSignedTx := []byte("mysignature")
+		expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+		ethCaller.EXPECT().CreateAccessList(ctx, &ethereum.CallMsg{
+			From:     &msg.From,
+			To:       &to,
+			Value:    value,
+			GasPrice: gasPrice,
+		}, ethereum.LatestBlockNumber).Return(&ethereum.AccessListResult{AccessList: accessList, GasUsed: 23000}, nil)
+		ethCaller.EXPECT().EstimateGas(ctx, &ethereum.CallMsg{
+			From:       &msg.From,
+			To:         &to,
+			Value:      value,
+			GasPrice:   gasPrice,
+			AccessList: accessList,
+		}).Return(uint64(23000), nil)
+		ethCaller.EXPECT().GetTransactionCount(ctx, msg.From, ethereum.PendingBlockNumber).Return(uint64(0), nil)
+		ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil)
+		accountsStore.EXPECT().SignTransaction(ctx, msg.From, chainID, gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *ethtypes.Transaction) ([]byte, error) {
+				assert.Equal(t, uint8(ethtypes.AccessListTxType), tx.Type())
+				assert.Equal(t, accessList, tx.AccessList())
+				return expectedSignedTx, nil
+			})
+		ethCaller.EXPECT().SendRawTransaction(ctx, expectedSignedTx).Return(expectedHash, nil)
+
+		hash, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+
+		assert.Equal(t, hash.Hex(), expectedHash.Hex())
+	})
+
 	t.Run("should revert to legacy tx if baseFeePerGas is nil", func(t *testing.T) {
 		msg := &ethereum.SendTxMsg{
 			From:  from,
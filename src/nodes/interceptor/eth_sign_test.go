@@ -77,3 +77,56 @@ func TestEthSign(t *testing.T) {
 		})
 	}
 }
+
+func TestEthSignRestrictedStores(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &types.UserInfo{
+		Username: "username",
+	}
+
+	session := proxynode.NewMockSession(ctrl)
+	cfg := new(proxynode.Config).SetDefault()
+	cfg.EthStores = []string{"eth-store"}
+	i, stores := newInterceptorWithConfig(ctrl, cfg)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "Signature",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+				stores.EXPECT().GetEthStore(gomock.Any(), "eth-store", userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().Get(gomock.Any(), expectedFrom).Return(nil, nil)
+				accountsStore.EXPECT().Sign(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_sign","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", "0x2eadbe1f"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:    "Account not in allowed stores",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+				stores.EXPECT().GetEthStore(gomock.Any(), "eth-store", userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().Get(gomock.Any(), expectedFrom).Return(nil, errors.NotFoundError("account not found"))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_sign","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", "0x2eadbe1f"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"IR500: account was not found"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
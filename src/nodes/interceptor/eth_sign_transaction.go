@@ -2,6 +2,7 @@ package interceptor
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/ethereum/go-ethereum/core/types"
 
@@ -29,13 +30,39 @@ func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.Send
 		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
 	}
 
+	// Quorum private transactions are always legacy
+	if !msg.IsPrivate() {
+		if err := i.checkAccessList(msg); err != nil {
+			return nil, err
+		}
+
+		switch msg.TxType() {
+		case types.LegacyTxType, types.AccessListTxType:
+			if msg.GasPrice == nil {
+				errMessage := "gasPrice not specified"
+				i.logger.Error(errMessage)
+				return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+			}
+		case types.DynamicFeeTxType:
+			if msg.GasFeeCap == nil || msg.GasTipCap == nil {
+				errMessage := "maxFeePerGas and maxPriorityFeePerGas must be specified"
+				i.logger.Error(errMessage)
+				return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+			}
+		default:
+			errMessage := fmt.Sprintf("unsupported transaction type %d", msg.TxType())
+			i.logger.Error(errMessage)
+			return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+		}
+	}
+
 	if msg.Data == nil {
 		msg.Data = &[]byte{}
 	}
 
 	userInfo := authenticator.UserInfoContextFromContext(ctx)
 	// Get store for from
-	store, err := i.stores.GetEthStoreByAddr(ctx, msg.From, userInfo)
+	store, err := i.getEthStoreByAddr(ctx, msg.From, userInfo)
 	if err != nil {
 		return nil, err
 	}
@@ -53,10 +80,8 @@ func (i *Interceptor) ethSignTransaction(ctx context.Context, msg *ethereum.Send
 	switch {
 	case msg.IsPrivate():
 		sig, err = store.SignPrivate(ctx, msg.From, msg.TxDataQuorum())
-	case msg.IsLegacy():
-		sig, err = store.SignTransaction(ctx, msg.From, chainID, msg.TxData(types.LegacyTxType, chainID))
 	default:
-		sig, err = store.SignTransaction(ctx, msg.From, chainID, msg.TxData(types.DynamicFeeTxType, chainID))
+		sig, err = store.SignTransaction(ctx, msg.From, chainID, msg.TxData(msg.TxType(), chainID))
 	}
 	if err != nil {
 		return nil, err
@@ -70,3 +95,14 @@ func (i *Interceptor) EthSignTransaction() jsonrpc.Handler {
 	h, _ := jsonrpc.MakeHandler(i.ethSignTransaction)
 	return h
 }
+
+// checkAccessList rejects access lists on transactions explicitly typed as legacy, as they cannot carry them
+func (i *Interceptor) checkAccessList(msg *ethereum.SendTxMsg) error {
+	if msg.TxType() == types.LegacyTxType && msg.AccessList != nil {
+		errMessage := "legacy transactions do not support access lists"
+		i.logger.Error(errMessage)
+		return jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	return nil
+}
@@ -11,7 +11,9 @@ import (
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
 	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestEthSignTransaction(t *testing.T) {
@@ -55,6 +57,39 @@ func TestEthSignTransaction(t *testing.T) {
 			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9172a000","nonce":"0x5","data":"0x5208","value":"0x1"}]}`),
 			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
 		},
+		{
+			desc:    "Access list transaction",
+			handler: i,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				ethCaller.EXPECT().ChainID(gomock.Any()).Return(big.NewInt(1998), nil)
+				accountsStore.EXPECT().SignTransaction(gomock.Any(), expectedFrom, big.NewInt(1998), gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *ethtypes.Transaction) ([]byte, error) {
+						assert.Equal(t, uint8(ethtypes.AccessListTxType), tx.Type())
+						assert.Len(t, tx.AccessList(), 1)
+						return ethcommon.FromHex("0xa6122e27"), nil
+					})
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9172a000","nonce":"0x5","data":"0x5208","value":"0x1","accessList":[{"address":"0x905b88eff8bda1543d4d6f4aa05afef143d27e18","storageKeys":["0x0000000000000000000000000000000000000000000000000000000000000001"]}]}]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:             "Unsupported transaction type",
+			handler:          i,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"type":"0x7","from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9172a000","nonce":"0x5"}]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: unsupported transaction type 7"}},"id":null}`),
+		},
+		{
+			desc:             "Legacy transaction with an access list",
+			handler:          i,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTransaction","params":[{"type":"0x0","from":"0x78e6e236592597c09d5c137c2af40aecd42d12a2","gas":"0x5208","gasPrice":"0x9172a000","nonce":"0x5","accessList":[{"address":"0x905b88eff8bda1543d4d6f4aa05afef143d27e18","storageKeys":[]}]}]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: legacy transactions do not support access lists"}},"id":null}`),
+		},
 		{
 			desc:    "Private transaction",
 			handler: i,
@@ -0,0 +1,48 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// typedDataParam is EIP-712 typed data sent either as a JSON object or as a JSON string, as wallets do for eth_signTypedData_v3 and v4
+type typedDataParam core.TypedData
+
+func (p *typedDataParam) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err == nil {
+		b = []byte(s)
+	}
+
+	return json.Unmarshal(b, (*core.TypedData)(p))
+}
+
+func (i *Interceptor) ethSignTypedData(ctx context.Context, from ethcommon.Address, typedData typedDataParam) (*hexutil.Bytes, error) {
+	logger := i.logger.With("from_account", from.Hex())
+	logger.Debug("signing typed data")
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	store, err := i.getEthStoreByAddr(ctx, from, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignTypedData(ctx, from, (*core.TypedData)(&typedData))
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("typed data signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+func (i *Interceptor) EthSignTypedData() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.ethSignTypedData)
+	return h
+}
@@ -0,0 +1,80 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/signer/core"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEthSignTypedData(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &types.UserInfo{
+		Username:    "username",
+		Permissions: []types.Permission{"sign:ethereum"},
+	}
+
+	session := proxynode.NewMockSession(ctrl)
+	i, stores := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	typedData := `{"types":{"EIP712Domain":[{"name":"name","type":"string"}],"Mail":[{"name":"contents","type":"string"}]},"primaryType":"Mail","domain":{"name":"MyDApp"},"message":{"contents":"hello"}}`
+	expectTypedData := func() {
+		stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+		accountsStore.EXPECT().SignTypedData(gomock.Any(), expectedFrom, gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ ethcommon.Address, data *core.TypedData) ([]byte, error) {
+				assert.Equal(t, "Mail", data.PrimaryType)
+				assert.Equal(t, "MyDApp", data.Domain.Name)
+				assert.Equal(t, "hello", data.Message["contents"])
+				return ethcommon.FromHex("0xa6122e27"), nil
+			},
+		)
+	}
+
+	tests := []*testHandlerCase{
+		{
+			desc:             "Typed data as an object",
+			handler:          i.handler,
+			ctx:              ctx,
+			prepare:          expectTypedData,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTypedData","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", ` + typedData + `]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:             "Typed data v4 as a string",
+			handler:          i.handler,
+			ctx:              ctx,
+			prepare:          expectTypedData,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTypedData_v4","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", ` + quote(typedData) + `]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:             "Typed data v3 as a string",
+			handler:          i.handler,
+			ctx:              ctx,
+			prepare:          expectTypedData,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_signTypedData_v3","params":["0x78e6e236592597c09d5c137c2af40aecd42d12a2", ` + quote(typedData) + `]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
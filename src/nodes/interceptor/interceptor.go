@@ -2,15 +2,24 @@ package interceptor
 
 import (
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	"github.com/consensys/quorum-key-manager/src/stores"
 )
 
 type Interceptor struct {
-	stores  stores.Stores
-	handler jsonrpc.Handler
-	logger  log.Logger
+	stores    stores.Stores
+	ethStores []string
+	methods   *proxynode.MethodsConfig
+	node      string
+	nonces    nonce.Store
+	txs       transactions.Store
+	aliases   aliasent.Connector
+	handler   jsonrpc.Handler
+	logger    log.Logger
 }
 
 func (i *Interceptor) ServeRPC(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
@@ -28,17 +37,25 @@ func (i *Interceptor) newHandler() jsonrpc.Handler {
 	v2Router.Method("eth_sign").Handle(i.EthSign())
 	v2Router.Method("eth_signTransaction").Handle(i.EthSignTransaction())
 	v2Router.Method("eea_sendTransaction").Handle(i.EEASendTransaction())
+	v2Router.Method("priv_distributeRawTransaction").Handle(i.PrivDistributeRawTransaction())
+	v2Router.Method("eth_signTypedData").Handle(i.EthSignTypedData())
+	v2Router.Method("eth_signTypedData_v3").Handle(i.EthSignTypedData())
+	v2Router.Method("eth_signTypedData_v4").Handle(i.EthSignTypedData())
+	v2Router.Method("personal_sign").Handle(i.PersonalSign())
+	v2Router.Method("personal_ecRecover").Handle(i.PersonalECRecover())
 
-	// Silence JSON-RPC personal
+	// Silence the rest of JSON-RPC personal
 	v2Router.MethodPrefix("personal_").Handle(jsonrpc.MethodNotFoundHandler())
 
-	return jsonrpc.LoggedHandler(jsonrpc.DefaultRWHandler(router), i.logger)
+	return jsonrpc.LoggedHandler(jsonrpc.DefaultRWHandler(i.filterMethods(router)), i.logger)
 }
 
-func New(storesConnector stores.Stores, logger log.Logger) *Interceptor {
+func New(storesConnector stores.Stores, cfg *proxynode.Config, logger log.Logger) *Interceptor {
 	i := &Interceptor{
-		stores: storesConnector,
-		logger: logger,
+		stores:    storesConnector,
+		ethStores: cfg.EthStores,
+		methods:   cfg.Methods,
+		logger:    logger,
 	}
 
 	i.handler = i.newHandler()
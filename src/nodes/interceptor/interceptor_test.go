@@ -10,6 +10,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 
 	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
 	mockstoremanager "github.com/consensys/quorum-key-manager/src/stores/mock"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
@@ -17,8 +18,12 @@ import (
 )
 
 func newInterceptor(ctrl *gomock.Controller) (*Interceptor, *mockstoremanager.MockStores) {
+	return newInterceptorWithConfig(ctrl, new(proxynode.Config).SetDefault())
+}
+
+func newInterceptorWithConfig(ctrl *gomock.Controller, cfg *proxynode.Config) (*Interceptor, *mockstoremanager.MockStores) {
 	stores := mockstoremanager.NewMockStores(ctrl)
-	return New(stores, testutils.NewMockLogger(ctrl)), stores
+	return New(stores, cfg, testutils.NewMockLogger(ctrl)), stores
 }
 
 type testHandlerCase struct {
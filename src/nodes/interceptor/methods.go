@@ -0,0 +1,22 @@
+package interceptor
+
+import (
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+)
+
+// filterMethods rejects the JSON-RPC methods that are not allowed on the node
+func (i *Interceptor) filterMethods(h jsonrpc.Handler) jsonrpc.Handler {
+	if i.methods == nil || (len(i.methods.Allow) == 0 && len(i.methods.Deny) == 0) {
+		return h
+	}
+
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		if !i.methods.IsAllowed(msg.Method) {
+			i.logger.Warn("JSON-RPC method not allowed", "method", msg.Method)
+			jsonrpc.MethodNotAllowed(rw, msg)
+			return
+		}
+
+		h.ServeRPC(rw, msg)
+	})
+}
@@ -0,0 +1,60 @@
+package interceptor
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+)
+
+func TestMethodsFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	cfg := new(proxynode.Config).SetDefault()
+	cfg.Methods = &proxynode.MethodsConfig{
+		Allow: []string{"eth_*", "net_version"},
+		Deny:  []string{"eth_sendTransaction"},
+	}
+	i, stores := newInterceptorWithConfig(ctrl, cfg)
+
+	userInfo := &types.UserInfo{Username: "username"}
+	ctx := authenticator.WithUserContext(context.TODO(), &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	tests := []*testHandlerCase{
+		{
+			desc:             "Denied method",
+			handler:          i,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_sendTransaction","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"eth_sendTransaction\" not allowed","data":null},"id":null}`),
+		},
+		{
+			desc:             "Method not in allowlist",
+			handler:          i,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"admin_peers","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32601,"message":"Method \"admin_peers\" not allowed","data":null},"id":null}`),
+		},
+		{
+			desc:    "Allowed method",
+			handler: i,
+			ctx:     ctx,
+			prepare: func() {
+				stores.EXPECT().ListAllAccounts(gomock.Any(), userInfo).Return([]ethcommon.Address{}, nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"eth_accounts","params":[]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":[],"error":null,"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
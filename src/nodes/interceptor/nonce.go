@@ -0,0 +1,176 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"sort"
+	"strings"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Errors returned by the nodes when the nonce of a transaction has already been used
+var nonceErrors = []string{"nonce too low"}
+
+// Errors returned by the nodes when the same transaction has already been received
+var knownTxErrors = []string{"known transaction", "already known"}
+
+// WithNonces allocates the nonces of the transactions sent through the node with the given store
+// so concurrent transactions of an account do not get the same nonce
+func (i *Interceptor) WithNonces(node string, nonces nonce.Store) *Interceptor {
+	i.node = node
+	i.nonces = nonces
+	return i
+}
+
+// sendWithNonce sets the nonce of a transaction, if not set, and sends it.
+// If the node rejects the nonce, it is resynced from the chain and the transaction is sent once more.
+// The nonce is released on any other error, unless the node may have received the transaction
+func (i *Interceptor) sendWithNonce(
+	ctx context.Context,
+	sess proxynode.Session,
+	from ethcommon.Address,
+	scope string,
+	txNonce **uint64,
+	fetch nonce.FetchFunc,
+	send func() (*ethcommon.Hash, error),
+) (*ethcommon.Hash, error) {
+	if *txNonce != nil {
+		return unwrapSendTxError(send())
+	}
+
+	if i.nonces == nil {
+		n, err := fetch(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		*txNonce = &n
+		return unwrapSendTxError(send())
+	}
+
+	chainID, err := sess.EthCaller().Eth().ChainID(ctx)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to fetch chainID")
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+	key := nonce.Key(i.node, chainID, from, scope)
+
+	for retried := false; ; retried = true {
+		n, err := i.nonces.Next(ctx, key, fetch)
+		if err != nil {
+			return nil, err
+		}
+		*txNonce = &n
+
+		hash, err := send()
+		if err == nil {
+			return hash, nil
+		}
+
+		// The node may hold the transaction, so the nonce is kept to not be allocated twice
+		if sendErr, ok := err.(*maybeSentError); ok {
+			return nil, sendErr.err
+		}
+
+		// The transaction has not been accepted, so the nonce is retrieved from the chain on the next allocation
+		if resetErr := i.nonces.Reset(ctx, key); resetErr != nil || retried || !isNonceError(err) {
+			return nil, err
+		}
+
+		i.logger.Warn("nonce rejected by the node, resyncing from the chain", "from_account", from, "nonce", n)
+	}
+}
+
+func (i *Interceptor) fetchNonce(sess proxynode.Session, from ethcommon.Address) nonce.FetchFunc {
+	return func(ctx context.Context) (uint64, error) {
+		n, err := sess.EthCaller().Eth().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber)
+		if err != nil {
+			i.logger.WithError(err).Error("failed to fetch nonce", "from_account", from)
+			return 0, errors.BlockchainNodeError(err.Error())
+		}
+
+		return n, nil
+	}
+}
+
+// eeaNonceScope identifies the private nonces of an account in a privacy group
+func eeaNonceScope(privacyGroupID, privateFrom *string, privateFor *[]string) string {
+	if privacyGroupID != nil {
+		return "pg:" + *privacyGroupID
+	}
+
+	parties := []string{}
+	if privateFor != nil {
+		parties = append(parties, *privateFor...)
+	}
+	sort.Strings(parties)
+
+	var from string
+	if privateFrom != nil {
+		from = *privateFrom
+	}
+
+	hash := sha256.Sum256([]byte(from + "|" + strings.Join(parties, ",")))
+	return "eea:" + hex.EncodeToString(hash[:])
+}
+
+// maybeSentError is an error sending a transaction to a node which has not responded, so the node may have received it
+type maybeSentError struct {
+	err error
+}
+
+func (e *maybeSentError) Error() string {
+	return e.err.Error()
+}
+
+// sendTxError returns the error of a node failing to receive a transaction.
+// Only errors responded by the node guarantee that the transaction has not been accepted
+func sendTxError(err error) error {
+	nodeErr := errors.BlockchainNodeError(err.Error())
+	if _, ok := err.(*jsonrpc.ErrorMsg); ok || isNonceError(err) {
+		return nodeErr
+	}
+
+	return &maybeSentError{err: nodeErr}
+}
+
+func unwrapSendTxError(hash *ethcommon.Hash, err error) (*ethcommon.Hash, error) {
+	if sendErr, ok := err.(*maybeSentError); ok {
+		return nil, sendErr.err
+	}
+
+	return hash, err
+}
+
+// knownTxHash returns the hash of a raw transaction if the node rejected it because it has already received it
+func knownTxHash(err error, raw []byte) (*ethcommon.Hash, bool) {
+	if err == nil || !containsError(err, knownTxErrors) {
+		return nil, false
+	}
+
+	hash := crypto.Keccak256Hash(raw)
+	return &hash, true
+}
+
+func isNonceError(err error) bool {
+	return containsError(err, nonceErrors)
+}
+
+func containsError(err error, errs []string) bool {
+	msg := strings.ToLower(err.Error())
+	for _, e := range errs {
+		if strings.Contains(msg, e) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,174 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	mockstores "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSendWithNonces(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := proxynode.NewMockSession(ctrl)
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	accountsStore := mockstores.NewMockEthStore(ctrl)
+	stores := mockstores.NewMockStores(ctrl)
+
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	userInfo := &types.UserInfo{
+		Username:    "username",
+		Permissions: []types.Permission{"sign:eth1Account"},
+	}
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+	gasPrice := big.NewInt(38)
+	gas := uint64(21000)
+	chainID := big.NewInt(1)
+	expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+	stores.EXPECT().GetEthStoreByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil).AnyTimes()
+	ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).AnyTimes()
+
+	// The signature holds the nonce of the transaction
+	accountsStore.EXPECT().SignTransaction(ctx, from, chainID, gomock.Any()).DoAndReturn(
+		func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *ethtypes.Transaction) ([]byte, error) {
+			return []byte(fmt.Sprintf("nonce-%d", tx.Nonce())), nil
+		},
+	).AnyTimes()
+
+	newMsg := func() *ethereum.SendTxMsg {
+		return &ethereum.SendTxMsg{From: from, GasPrice: gasPrice, Gas: &gas}
+	}
+
+	t.Run("should allocate consecutive nonces fetching the nonce once", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-consecutive", nonce.NewMemoryStore())
+
+		ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(4), nil).Times(1)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-4")).Return(expectedHash, nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-5")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+		_, err = i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+	})
+
+	t.Run("should resync the nonce from the chain if the node rejects it", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-resync", nonce.NewMemoryStore())
+
+		gomock.InOrder(
+			ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(2), nil),
+			ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(7), nil),
+		)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-2")).Return(ethcommon.Hash{}, fmt.Errorf("nonce too low"))
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-7")).Return(expectedHash, nil)
+
+		hash, err := i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+		assert.Equal(t, expectedHash.Hex(), hash.Hex())
+	})
+
+	t.Run("should release the nonce and not retry if the node rejects the transaction", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-error", nonce.NewMemoryStore())
+
+		ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(1), nil).Times(2)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-1")).Return(ethcommon.Hash{}, &jsonrpc.ErrorMsg{Code: -32000, Message: "insufficient funds for gas * price + value"})
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-1")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, newMsg())
+		require.Error(t, err)
+
+		_, err = i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+	})
+
+	t.Run("should release the nonce if the transaction cannot be signed", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-sign-error", nonce.NewMemoryStore())
+
+		signer := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+		msg := newMsg()
+		msg.From = signer
+		ethCaller.EXPECT().GetTransactionCount(ctx, signer, ethereum.PendingBlockNumber).Return(uint64(3), nil).Times(2)
+		gomock.InOrder(
+			stores.EXPECT().GetEthStoreByAddr(gomock.Any(), signer, userInfo).Return(nil, errors.TooManyRequestsError(time.Second, "rate limit exceeded")),
+			stores.EXPECT().GetEthStoreByAddr(gomock.Any(), signer, userInfo).Return(accountsStore, nil),
+		)
+		accountsStore.EXPECT().SignTransaction(ctx, signer, chainID, gomock.Any()).Return([]byte("signer-nonce-3"), nil)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("signer-nonce-3")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, msg)
+		require.Error(t, err)
+
+		msg = newMsg()
+		msg.From = signer
+		_, err = i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+	})
+
+	t.Run("should keep the nonce if the node may have received the transaction", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-timeout", nonce.NewMemoryStore())
+
+		ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(1), nil).Times(1)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-1")).Return(ethcommon.Hash{}, fmt.Errorf("context deadline exceeded"))
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-2")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, newMsg())
+		require.Error(t, err)
+
+		_, err = i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+	})
+
+	t.Run("should return the hash of the transaction if the node already knows it", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-known", nonce.NewMemoryStore())
+
+		ethCaller.EXPECT().GetTransactionCount(ctx, from, ethereum.PendingBlockNumber).Return(uint64(3), nil).Times(1)
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-3")).Return(ethcommon.Hash{}, fmt.Errorf("already known"))
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-4")).Return(ethcommon.Hash{}, fmt.Errorf("known transaction: 0x1234"))
+
+		hash, err := i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+		assert.Equal(t, crypto.Keccak256Hash([]byte("nonce-3")).Hex(), hash.Hex())
+
+		hash, err = i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+		assert.Equal(t, crypto.Keccak256Hash([]byte("nonce-4")).Hex(), hash.Hex())
+	})
+
+	t.Run("should not allocate a nonce if set", func(t *testing.T) {
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithNonces("node-set", nonce.NewMemoryStore())
+
+		msg := newMsg()
+		n := uint64(12)
+		msg.Nonce = &n
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("nonce-12")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, msg)
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,68 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// personalSign signs an EIP-191 message, the optional password of the account is ignored
+func (i *Interceptor) personalSign(ctx context.Context, data hexutil.Bytes, from ethcommon.Address) (*hexutil.Bytes, error) {
+	logger := i.logger.With("from_account", from.Hex())
+	logger.Debug("signing message")
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	store, err := i.getEthStoreByAddr(ctx, from, userInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := store.SignMessage(ctx, from, data)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("message signed successfully")
+	return (*hexutil.Bytes)(&sig), nil
+}
+
+// personalECRecover returns the account which signed an EIP-191 message
+func (i *Interceptor) personalECRecover(_ context.Context, data, sig hexutil.Bytes) (*ethcommon.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		errMessage := "signature must be exactly 65 bytes"
+		i.logger.Error(errMessage, "signature_length", len(sig))
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	// Signatures are returned with a recovery ID of 27 or 28
+	recoverySig := make([]byte, crypto.SignatureLength)
+	copy(recoverySig, sig)
+	if recoverySig[crypto.RecoveryIDOffset] >= 27 {
+		recoverySig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	pubKey, err := crypto.SigToPub(crypto.Keccak256(ethereum.GetEIP191EncodedData(data)), recoverySig)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to recover signer")
+		return nil, errors.InvalidParameterError(err.Error())
+	}
+
+	addr := crypto.PubkeyToAddress(*pubKey)
+	return &addr, nil
+}
+
+func (i *Interceptor) PersonalSign() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.personalSign)
+	return h
+}
+
+func (i *Interceptor) PersonalECRecover() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.personalECRecover)
+	return h
+}
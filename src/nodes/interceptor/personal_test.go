@@ -0,0 +1,86 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	mockaccounts "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func quote(s string) string {
+	b, _ := json.Marshal(s)
+	return string(b)
+}
+
+func TestPersonalSign(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	userInfo := &types.UserInfo{
+		Username:    "username",
+		Permissions: []types.Permission{"sign:ethereum"},
+	}
+
+	session := proxynode.NewMockSession(ctrl)
+	i, stores := newInterceptor(ctrl)
+	accountsStore := mockaccounts.NewMockEthStore(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+
+	privKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	signer := crypto.PubkeyToAddress(privKey.PublicKey)
+	data := []byte("hello")
+	sig, err := crypto.Sign(crypto.Keccak256(ethereum.GetEIP191EncodedData(data)), privKey)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "personal_sign",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				expectedFrom := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+				stores.EXPECT().GetEthStoreByAddr(gomock.Any(), expectedFrom, userInfo).Return(accountsStore, nil)
+				accountsStore.EXPECT().SignMessage(gomock.Any(), expectedFrom, ethcommon.FromHex("0x2eadbe1f")).Return(ethcommon.FromHex("0xa6122e27"), nil)
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_sign","params":["0x2eadbe1f", "0x78e6e236592597c09d5c137c2af40aecd42d12a2", "password"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xa6122e27","error":null,"id":null}`),
+		},
+		{
+			desc:             "personal_ecRecover",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"personal_ecRecover","params":[%q, %q]}`, hexutil.Encode(data), hexutil.Encode(sig))),
+			expectedRespBody: []byte(fmt.Sprintf(`{"jsonrpc":"2.0","result":%q,"error":null,"id":null}`, strings.ToLower(signer.Hex()))),
+		},
+		{
+			desc:             "personal_ecRecover with invalid signature",
+			handler:          i.handler,
+			ctx:              ctx,
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"personal_ecRecover","params":["0x2eadbe1f", "0xa6122e27"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32603,"message":"Internal error","data":{"message":"IR500: signature must be exactly 65 bytes"}},"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/pkg/tessera"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// privDistributeRawTransaction distributes a signed EEA transaction to its recipients through the private transaction manager
+// of the node and returns the key of the payload, as Besu does with its enclave
+func (i *Interceptor) privDistributeRawTransaction(ctx context.Context, raw hexutil.Bytes) (hexutil.Bytes, error) {
+	i.logger.Debug("distributing raw private transaction")
+
+	args, err := ethereum.ParseEEAPrivateArgs(raw)
+	if err != nil {
+		errMessage := "invalid EEA transaction"
+		i.logger.WithError(err).Error(errMessage)
+		return nil, jsonrpc.InvalidParamsError(errors.InvalidParameterError(errMessage))
+	}
+
+	req := &tessera.SendRequest{
+		Payload: raw,
+		From:    *args.PrivateFrom,
+	}
+	if args.PrivacyGroupID != nil {
+		req.PrivacyGroupID = *args.PrivacyGroupID
+	} else {
+		req.To = *args.PrivateFor
+	}
+
+	key, err := proxynode.SessionFromContext(ctx).ClientPrivTxManager().Send(ctx, req)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to distribute private transaction", "private_from", req.From)
+		return nil, errors.BlockchainNodeError(err.Error())
+	}
+
+	i.logger.Info("private transaction distributed successfully")
+	return key, nil
+}
+
+// PrivDistributeRawTransaction forwards the request to the node when no private transaction manager is configured on the node
+func (i *Interceptor) PrivDistributeRawTransaction() jsonrpc.Handler {
+	h, _ := jsonrpc.MakeHandler(i.privDistributeRawTransaction)
+	return jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		if _, ok := proxynode.SessionFromContext(msg.Context()).ClientPrivTxManager().(*tessera.NotConfiguredClient); ok {
+			proxynode.ProxyHandler.ServeRPC(rw, msg)
+			return
+		}
+
+		h.ServeRPC(rw, msg)
+	})
+}
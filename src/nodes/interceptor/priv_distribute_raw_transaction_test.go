@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	mockjsonrpc "github.com/consensys/quorum-key-manager/pkg/jsonrpc/mock"
+	"github.com/consensys/quorum-key-manager/pkg/tessera"
+	mocktessera "github.com/consensys/quorum-key-manager/pkg/tessera/mock"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrivDistributeRawTransaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	i, _ := newInterceptor(ctrl)
+	session := proxynode.NewMockSession(ctrl)
+	ctx := proxynode.WithSession(context.TODO(), session)
+
+	to := ethcommon.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	raw, err := rlp.EncodeToBytes([]interface{}{
+		uint64(1), big.NewInt(0), uint64(21000), &to, big.NewInt(0), []byte{0xab},
+		big.NewInt(2710), big.NewInt(1), big.NewInt(2),
+		[]byte{0x01, 0x02}, [][]byte{{0x03}}, "restricted",
+	})
+	require.NoError(t, err)
+	reqBody := []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"priv_distributeRawTransaction","params":[%q]}`, hexutil.Encode(raw)))
+
+	tests := []*testHandlerCase{
+		{
+			desc:    "should distribute the transaction through the private transaction manager",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				ptm := mocktessera.NewMockClient(ctrl)
+				session.EXPECT().ClientPrivTxManager().Return(ptm).Times(2)
+				ptm.EXPECT().Send(gomock.Any(), &tessera.SendRequest{
+					Payload: raw,
+					From:    "AQI=",
+					To:      []string{"Aw=="},
+				}).Return([]byte{0xca, 0xfe}, nil)
+			},
+			reqBody:          reqBody,
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xcafe","error":null,"id":null}`),
+		},
+		{
+			desc:    "should reject invalid transactions",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				session.EXPECT().ClientPrivTxManager().Return(mocktessera.NewMockClient(ctrl))
+			},
+			reqBody:          []byte(`{"jsonrpc":"2.0","method":"priv_distributeRawTransaction","params":["0xc0"]}`),
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":null,"error":{"code":-32602,"message":"Invalid params","data":{"message":"IR500: invalid EEA transaction"}},"id":null}`),
+		},
+		{
+			desc:    "should forward to the node without private transaction manager",
+			handler: i.handler,
+			ctx:     ctx,
+			prepare: func() {
+				rpcClient := mockjsonrpc.NewMockClient(ctrl)
+				session.EXPECT().ClientPrivTxManager().Return(&tessera.NotConfiguredClient{})
+				session.EXPECT().ClientRPC().Return(rpcClient)
+				rpcClient.EXPECT().Do(gomock.Any()).Return(new(jsonrpc.ResponseMsg).WithVersion("2.0").WithResult("0xbeef"), nil)
+			},
+			reqBody:          reqBody,
+			expectedRespBody: []byte(`{"jsonrpc":"2.0","result":"0xbeef","error":null,"id":null}`),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			assertHandlerScenario(t, tt)
+		})
+	}
+}
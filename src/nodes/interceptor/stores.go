@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// getEthStoreByAddr returns the store of the account among the Ethereum stores allowed on the node
+func (i *Interceptor) getEthStoreByAddr(ctx context.Context, addr ethcommon.Address, userInfo *authtypes.UserInfo) (stores.EthStore, error) {
+	if len(i.ethStores) == 0 {
+		return i.stores.GetEthStoreByAddr(ctx, addr, userInfo)
+	}
+
+	for _, storeName := range i.ethStores {
+		store, err := i.stores.GetEthStore(ctx, storeName, userInfo)
+		if err != nil {
+			// Stores not accessible by the user are ignored
+			if errors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if _, err = store.Get(ctx, addr); err == nil {
+			return store, nil
+		}
+	}
+
+	errMessage := "account was not found"
+	i.logger.Error(errMessage, "account", addr.Hex())
+	return nil, errors.InvalidParameterError(errMessage)
+}
+
+// listAccounts lists the accounts of the Ethereum stores allowed on the node
+func (i *Interceptor) listAccounts(ctx context.Context, userInfo *authtypes.UserInfo) ([]ethcommon.Address, error) {
+	if len(i.ethStores) == 0 {
+		return i.stores.ListAllAccounts(ctx, userInfo)
+	}
+
+	var accs []ethcommon.Address
+	for _, storeName := range i.ethStores {
+		store, err := i.stores.GetEthStore(ctx, storeName, userInfo)
+		if err != nil {
+			// Stores not accessible by the user are ignored
+			if errors.IsNotFoundError(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		storeAccs, err := store.List(ctx, 0, 0)
+		if err != nil {
+			return nil, err
+		}
+		accs = append(accs, storeAccs...)
+	}
+
+	return accs, nil
+}
@@ -0,0 +1,44 @@
+package interceptor
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// WithTransactions records the transactions sent through the node in the given store
+func (i *Interceptor) WithTransactions(node string, txs transactions.Store) *Interceptor {
+	i.node = node
+	i.txs = txs
+	return i
+}
+
+// trackTransaction records a transaction sent to the node, failing to record it does not fail the request as the transaction is already sent
+func (i *Interceptor) trackTransaction(ctx context.Context, kind transactions.Kind, from ethcommon.Address, to *ethcommon.Address, nonce uint64, raw []byte, hash ethcommon.Hash) {
+	if i.txs == nil {
+		return
+	}
+
+	tx := &transactions.Transaction{
+		Hash:   hash,
+		Node:   i.node,
+		Kind:   kind,
+		From:   from,
+		To:     to,
+		Nonce:  nonce,
+		Raw:    raw,
+		Status: transactions.StatusPending,
+	}
+
+	if userInfo := authenticator.UserInfoContextFromContext(ctx); userInfo != nil {
+		tx.Tenant = userInfo.Tenant
+		tx.Username = userInfo.Username
+	}
+
+	err := i.txs.Add(ctx, tx)
+	if err != nil {
+		i.logger.WithError(err).Error("failed to record transaction", "tx_hash", hash)
+	}
+}
@@ -0,0 +1,94 @@
+package interceptor
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
+	mockstores "github.com/consensys/quorum-key-manager/src/stores/mock"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTrackTransactions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	session := proxynode.NewMockSession(ctrl)
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	accountsStore := mockstores.NewMockEthStore(ctrl)
+	stores := mockstores.NewMockStores(ctrl)
+
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	to := ethcommon.HexToAddress("0xd46e8dd67c5d32be8058bb8eb970870f07244567")
+	userInfo := &types.UserInfo{
+		Tenant:      "tenantOne",
+		Username:    "username",
+		Permissions: []types.Permission{"sign:eth1Account"},
+	}
+	ctx := proxynode.WithSession(context.TODO(), session)
+	ctx = authenticator.WithUserContext(ctx, &authenticator.UserContext{
+		UserInfo: userInfo,
+	})
+	gasPrice := big.NewInt(38)
+	gas := uint64(21000)
+	nonce := uint64(3)
+	chainID := big.NewInt(1)
+	expectedHash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+	session.EXPECT().EthCaller().Return(caller).AnyTimes()
+	stores.EXPECT().GetEthStoreByAddr(gomock.Any(), from, userInfo).Return(accountsStore, nil).AnyTimes()
+	ethCaller.EXPECT().ChainID(gomock.Any()).Return(chainID, nil).AnyTimes()
+	accountsStore.EXPECT().SignTransaction(ctx, from, chainID, gomock.Any()).Return([]byte("raw"), nil).AnyTimes()
+
+	newMsg := func() *ethereum.SendTxMsg {
+		return &ethereum.SendTxMsg{From: from, To: &to, GasPrice: gasPrice, Gas: &gas, Nonce: &nonce}
+	}
+
+	t.Run("should record the transaction sent with the caller identity", func(t *testing.T) {
+		txs := transactions.NewMemoryStore()
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithTransactions("node-track", txs)
+
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("raw")).Return(expectedHash, nil)
+
+		_, err := i.ethSendTransaction(ctx, newMsg())
+		require.NoError(t, err)
+
+		tx, err := txs.Get(ctx, "node-track", expectedHash)
+		require.NoError(t, err)
+		assert.Equal(t, transactions.KindPublic, tx.Kind)
+		assert.Equal(t, transactions.StatusPending, tx.Status)
+		assert.Equal(t, from, tx.From)
+		assert.Equal(t, &to, tx.To)
+		assert.Equal(t, nonce, tx.Nonce)
+		assert.Equal(t, []byte("raw"), tx.Raw)
+		assert.Equal(t, "tenantOne", tx.Tenant)
+		assert.Equal(t, "username", tx.Username)
+	})
+
+	t.Run("should not record a transaction rejected by the node", func(t *testing.T) {
+		txs := transactions.NewMemoryStore()
+		i := New(stores, new(proxynode.Config).SetDefault(), testutils.NewMockLogger(ctrl)).WithTransactions("node-rejected", txs)
+
+		ethCaller.EXPECT().SendRawTransaction(ctx, []byte("raw")).Return(ethcommon.Hash{}, fmt.Errorf("insufficient funds"))
+
+		_, err := i.ethSendTransaction(ctx, newMsg())
+		require.Error(t, err)
+
+		list, err := txs.List(ctx, &transactions.Filter{})
+		require.NoError(t, err)
+		assert.Empty(t, list)
+	})
+}
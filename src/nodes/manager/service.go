@@ -6,6 +6,7 @@ import (
 	"sort"
 	"sync"
 
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
 
@@ -13,11 +14,14 @@ import (
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 
 	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	manifest "github.com/consensys/quorum-key-manager/src/manifests/entities"
 	manifestsmanager "github.com/consensys/quorum-key-manager/src/manifests/manager"
 	"github.com/consensys/quorum-key-manager/src/nodes/interceptor"
 	"github.com/consensys/quorum-key-manager/src/nodes/node"
 	proxynode "github.com/consensys/quorum-key-manager/src/nodes/node/proxy"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	"github.com/consensys/quorum-key-manager/src/stores"
 )
 
@@ -29,6 +33,9 @@ type BaseManager struct {
 	stores      stores.Manager
 	manifests   manifestsmanager.Manager
 	authManager auth.Manager
+	nonces      nonce.Store
+	txs         transactions.Store
+	aliases     aliasent.Connector
 
 	mux   sync.RWMutex
 	nodes map[string]*nodeBundle
@@ -44,15 +51,20 @@ type BaseManager struct {
 
 type nodeBundle struct {
 	manifest *manifest.Manifest
+	cfg      *proxynode.Config
 	node     node.Node
+	caller   ethereum.Caller
 	err      error
 	stop     func(context.Context) error
 }
 
-func New(smng stores.Manager, manifests manifestsmanager.Manager, authManager auth.Manager, logger log.Logger) *BaseManager {
+func New(smng stores.Manager, manifests manifestsmanager.Manager, authManager auth.Manager, nonces nonce.Store, txs transactions.Store, aliases aliasent.Connector, logger log.Logger) *BaseManager {
 	return &BaseManager{
 		stores:      smng,
 		manifests:   manifests,
+		nonces:      nonces,
+		txs:         txs,
+		aliases:     aliases,
 		mnfsts:      make(chan []manifestsmanager.Message),
 		mux:         sync.RWMutex{},
 		nodes:       make(map[string]*nodeBundle),
@@ -140,12 +152,35 @@ func (m *BaseManager) Node(_ context.Context, name string, userInfo *authtypes.U
 			return nil, err
 		}
 
+		if nodeBundle.cfg != nil {
+			err = m.checkRoles(userInfo, nodeBundle.cfg.AllowedRoles)
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return nodeBundle.node, nodeBundle.err
 	}
 
 	return nil, errors.NotFoundError("node not found")
 }
 
+// Caller returns a caller to a node for internal use, regardless of user permissions
+func (m *BaseManager) Caller(name string) (ethereum.Caller, error) {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	nodeBundle, ok := m.nodes[name]
+	if !ok {
+		return nil, errors.NotFoundError("node not found")
+	}
+	if nodeBundle.err != nil {
+		return nil, nodeBundle.err
+	}
+
+	return nodeBundle.caller, nil
+}
+
 func (m *BaseManager) List(_ context.Context, userInfo *authtypes.UserInfo) ([]string, error) {
 	m.mux.RLock()
 	defer m.mux.RUnlock()
@@ -192,6 +227,7 @@ func (m *BaseManager) load(ctx context.Context, mnf *manifest.Manifest) error {
 			return n.err
 		}
 		cfg.SetDefault()
+		n.cfg = cfg
 
 		// Create proxy node
 		prxNode, err := proxynode.New(cfg, m.logger)
@@ -202,7 +238,10 @@ func (m *BaseManager) load(ctx context.Context, mnf *manifest.Manifest) error {
 		}
 
 		// Set interceptor on proxy node
-		prxNode.Handler = interceptor.New(m.stores.Stores(), m.logger)
+		prxNode.Handler = interceptor.New(m.stores.Stores(), cfg, m.logger).
+			WithNonces(mnf.Name, m.nonces).
+			WithTransactions(mnf.Name, m.txs).
+			WithAliases(m.aliases)
 
 		// Start node
 		err = prxNode.Start(ctx)
@@ -212,6 +251,7 @@ func (m *BaseManager) load(ctx context.Context, mnf *manifest.Manifest) error {
 			return err
 		}
 		n.node = prxNode
+		n.caller = prxNode.Caller()
 		n.stop = prxNode.Stop
 	default:
 		errMessage := "invalid manifest kind"
@@ -223,6 +263,24 @@ func (m *BaseManager) load(ctx context.Context, mnf *manifest.Manifest) error {
 	return nil
 }
 
+func (m *BaseManager) checkRoles(userInfo *authtypes.UserInfo, allowedRoles []string) error {
+	if len(allowedRoles) == 0 {
+		return nil
+	}
+
+	for _, role := range userInfo.Roles {
+		for _, allowedRole := range allowedRoles {
+			if role == allowedRole {
+				return nil
+			}
+		}
+	}
+
+	errMessage := "user is not allowed to use this node"
+	m.logger.With("roles", userInfo.Roles, "allowed_roles", allowedRoles).Error(errMessage)
+	return errors.ForbiddenError(errMessage)
+}
+
 func (m *BaseManager) ID() string { return NodeManagerID }
 func (m *BaseManager) CheckLiveness(_ context.Context) error {
 	if m.isLive {
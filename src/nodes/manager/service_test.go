@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth/mock"
 	storesmock "github.com/consensys/quorum-key-manager/src/stores/mock"
 
@@ -16,6 +17,8 @@ import (
 	"github.com/stretchr/testify/require"
 
 	manifest "github.com/consensys/quorum-key-manager/src/manifests/entities"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 )
 
 var manifestWithTessera = &manifest.Manifest{
@@ -99,6 +102,24 @@ var manifestWithTenant = &manifest.Manifest{
 `),
 }
 
+var manifestWithRoles = &manifest.Manifest{
+	Kind:    "Node",
+	Version: "v2alpha",
+	Name:    "node-test4",
+	Specs: json.RawMessage(`
+{
+	"rpc": {
+		"addr": "www.test-rpc.com"
+	},
+	"allowedRoles": ["operator"],
+	"methods": {
+		"deny": ["admin_*", "debug_*", "miner_*"]
+	},
+	"ethStores": ["eth-accounts"]
+}
+`),
+}
+
 func TestManager(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
@@ -110,7 +131,7 @@ func TestManager(t *testing.T) {
 	mockAuthManager.EXPECT().UserPermissions(gomock.Any()).Return(types.ListPermissions()).AnyTimes()
 	mockStoresManager.EXPECT().Stores().Return(mockStores).AnyTimes()
 
-	mngr := New(mockStoresManager, nil, mockAuthManager, testutils.NewMockLogger(ctrl))
+	mngr := New(mockStoresManager, nil, mockAuthManager, nonce.NewMemoryStore(), transactions.NewMemoryStore(), nil, testutils.NewMockLogger(ctrl))
 
 	err := mngr.load(context.Background(), manifestWithTessera)
 	require.NoError(t, err, "Load must not error")
@@ -132,4 +153,14 @@ func TestManager(t *testing.T) {
 	l, err = mngr.List(context.Background(), &types.UserInfo{Tenant: "tenantOne"})
 	require.NoError(t, err, "List must not error")
 	require.Contains(t, l, "node-test3")
+
+	err = mngr.load(context.Background(), manifestWithRoles)
+	require.NoError(t, err, "Load must not error")
+
+	n, err = mngr.Node(context.Background(), "node-test4", &types.UserInfo{Roles: []string{"operator"}})
+	require.NoError(t, err, "Node must not error for allowed role")
+	require.NotNil(t, n, "Node must not be nil")
+
+	_, err = mngr.Node(context.Background(), "node-test4", &types.UserInfo{Roles: []string{"guest"}})
+	require.True(t, errors.IsForbiddenError(err), "Node must fail with forbidden error for not allowed role")
 }
@@ -0,0 +1,132 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+)
+
+const ctxForwarderKey ctxKeyType = "forwarder"
+
+// forwarder collects the requests of a batch to be forwarded downstream so they are sent in a single batch
+type forwarder struct {
+	mux  sync.Mutex
+	rws  []jsonrpc.ResponseWriter
+	msgs []*jsonrpc.RequestMsg
+}
+
+func forwarderFromContext(ctx context.Context) *forwarder {
+	f, ok := ctx.Value(ctxForwarderKey).(*forwarder)
+	if !ok {
+		return nil
+	}
+
+	return f
+}
+
+func withForwarder(ctx context.Context, f *forwarder) context.Context {
+	return context.WithValue(ctx, ctxForwarderKey, f)
+}
+
+func (f *forwarder) forward(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+	f.mux.Lock()
+	defer f.mux.Unlock()
+
+	f.rws = append(f.rws, rw)
+	f.msgs = append(f.msgs, msg)
+}
+
+// flush sends the collected requests downstream and writes the responses
+func (f *forwarder) flush(ctx context.Context, client jsonrpc.BatchClient) {
+	if len(f.msgs) == 0 {
+		return
+	}
+
+	resps, err := client.DoBatch(ctx, f.msgs)
+	for i, rw := range f.rws {
+		switch {
+		case err != nil:
+			_ = jsonrpc.WriteError(rw, err)
+		case resps[i] != nil:
+			_ = rw.WriteMsg(resps[i])
+		case f.msgs[i].ID != nil:
+			_ = jsonrpc.WriteError(rw, jsonrpc.InvalidDownstreamResponse(fmt.Errorf("missing response in batch")))
+		}
+	}
+}
+
+// batchResponseWriter holds the response to a request of a batch
+type batchResponseWriter struct {
+	msg *jsonrpc.ResponseMsg
+}
+
+func (rw *batchResponseWriter) WriteMsg(msg *jsonrpc.ResponseMsg) error {
+	rw.msg = msg
+	return nil
+}
+
+// unmarshalBatch unmarshals the raw requests of a batch, rejecting batches larger than the maximum size of the node
+func (n *Node) unmarshalBatch(b []byte) ([]json.RawMessage, error) {
+	raws, err := jsonrpc.UnmarshalBatch(b)
+	if err != nil {
+		return nil, jsonrpc.InvalidRequest(err)
+	}
+
+	if len(raws) > n.batchMaxSize {
+		return nil, jsonrpc.InvalidRequest(fmt.Errorf("batch of %d requests exceeds the maximum size of %d", len(raws), n.batchMaxSize))
+	}
+
+	return raws, nil
+}
+
+// serveBatch serves the requests of a batch and returns the responses in order of the requests
+// Intercepted requests are served concurrently while the others are forwarded downstream in a single batch
+func (n *Node) serveBatch(ctx context.Context, client jsonrpc.Client, batchClient jsonrpc.BatchClient, raws []json.RawMessage) []*jsonrpc.ResponseMsg {
+	rws := make([]*batchResponseWriter, len(raws))
+	msgs := make([]*jsonrpc.RequestMsg, len(raws))
+	fwd := new(forwarder)
+	ctx = withForwarder(ctx, fwd)
+
+	wg := &sync.WaitGroup{}
+	sem := make(chan struct{}, n.batchConcurrency)
+	for i, raw := range raws {
+		rws[i] = new(batchResponseWriter)
+
+		msg := new(jsonrpc.RequestMsg)
+		err := json.Unmarshal(raw, msg)
+		if err != nil {
+			_ = jsonrpc.WriteError(rws[i], jsonrpc.InvalidRequest(err))
+			continue
+		}
+		msgs[i] = msg
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			sess := n.newSession(client, msg)
+			n.handler().ServeRPC(rw, msg.WithContext(WithSession(ctx, sess)))
+		}(rws[i], msg)
+	}
+	wg.Wait()
+
+	fwd.flush(ctx, n.cache.batchClient(batchClient))
+
+	// Notifications do not have any response
+	resps := make([]*jsonrpc.ResponseMsg, 0, len(raws))
+	for i, rw := range rws {
+		if rw.msg == nil || (msgs[i] != nil && msgs[i].ID == nil) {
+			continue
+		}
+		resps = append(resps, rw.msg)
+	}
+
+	return resps
+}
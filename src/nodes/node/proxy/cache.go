@@ -0,0 +1,498 @@
+package proxynode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+// headRefreshInterval is the minimum interval between two requests of the head of the chain
+const headRefreshInterval = time.Second
+
+// blockParams are the positions of the block parameter of the methods defaulting to the latest block when it is omitted
+var blockParams = map[string]int{
+	"eth_getBalance":                          1,
+	"eth_getCode":                             1,
+	"eth_getTransactionCount":                 1,
+	"eth_getStorageAt":                        2,
+	"eth_call":                                1,
+	"eth_estimateGas":                         1,
+	"eth_getBlockByNumber":                    0,
+	"eth_getBlockTransactionCountByNumber":    0,
+	"eth_getUncleCountByBlockNumber":          0,
+	"eth_getTransactionByBlockNumberAndIndex": 0,
+	"eth_getUncleByBlockNumberAndIndex":       0,
+}
+
+// movingBlockTags are the block tags whose block changes over time
+var movingBlockTags = map[string]bool{
+	"latest":    true,
+	"pending":   true,
+	"safe":      true,
+	"finalized": true,
+}
+
+// CacheStats are the statistics of the cache of a node
+type CacheStats struct {
+	// Size is the number of cached responses
+	Size int `json:"size"`
+
+	// Hits is the number of requests served from the cache
+	Hits uint64 `json:"hits"`
+
+	// Misses is the number of cacheable requests forwarded to the node
+	Misses uint64 `json:"misses"`
+
+	// Evictions is the number of responses removed from the cache because it was full or they expired
+	Evictions uint64 `json:"evictions"`
+}
+
+// cache is an LRU cache of the results of idempotent JSON-RPC requests
+type cache struct {
+	lru           *lru.Cache
+	ttls          map[string]time.Duration
+	confirmations uint64
+
+	headMux sync.Mutex
+	head    uint64
+	headAt  time.Time
+
+	hits, misses, evictions uint64
+}
+
+// headFunc returns the number of the head block of the chain
+type headFunc func() (uint64, error)
+
+type cacheEntry struct {
+	result    json.RawMessage
+	expiresAt time.Time
+}
+
+func newCache(cfg *CacheConfig) (*cache, error) {
+	c := &cache{
+		ttls: make(map[string]time.Duration, len(cfg.Methods)),
+	}
+	if cfg.Confirmations != nil {
+		c.confirmations = *cfg.Confirmations
+	}
+
+	for method, ttl := range cfg.Methods {
+		switch {
+		case ttl == nil && cfg.TTL != nil:
+			c.ttls[method] = cfg.TTL.Duration
+		case ttl == nil:
+			c.ttls[method] = DefaultCacheTTL
+		default:
+			c.ttls[method] = ttl.Duration
+		}
+	}
+
+	var err error
+	c.lru, err = lru.NewWithEvict(cfg.Size, func(interface{}, interface{}) {
+		atomic.AddUint64(&c.evictions, 1)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return c, nil
+}
+
+func (c *cache) Stats() *CacheStats {
+	return &CacheStats{
+		Size:      c.lru.Len(),
+		Hits:      atomic.LoadUint64(&c.hits),
+		Misses:    atomic.LoadUint64(&c.misses),
+		Evictions: atomic.LoadUint64(&c.evictions),
+	}
+}
+
+// key returns the cache key of a request, false if the request cannot be cached
+func (c *cache) key(msg *jsonrpc.RequestMsg) (string, bool) {
+	if _, ok := c.ttls[msg.Method]; !ok {
+		return "", false
+	}
+
+	b, err := json.Marshal(msg.Params)
+	if err != nil {
+		return "", false
+	}
+
+	// Params are decoded so equivalent requests share the same key whatever their formatting
+	var params interface{}
+	err = json.Unmarshal(b, &params)
+	if err != nil || !isFinal(msg.Method, params) {
+		return "", false
+	}
+
+	// Omitted params are equivalent to no params
+	if params == nil {
+		params = []interface{}{}
+	}
+
+	b, err = json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+
+	return msg.Method + ":" + string(b), true
+}
+
+func (c *cache) get(key string) (json.RawMessage, bool) {
+	v, ok := c.lru.Get(key)
+	if !ok {
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	entry := v.(*cacheEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		c.lru.Remove(key)
+		atomic.AddUint64(&c.misses, 1)
+		return nil, false
+	}
+
+	atomic.AddUint64(&c.hits, 1)
+	return entry.result, true
+}
+
+// add caches the result of a response if it is final and the blocks it relates to are confirmed
+func (c *cache) add(key string, msg *jsonrpc.RequestMsg, resp *jsonrpc.ResponseMsg, head headFunc) {
+	if resp == nil || resp.Error != nil || resp.Result == nil {
+		return
+	}
+
+	result, err := json.Marshal(resp.Result)
+	if err != nil || !isFinalResult(result) {
+		return
+	}
+
+	if c.confirmations > 0 {
+		blockNumber, ok := blockNumberOf(msg, result)
+		if ok && !c.isConfirmed(blockNumber, head) {
+			return
+		}
+	}
+
+	entry := &cacheEntry{result: result}
+	if ttl := c.ttls[msg.Method]; ttl > 0 {
+		entry.expiresAt = time.Now().Add(ttl)
+	}
+
+	c.lru.Add(key, entry)
+}
+
+// isConfirmed indicates whether a block is at least the number of confirmations below the head of the chain.
+// The head is requested again if the block is not confirmed, at most once per refresh interval
+func (c *cache) isConfirmed(blockNumber uint64, head headFunc) bool {
+	c.headMux.Lock()
+	confirmed := blockNumber+c.confirmations <= c.head
+	refresh := !confirmed && time.Since(c.headAt) >= headRefreshInterval
+	if refresh {
+		c.headAt = time.Now()
+	}
+	c.headMux.Unlock()
+
+	if !refresh {
+		return confirmed
+	}
+
+	h, err := head()
+	if err != nil {
+		return false
+	}
+
+	c.headMux.Lock()
+	defer c.headMux.Unlock()
+	if h > c.head {
+		c.head = h
+	}
+
+	return blockNumber+c.confirmations <= c.head
+}
+
+// client wraps a client so it serves cacheable requests from the cache
+func (c *cache) client(client jsonrpc.Client) jsonrpc.Client {
+	if c == nil {
+		return client
+	}
+
+	return &cachedClient{cache: c, client: client}
+}
+
+// batchClient wraps a batch client so it only forwards the requests of a batch that are not cached
+func (c *cache) batchClient(client jsonrpc.BatchClient) jsonrpc.BatchClient {
+	if c == nil {
+		return client
+	}
+
+	return &cachedBatchClient{cache: c, client: client}
+}
+
+type cachedClient struct {
+	cache  *cache
+	client jsonrpc.Client
+}
+
+func (c *cachedClient) Do(msg *jsonrpc.RequestMsg) (*jsonrpc.ResponseMsg, error) {
+	key, ok := c.cache.key(msg)
+	if !ok {
+		return c.client.Do(msg)
+	}
+
+	if result, ok := c.cache.get(key); ok {
+		return cachedResponse(msg, result)
+	}
+
+	resp, err := c.client.Do(msg)
+	if err == nil {
+		c.cache.add(key, msg, resp, func() (uint64, error) {
+			headResp, err := c.client.Do(headRequest(msg.Context()))
+			if err != nil {
+				return 0, err
+			}
+			return headNumber(headResp)
+		})
+	}
+
+	return resp, err
+}
+
+type cachedBatchClient struct {
+	cache  *cache
+	client jsonrpc.BatchClient
+}
+
+func (c *cachedBatchClient) DoBatch(ctx context.Context, msgs []*jsonrpc.RequestMsg) ([]*jsonrpc.ResponseMsg, error) {
+	resps := make([]*jsonrpc.ResponseMsg, len(msgs))
+	keys := make([]string, len(msgs))
+
+	var fwdIdx []int
+	var fwdMsgs []*jsonrpc.RequestMsg
+	for i, msg := range msgs {
+		key, ok := c.cache.key(msg)
+		if ok {
+			if result, hit := c.cache.get(key); hit {
+				resp, err := cachedResponse(msg, result)
+				if err == nil {
+					resps[i] = resp
+					continue
+				}
+			}
+			keys[i] = key
+		}
+
+		fwdIdx = append(fwdIdx, i)
+		fwdMsgs = append(fwdMsgs, msg)
+	}
+
+	if len(fwdMsgs) == 0 {
+		return resps, nil
+	}
+
+	fwdResps, err := c.client.DoBatch(ctx, fwdMsgs)
+	if err != nil {
+		return nil, err
+	}
+
+	head := func() (uint64, error) {
+		headResps, err := c.client.DoBatch(ctx, []*jsonrpc.RequestMsg{headRequest(ctx)})
+		if err != nil {
+			return 0, err
+		}
+		return headNumber(headResps[0])
+	}
+
+	for j, i := range fwdIdx {
+		resps[i] = fwdResps[j]
+		if keys[i] != "" {
+			c.cache.add(keys[i], msgs[i], fwdResps[j], head)
+		}
+	}
+
+	return resps, nil
+}
+
+func headRequest(ctx context.Context) *jsonrpc.RequestMsg {
+	return new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_blockNumber").WithID("head").WithContext(ctx)
+}
+
+func headNumber(resp *jsonrpc.ResponseMsg) (uint64, error) {
+	if resp == nil {
+		return 0, jsonrpc.InvalidDownstreamResponse(fmt.Errorf("missing head block number"))
+	}
+
+	if resp.Error != nil {
+		return 0, resp.Error
+	}
+
+	var number hexutil.Uint64
+	err := resp.UnmarshalResult(&number)
+	if err != nil {
+		return 0, err
+	}
+
+	return uint64(number), nil
+}
+
+// cachedResponse builds the response to a request from a cached result
+func cachedResponse(msg *jsonrpc.RequestMsg, result json.RawMessage) (*jsonrpc.ResponseMsg, error) {
+	version := msg.Version
+	if version == "" {
+		version = "2.0"
+	}
+
+	// Response is encoded then decoded so its result can be unmarshaled by callers
+	b, err := json.Marshal(&jsonrpc.ResponseMsg{Version: version, ID: msg.ID, Result: result})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(jsonrpc.ResponseMsg)
+	err = json.Unmarshal(b, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// isFinal indicates whether the response to a request does not depend on the latest state of the chain
+func isFinal(method string, params interface{}) bool {
+	if idx, ok := blockParams[method]; ok {
+		args, ok := params.([]interface{})
+		if !ok || len(args) <= idx {
+			return false
+		}
+	}
+
+	if method == "eth_getLogs" {
+		args, ok := params.([]interface{})
+		if !ok || len(args) == 0 {
+			return false
+		}
+
+		filter, ok := args[0].(map[string]interface{})
+		if !ok {
+			return false
+		}
+
+		if _, ok := filter["blockHash"]; !ok {
+			_, hasFrom := filter["fromBlock"]
+			_, hasTo := filter["toBlock"]
+			if !hasFrom || !hasTo {
+				return false
+			}
+		}
+	}
+
+	return !hasMovingBlockTag(params)
+}
+
+func hasMovingBlockTag(v interface{}) bool {
+	switch v := v.(type) {
+	case string:
+		return movingBlockTags[v]
+	case []interface{}:
+		for _, e := range v {
+			if hasMovingBlockTag(e) {
+				return true
+			}
+		}
+	case map[string]interface{}:
+		for _, e := range v {
+			if hasMovingBlockTag(e) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// blockNumberOf returns the highest block number a request or its result relates to, false if none
+func blockNumberOf(msg *jsonrpc.RequestMsg, result json.RawMessage) (uint64, bool) {
+	var params []interface{}
+	_ = msg.UnmarshalParams(&params)
+
+	var values []interface{}
+	if idx, ok := blockParams[msg.Method]; ok && len(params) > idx {
+		values = append(values, params[idx])
+	}
+	if msg.Method == "eth_getLogs" && len(params) > 0 {
+		if filter, ok := params[0].(map[string]interface{}); ok {
+			values = append(values, filter["fromBlock"], filter["toBlock"])
+		}
+	}
+
+	// Transactions, receipts, logs and blocks hold the number of their block
+	var decoded interface{}
+	_ = json.Unmarshal(result, &decoded)
+	items, ok := decoded.([]interface{})
+	if !ok {
+		items = []interface{}{decoded}
+	}
+	for _, item := range items {
+		if fields, ok := item.(map[string]interface{}); ok {
+			values = append(values, fields["blockNumber"], fields["number"])
+		}
+	}
+
+	var highest uint64
+	var found bool
+	for _, v := range values {
+		if n, ok := parseBlockNumber(v); ok && (!found || n > highest) {
+			highest, found = n, true
+		}
+	}
+
+	return highest, found
+}
+
+// parseBlockNumber parses a block number or an EIP-1898 block number object
+func parseBlockNumber(v interface{}) (uint64, bool) {
+	switch v := v.(type) {
+	case string:
+		if v == "earliest" {
+			return 0, true
+		}
+		n, err := hexutil.DecodeUint64(v)
+		return n, err == nil
+	case map[string]interface{}:
+		return parseBlockNumber(v["blockNumber"])
+	}
+
+	return 0, false
+}
+
+// isFinalResult indicates whether a result can be cached, pending transactions and blocks are not
+func isFinalResult(result json.RawMessage) bool {
+	if bytes.Equal(result, []byte("null")) {
+		return false
+	}
+
+	if len(result) == 0 || result[0] != '{' {
+		return true
+	}
+
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(result, &fields); err != nil {
+		return false
+	}
+
+	for _, field := range []string{"blockNumber", "blockHash"} {
+		if v, ok := fields[field]; ok && bytes.Equal(v, []byte("null")) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,318 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	pkgjson "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// cacheServer counts the requests it receives by method, replies with head block 0x100 and to transaction lookups
+// with a pending transaction for hash 0xpending, a transaction of the head block for hash 0xrecent and with null for hash 0xunknown
+type cacheServer struct {
+	mux   sync.Mutex
+	calls map[string]int
+}
+
+func (srv *cacheServer) count(method string) int {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	return srv.calls[method]
+}
+
+func (srv *cacheServer) serveRPC(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+	srv.mux.Lock()
+	srv.calls[msg.Method]++
+	srv.mux.Unlock()
+
+	var params []interface{}
+	_ = msg.UnmarshalParams(&params)
+
+	switch {
+	case msg.Method == "eth_chainId":
+		_ = jsonrpc.WriteResult(rw, "0x539")
+	case msg.Method == "eth_blockNumber":
+		_ = jsonrpc.WriteResult(rw, "0x100")
+	case len(params) > 0 && params[0] == "0xpending":
+		_ = jsonrpc.WriteResult(rw, map[string]interface{}{"hash": "0xpending", "blockNumber": nil})
+	case len(params) > 0 && params[0] == "0xrecent":
+		_ = jsonrpc.WriteResult(rw, map[string]interface{}{"hash": "0xrecent", "blockNumber": "0x100"})
+	case len(params) > 0 && params[0] == "0xunknown":
+		_ = jsonrpc.WriteResult(rw, nil)
+	default:
+		_ = jsonrpc.WriteResult(rw, map[string]interface{}{"params": params, "blockNumber": "0x1"})
+	}
+}
+
+func (srv *cacheServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	handler := jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(srv.serveRPC))
+
+	b, _ := ioutil.ReadAll(req.Body)
+	req.Body.Close()
+
+	var msgs []*jsonrpc.RequestMsg
+	if err := json.Unmarshal(b, &msgs); err != nil {
+		msg := new(jsonrpc.RequestMsg)
+		_ = json.Unmarshal(b, msg)
+		handler.ServeRPC(jsonrpc.NewResponseWriter(rw), msg)
+		return
+	}
+
+	resps := make([]*jsonrpc.ResponseMsg, len(msgs))
+	for i, msg := range msgs {
+		rec := &batchResponseWriter{}
+		handler.ServeRPC(rec, msg)
+		resps[i] = rec.msg
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(resps)
+}
+
+func newCacheTestNode(t *testing.T, ctrl *gomock.Controller, cacheCfg *CacheConfig) (*Node, *cacheServer) {
+	srv := &cacheServer{calls: make(map[string]int)}
+	rpcServer := httptest.NewServer(srv)
+	t.Cleanup(rpcServer.Close)
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+		Cache: cacheCfg,
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	t.Cleanup(func() { _ = n.Stop(context.Background()) })
+
+	return n, srv
+}
+
+func serveNode(n *Node, method, path, body string) *httptest.ResponseRecorder {
+	req, _ := http.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	n.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestNodeCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n, srv := newCacheTestNode(t, ctrl, &CacheConfig{Size: 100})
+
+	call := func(t *testing.T, body string) *jsonrpc.ResponseMsg {
+		rec := serveNode(n, http.MethodPost, "/", body)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		resp := new(jsonrpc.ResponseMsg)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		return resp
+	}
+
+	t.Run("should serve cached responses with the ID of the request", func(t *testing.T) {
+		assertResponse(t, call(t, `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`), "2.0", 1, "0x539")
+		assertResponse(t, call(t, `{"jsonrpc":"2.0","method":"eth_chainId","id":"abc"}`), "2.0", "abc", "0x539")
+		assert.Equal(t, 1, srv.count("eth_chainId"))
+	})
+
+	t.Run("should serve the chain ID of signed transactions from the cache", func(t *testing.T) {
+		chainID, err := n.Caller().Eth().ChainID(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, int64(1337), chainID.Int64())
+		assert.Equal(t, 1, srv.count("eth_chainId"))
+	})
+
+	t.Run("should share the cache between equivalent params", func(t *testing.T) {
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x10",false],"id":1}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":[ "0x10", false ],"id":2}`)
+		assert.Equal(t, 1, srv.count("eth_getBlockByNumber"))
+	})
+
+	t.Run("should not cache requests on moving blocks", func(t *testing.T) {
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":1}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":2}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["pending",false],"id":3}`)
+		assert.Equal(t, 4, srv.count("eth_getBlockByNumber"))
+	})
+
+	t.Run("should not cache methods which are not configured", func(t *testing.T) {
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0xaddress","0x10"],"id":1}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getBalance","params":["0xaddress","0x10"],"id":2}`)
+		assert.Equal(t, 2, srv.count("eth_getBalance"))
+	})
+
+	t.Run("should not cache unknown and pending transactions", func(t *testing.T) {
+		resp := call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xunknown"],"id":1}`)
+		assert.Nil(t, resp.Result)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xunknown"],"id":2}`)
+		assert.Equal(t, 2, srv.count("eth_getTransactionReceipt"))
+
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xpending"],"id":1}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xpending"],"id":2}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xmined"],"id":3}`)
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xmined"],"id":4}`)
+		assert.Equal(t, 3, srv.count("eth_getTransactionByHash"))
+	})
+
+	t.Run("should only forward the requests of a batch which are not cached", func(t *testing.T) {
+		rec := serveNode(n, http.MethodPost, "/", `[
+			{"jsonrpc":"2.0","method":"eth_chainId","id":1},
+			{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xbatch"],"id":2}
+		]`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resps []*jsonrpc.ResponseMsg
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+		require.Len(t, resps, 2)
+		assertResponse(t, resps[0], "2.0", 1, "0x539")
+		assert.Equal(t, 1, srv.count("eth_chainId"))
+
+		call(t, `{"jsonrpc":"2.0","method":"eth_getTransactionReceipt","params":["0xbatch"],"id":3}`)
+		assert.Equal(t, 3, srv.count("eth_getTransactionReceipt"))
+	})
+
+	t.Run("should return the statistics of the cache", func(t *testing.T) {
+		rec := serveNode(n, http.MethodGet, "/cache", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		stats := new(CacheStats)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), stats))
+		assert.Equal(t, &CacheStats{Size: 4, Hits: 6, Misses: 8}, stats)
+	})
+}
+
+func TestNodeCacheExpiration(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n, srv := newCacheTestNode(t, ctrl, &CacheConfig{
+		Size: 1,
+		Methods: map[string]*pkgjson.Duration{
+			"eth_getTransactionReceipt": {Duration: 10 * time.Millisecond},
+			"eth_getTransactionByHash":  nil,
+		},
+	})
+
+	call := func(method, hash string) {
+		rec := serveNode(n, http.MethodPost, "/", `{"jsonrpc":"2.0","method":"`+method+`","params":["`+hash+`"],"id":1}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	t.Run("should expire responses after their TTL", func(t *testing.T) {
+		call("eth_getTransactionReceipt", "0x1")
+		call("eth_getTransactionReceipt", "0x1")
+		assert.Equal(t, 1, srv.count("eth_getTransactionReceipt"))
+
+		time.Sleep(20 * time.Millisecond)
+		call("eth_getTransactionReceipt", "0x1")
+		assert.Equal(t, 2, srv.count("eth_getTransactionReceipt"))
+	})
+
+	t.Run("should evict the least recently used response when full", func(t *testing.T) {
+		call("eth_getTransactionByHash", "0x1")
+		call("eth_getTransactionReceipt", "0x1")
+		assert.Equal(t, 3, srv.count("eth_getTransactionReceipt"))
+
+		stats := n.CacheStats()
+		assert.Equal(t, 1, stats.Size)
+		assert.Equal(t, uint64(2), stats.Evictions)
+	})
+}
+
+func TestNodeCacheConfirmations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	call := func(t *testing.T, n *Node, body string) {
+		rec := serveNode(n, http.MethodPost, "/", body)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+
+	t.Run("should only cache blocks below the head by the number of confirmations", func(t *testing.T) {
+		n, srv := newCacheTestNode(t, ctrl, &CacheConfig{Size: 100})
+
+		for i := 0; i < 2; i++ {
+			call(t, n, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0xf4",false],"id":1}`)
+			call(t, n, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0xf5",false],"id":1}`)
+			call(t, n, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xrecent"],"id":1}`)
+		}
+		assert.Equal(t, 3, srv.count("eth_getBlockByNumber"))
+		assert.Equal(t, 2, srv.count("eth_getTransactionByHash"))
+		assert.Equal(t, 1, srv.count("eth_blockNumber"), "head should not be requested more than once per refresh interval")
+	})
+
+	t.Run("should cache all blocks on chains with immediate finality", func(t *testing.T) {
+		confirmations := uint64(0)
+		n, srv := newCacheTestNode(t, ctrl, &CacheConfig{Size: 100, Confirmations: &confirmations})
+
+		for i := 0; i < 2; i++ {
+			call(t, n, `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["0x100",false],"id":1}`)
+			call(t, n, `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["0xrecent"],"id":1}`)
+		}
+		assert.Equal(t, 1, srv.count("eth_getBlockByNumber"))
+		assert.Equal(t, 1, srv.count("eth_getTransactionByHash"))
+		assert.Zero(t, srv.count("eth_blockNumber"))
+	})
+}
+
+func TestNodeCacheDisabled(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	n, srv := newCacheTestNode(t, ctrl, nil)
+
+	for i := 0; i < 2; i++ {
+		rec := serveNode(n, http.MethodPost, "/", `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+	}
+	assert.Equal(t, 2, srv.count("eth_chainId"))
+
+	rec := serveNode(n, http.MethodGet, "/cache", "")
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Nil(t, n.CacheStats())
+}
+
+func TestIsFinal(t *testing.T) {
+	testCases := []struct {
+		desc   string
+		method string
+		params string
+		final  bool
+	}{
+		{"block number", "eth_getBlockByNumber", `["0x10",true]`, true},
+		{"latest block", "eth_getBlockByNumber", `["latest",true]`, false},
+		{"omitted block", "eth_getBalance", `["0xaddress"]`, false},
+		{"explicit block", "eth_getBalance", `["0xaddress","0x10"]`, true},
+		{"pending block in call", "eth_call", `[{"to":"0xaddress"},"pending"]`, false},
+		{"logs by range", "eth_getLogs", `[{"fromBlock":"0x1","toBlock":"0x10"}]`, true},
+		{"logs up to latest", "eth_getLogs", `[{"fromBlock":"0x1","toBlock":"latest"}]`, false},
+		{"logs without range", "eth_getLogs", `[{"address":"0xaddress"}]`, false},
+		{"logs by block hash", "eth_getLogs", `[{"blockHash":"0xhash"}]`, true},
+		{"no params", "eth_chainId", `null`, true},
+	}
+
+	for _, tt := range testCases {
+		t.Run(tt.desc, func(t *testing.T) {
+			var params interface{}
+			require.NoError(t, json.Unmarshal([]byte(tt.params), &params))
+			assert.Equal(t, tt.final, isFinal(tt.method, params))
+		})
+	}
+}
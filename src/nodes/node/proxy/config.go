@@ -1,6 +1,9 @@
 package proxynode
 
 import (
+	"strings"
+	"time"
+
 	httpclient "github.com/consensys/quorum-key-manager/pkg/http/client"
 	"github.com/consensys/quorum-key-manager/pkg/http/request"
 	"github.com/consensys/quorum-key-manager/pkg/http/response"
@@ -34,11 +37,64 @@ func (cfg *ProxyConfig) SetDefault() *ProxyConfig {
 	return cfg
 }
 
+// Strategies selecting the endpoint serving a request
+const (
+	// FailoverStrategy sends requests to the healthy endpoint with the lowest priority value
+	FailoverStrategy = "failover"
+	// RoundRobinStrategy spreads requests over the healthy endpoints
+	RoundRobinStrategy = "round-robin"
+	// LeastLatencyStrategy sends requests to the healthy endpoint with the lowest latency
+	LeastLatencyStrategy = "least-latency"
+)
+
+const (
+	DefaultHealthCheckInterval = 10 * time.Second
+	DefaultHealthCheckTimeout  = 5 * time.Second
+)
+
+// EndpointConfig is an upstream endpoint of a downstream
+type EndpointConfig struct {
+	Addr string `json:"addr,omitempty"`
+
+	// Priority orders the endpoints with the failover strategy, lowest first
+	Priority int `json:"priority,omitempty"`
+}
+
+// HealthCheckConfig configures the active health checks of the endpoints of a downstream
+type HealthCheckConfig struct {
+	Interval *json.Duration `json:"interval,omitempty"`
+	Timeout  *json.Duration `json:"timeout,omitempty"`
+
+	// MaxBlockLag is the number of blocks an endpoint can lag behind the highest endpoint before being ejected, disabled if 0
+	MaxBlockLag uint64 `json:"maxBlockLag,omitempty"`
+}
+
+func (cfg *HealthCheckConfig) SetDefault() *HealthCheckConfig {
+	if cfg.Interval == nil {
+		cfg.Interval = &json.Duration{Duration: DefaultHealthCheckInterval}
+	}
+
+	if cfg.Timeout == nil {
+		cfg.Timeout = &json.Duration{Duration: DefaultHealthCheckTimeout}
+	}
+
+	return cfg
+}
+
 type DownstreamConfig struct {
 	Addr          string            `json:"addr,omitempty"`
 	Transport     *transport.Config `json:"transport,omitempty"`
 	Proxy         *ProxyConfig      `json:"proxy,omitempty"`
 	ClientTimeout *json.Duration    `json:"clientTimeout,omitempty"`
+
+	// Endpoints are the upstream endpoints sharing the transport and proxy configuration, Addr is used if empty
+	Endpoints []*EndpointConfig `json:"endpoints,omitempty"`
+
+	// Strategy selects the endpoint serving each request, defaults to failover
+	Strategy string `json:"strategy,omitempty"`
+
+	// HealthCheck configures the health checks of the endpoints, only performed with several endpoints
+	HealthCheck *HealthCheckConfig `json:"healthCheck,omitempty"`
 }
 
 func (cfg *DownstreamConfig) SetDefault() *DownstreamConfig {
@@ -79,6 +135,140 @@ func (cfg *DownstreamConfig) SetDefault() *DownstreamConfig {
 	}
 	cfg.Proxy.WebSocket.SetDefault()
 
+	if cfg.Strategy == "" {
+		cfg.Strategy = FailoverStrategy
+	}
+
+	if cfg.HealthCheck == nil {
+		cfg.HealthCheck = new(HealthCheckConfig)
+	}
+	cfg.HealthCheck.SetDefault()
+
+	return cfg
+}
+
+// MethodsConfig restricts the JSON-RPC methods that can be called on a node.
+// A method ending with '*' matches all methods with the same prefix (e.g. 'admin_*').
+// The routes to the private transaction manager are restricted as the methods 'priv_send', 'priv_receive', 'priv_resend',
+// 'priv_getTransaction', 'priv_createPrivacyGroup', 'priv_findPrivacyGroup' and 'priv_retrievePrivacyGroup'
+type MethodsConfig struct {
+	// Allow are the only methods that can be called, all methods are allowed if empty
+	Allow []string `json:"allow,omitempty"`
+
+	// Deny are the methods that cannot be called, even if allowed
+	Deny []string `json:"deny,omitempty"`
+}
+
+// IsAllowed indicates whether a method can be called on the node
+func (cfg *MethodsConfig) IsAllowed(method string) bool {
+	if cfg == nil {
+		return true
+	}
+
+	if matchMethod(method, cfg.Deny) {
+		return false
+	}
+
+	return len(cfg.Allow) == 0 || matchMethod(method, cfg.Allow)
+}
+
+func matchMethod(method string, patterns []string) bool {
+	for _, pattern := range patterns {
+		if strings.HasSuffix(pattern, "*") {
+			if strings.HasPrefix(method, strings.TrimSuffix(pattern, "*")) {
+				return true
+			}
+		} else if method == pattern {
+			return true
+		}
+	}
+
+	return false
+}
+
+const DefaultBatchConcurrency = 10
+
+// DefaultBatchMaxSize is the default maximum number of requests of a batch, the same as the one of geth
+const DefaultBatchMaxSize = 1000
+
+// MaxWebSocketConcurrency is the maximum number of requests of a websocket connection processed concurrently
+const MaxWebSocketConcurrency = 100
+
+// BatchConfig configures the processing of JSON-RPC batch requests
+type BatchConfig struct {
+	// Concurrency is the maximum number of requests of a batch processed concurrently
+	Concurrency int `json:"concurrency,omitempty"`
+
+	// MaxSize is the maximum number of requests of a batch, larger batches are rejected
+	MaxSize int `json:"maxSize,omitempty"`
+}
+
+func (cfg *BatchConfig) SetDefault() *BatchConfig {
+	if cfg.Concurrency <= 0 {
+		cfg.Concurrency = DefaultBatchConcurrency
+	}
+
+	if cfg.MaxSize <= 0 {
+		cfg.MaxSize = DefaultBatchMaxSize
+	}
+
+	return cfg
+}
+
+const DefaultCacheTTL = 10 * time.Minute
+
+// DefaultCacheConfirmations is the default number of blocks a block must be below the head of the chain to be cached
+const DefaultCacheConfirmations = 12
+
+// DefaultCachedMethods are the methods cached when no method is configured
+// Chain identifiers never expire while blocks and transactions expire to account for reorganizations
+var DefaultCachedMethods = map[string]*json.Duration{
+	"eth_chainId":                             {},
+	"net_version":                             {},
+	"eth_getBlockByHash":                      nil,
+	"eth_getBlockByNumber":                    nil,
+	"eth_getBlockTransactionCountByHash":      nil,
+	"eth_getBlockTransactionCountByNumber":    nil,
+	"eth_getTransactionByHash":                nil,
+	"eth_getTransactionByBlockHashAndIndex":   nil,
+	"eth_getTransactionByBlockNumberAndIndex": nil,
+	"eth_getTransactionReceipt":               nil,
+}
+
+// CacheConfig configures the cache of the responses to idempotent JSON-RPC requests
+// Requests on the latest or pending state are never cached
+type CacheConfig struct {
+	// Size is the maximum number of cached responses, the cache is disabled if 0
+	Size int `json:"size,omitempty"`
+
+	// Confirmations is the number of blocks a block must be below the head of the chain for the responses on it to be cached,
+	// so they are not cached until they cannot be reorganized. Only chains with immediate finality (e.g. IBFT, QBFT or Raft) can use 0
+	Confirmations *uint64 `json:"confirmations,omitempty"`
+
+	// TTL is the time to live of the cached responses of methods without a TTL
+	TTL *json.Duration `json:"ttl,omitempty"`
+
+	// Methods are the cached methods with their TTL, responses never expire with a 0 TTL
+	Methods map[string]*json.Duration `json:"methods,omitempty"`
+}
+
+func (cfg *CacheConfig) SetDefault() *CacheConfig {
+	if cfg.Confirmations == nil {
+		confirmations := uint64(DefaultCacheConfirmations)
+		cfg.Confirmations = &confirmations
+	}
+
+	if cfg.TTL == nil {
+		cfg.TTL = &json.Duration{Duration: DefaultCacheTTL}
+	}
+
+	if len(cfg.Methods) == 0 {
+		cfg.Methods = make(map[string]*json.Duration, len(DefaultCachedMethods))
+		for method, ttl := range DefaultCachedMethods {
+			cfg.Methods[method] = ttl
+		}
+	}
+
 	return cfg
 }
 
@@ -86,6 +276,21 @@ func (cfg *DownstreamConfig) SetDefault() *DownstreamConfig {
 type Config struct {
 	RPC           *DownstreamConfig `json:"rpc,omitempty"`
 	PrivTxManager *DownstreamConfig `json:"tessera,omitempty"`
+
+	// AllowedRoles are the roles allowed to use the node, all roles are allowed if empty
+	AllowedRoles []string `json:"allowedRoles,omitempty"`
+
+	// Methods restricts the JSON-RPC methods that can be called on the node
+	Methods *MethodsConfig `json:"methods,omitempty"`
+
+	// EthStores are the Ethereum stores whose accounts can be used to sign through the node, all stores are allowed if empty
+	EthStores []string `json:"ethStores,omitempty"`
+
+	// Batch configures the processing of JSON-RPC batch requests
+	Batch *BatchConfig `json:"batch,omitempty"`
+
+	// Cache configures the cache of the responses of the node, disabled if empty
+	Cache *CacheConfig `json:"cache,omitempty"`
 }
 
 func (cfg *Config) SetDefault() *Config {
@@ -94,7 +299,7 @@ func (cfg *Config) SetDefault() *Config {
 	}
 	cfg.RPC.SetDefault()
 
-	if cfg.RPC.Addr == "" {
+	if cfg.RPC.Addr == "" && len(cfg.RPC.Endpoints) == 0 {
 		cfg.RPC.Addr = "localhost:8545"
 	}
 
@@ -102,5 +307,18 @@ func (cfg *Config) SetDefault() *Config {
 		cfg.PrivTxManager.SetDefault()
 	}
 
+	if cfg.Methods == nil {
+		cfg.Methods = new(MethodsConfig)
+	}
+
+	if cfg.Batch == nil {
+		cfg.Batch = new(BatchConfig)
+	}
+	cfg.Batch.SetDefault()
+
+	if cfg.Cache != nil {
+		cfg.Cache.SetDefault()
+	}
+
 	return cfg
 }
@@ -0,0 +1,100 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	httpclient "github.com/consensys/quorum-key-manager/pkg/http/client"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const tesseraUpcheckPath = "/upcheck"
+
+// CheckConnectivity runs once the health checks of all the endpoints of the node, the defaults of cfg must be set
+func CheckConnectivity(ctx context.Context, cfg *Config, logger log.Logger) error {
+	rpc, err := newhttpDownstream(cfg.RPC, rpcHealthCheck, logger)
+	if err != nil {
+		return err
+	}
+
+	err = rpc.upstreams.checkConnectivity(ctx)
+	if err != nil {
+		return fmt.Errorf("rpc: %v", err)
+	}
+
+	if cfg.PrivTxManager != nil {
+		privTxMngr, err := newhttpDownstream(cfg.PrivTxManager, tesseraHealthCheck, logger)
+		if err != nil {
+			return err
+		}
+
+		err = privTxMngr.upstreams.checkConnectivity(ctx)
+		if err != nil {
+			return fmt.Errorf("tessera: %v", err)
+		}
+	}
+
+	return nil
+}
+
+// rpcHealthCheck checks that a JSON-RPC endpoint is not syncing and returns its block number
+func rpcHealthCheck(ctx context.Context, client httpclient.Client) (uint64, error) {
+	jsonrpcClient := jsonrpc.NewHTTPClient(client)
+
+	resp, err := jsonrpcClient.Do(newHealthCheckMsg(ctx, "eth_syncing"))
+	if err != nil {
+		return 0, err
+	}
+	if err = resp.Err(); err != nil {
+		return 0, err
+	}
+
+	// Result is false if the node is not syncing, an object describing the sync status otherwise
+	var syncing json.RawMessage
+	if err = resp.UnmarshalResult(&syncing); err != nil {
+		return 0, err
+	}
+	if string(syncing) != "false" {
+		return 0, fmt.Errorf("node is syncing")
+	}
+
+	resp, err = jsonrpcClient.Do(newHealthCheckMsg(ctx, "eth_blockNumber"))
+	if err != nil {
+		return 0, err
+	}
+	if err = resp.Err(); err != nil {
+		return 0, err
+	}
+
+	var blockNumber hexutil.Uint64
+	if err = resp.UnmarshalResult(&blockNumber); err != nil {
+		return 0, err
+	}
+
+	return uint64(blockNumber), nil
+}
+
+// tesseraHealthCheck checks the upcheck endpoint of a private transaction manager
+func tesseraHealthCheck(ctx context.Context, client httpclient.Client) (uint64, error) {
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, tesseraUpcheckPath, nil)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("upcheck returned status %d", resp.StatusCode)
+	}
+
+	return 0, nil
+}
+
+func newHealthCheckMsg(ctx context.Context, method string) *jsonrpc.RequestMsg {
+	return new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod(method).WithID("healthcheck").WithParams([]interface{}{}).WithContext(ctx)
+}
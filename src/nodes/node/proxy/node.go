@@ -3,8 +3,11 @@ package proxynode
 import (
 	"context"
 	"encoding/json"
+	"io/ioutil"
 	"net/http"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
@@ -31,19 +34,48 @@ type Node struct {
 
 	wsHandler   *websocket.Proxy
 	httpHandler http.Handler
+
+	batchConcurrency int
+	batchMaxSize     int
+
+	// methods restricts the methods that can be called on the node, including the routes to the private transaction manager
+	methods *MethodsConfig
+
+	// cache holds the responses to idempotent requests, nil if disabled
+	cache *cache
+
+	logger log.Logger
 }
 
 // New creates a Node
 func New(cfg *Config, logger log.Logger) (*Node, error) {
 	n := new(Node)
+	n.logger = logger
+	n.methods = cfg.Methods
+	n.batchConcurrency = DefaultBatchConcurrency
+	if cfg.Batch != nil && cfg.Batch.Concurrency > 0 {
+		n.batchConcurrency = cfg.Batch.Concurrency
+	}
+	n.batchMaxSize = DefaultBatchMaxSize
+	if cfg.Batch != nil && cfg.Batch.MaxSize > 0 {
+		n.batchMaxSize = cfg.Batch.MaxSize
+	}
+
 	var err error
-	n.rpc, err = newhttpDownstream(cfg.RPC)
+	if cfg.Cache != nil && cfg.Cache.Size > 0 {
+		n.cache, err = newCache(cfg.Cache)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	n.rpc, err = newhttpDownstream(cfg.RPC, rpcHealthCheck, logger.With("downstream", "rpc"))
 	if err != nil {
 		return nil, err
 	}
 
 	if cfg.PrivTxManager != nil {
-		n.privTxMngr, err = newhttpDownstream(cfg.PrivTxManager)
+		n.privTxMngr, err = newhttpDownstream(cfg.PrivTxManager, tesseraHealthCheck, logger.With("downstream", "tessera"))
 		if err != nil {
 			return nil, err
 		}
@@ -51,12 +83,15 @@ func New(cfg *Config, logger log.Logger) (*Node, error) {
 
 	// Set HTTP proxy
 	router := gorillamux.NewRouter()
+	n.registerTesseraRoutes(router)
 	router.Methods(http.MethodPost).HandlerFunc(n.serveHTTP)
+	router.Methods(http.MethodGet).Path("/cache").HandlerFunc(n.serveCacheStats)
 	n.httpHandler = router
 
 	// Set websocket proxy
 	websocketProxy := websocket.NewProxy(cfg.RPC.Proxy.WebSocket, logger)
-	websocketProxy.ReqPreparer = n.rpc.reqPreparer
+	// Websocket sessions stick to the endpoint selected when connecting
+	websocketProxy.ReqPreparer = n.rpc.upstreams
 	websocketProxy.RespModifier = n.rpc.respModifier
 	websocketProxy.Interceptor = n.interceptWS
 	websocketProxy.ErrorHandler = n.rpc.errorHandler
@@ -66,11 +101,35 @@ func New(cfg *Config, logger log.Logger) (*Node, error) {
 }
 
 func (n *Node) Start(ctx context.Context) error {
+	err := n.rpc.upstreams.Start(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n.privTxMngr != nil {
+		err = n.privTxMngr.upstreams.Start(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
 	return n.wsHandler.Start(ctx)
 }
 
 func (n *Node) Stop(ctx context.Context) error {
-	return n.wsHandler.Stop(ctx)
+	err := n.wsHandler.Stop(ctx)
+	if err != nil {
+		return err
+	}
+
+	if n.privTxMngr != nil {
+		err = n.privTxMngr.upstreams.Stop(ctx)
+		if err != nil {
+			return err
+		}
+	}
+
+	return n.rpc.upstreams.Stop(ctx)
 }
 
 func (n *Node) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
@@ -88,66 +147,67 @@ func (n *Node) serveHTTP(rw http.ResponseWriter, req *http.Request) {
 	rpcRw := jsonrpc.NewResponseWriter(rw)
 
 	// Parse request body
-	msg := new(jsonrpc.RequestMsg)
-	err := json.NewDecoder(req.Body).Decode(msg)
+	b, err := ioutil.ReadAll(req.Body)
 	req.Body.Close()
 	if err != nil {
 		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
 		return
 	}
 
-	// Attach session to context
 	jsonrpcClient := n.newHTTPJSONRPCClient(req)
+	if jsonrpc.IsBatch(b) {
+		n.serveHTTPBatch(req.Context(), rw, jsonrpcClient, b)
+		return
+	}
+
+	msg := new(jsonrpc.RequestMsg)
+	err = json.Unmarshal(b, msg)
+	if err != nil {
+		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		return
+	}
+
+	// Attach session to context
 	ctx := WithSession(req.Context(), n.newSession(jsonrpcClient, msg))
 
 	// Serve
 	n.handler().ServeRPC(rpcRw, msg.WithContext(ctx))
 }
 
-func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillawebsocket.Conn) (clientErrors, serverErrors <-chan error) {
-	// Create a JSON-RPC client attached to the downstream server connection
-	jsonrpcClient := jsonrpc.NewWebsocketClient(serverConn)
-	_ = jsonrpcClient.Start(ctx)
-
-	clientErrs := make(chan error, 1)
-
-	// Start main loop treating client messages
-	go func() {
-		defer func() { _ = jsonrpcClient.Stop(ctx) }()
-		for {
-			// Read client message
-			typ, b, err := clientConn.ReadMessage()
-			if err != nil {
-				clientErrs <- err
-				close(clientErrs)
-				return
-			}
-
-			// Create ResponseWriter
-			w, err := clientConn.NextWriter(typ)
-			if err != nil {
-				continue
-			}
-			rpcRw := jsonrpc.NewResponseWriter(w)
-
-			// Unmarshal input message
-			msg := new(jsonrpc.RequestMsg)
-			err = json.Unmarshal(b, msg)
-			if err != nil {
-				_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
-				continue
-			}
-
-			// Create and attach session to context then handle message
-			sess := n.newSession(jsonrpcClient, msg)
-			n.handler().ServeRPC(rpcRw, msg.WithContext(WithSession(ctx, sess)))
-
-			// Close writer so message is sent through connection
-			w.Close()
-		}
-	}()
+func (n *Node) serveHTTPBatch(ctx context.Context, rw http.ResponseWriter, jsonrpcClient *jsonrpc.HTTPClient, b []byte) {
+	raws, err := n.unmarshalBatch(b)
+	if err != nil {
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(rw), err)
+		return
+	}
+
+	resps := n.serveBatch(ctx, jsonrpcClient, jsonrpcClient, raws)
+	if len(resps) == 0 {
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(resps)
+}
+
+func (n *Node) serveCacheStats(rw http.ResponseWriter, _ *http.Request) {
+	stats := n.CacheStats()
+	if stats == nil {
+		http2.WriteHTTPErrorResponse(rw, errors.NotFoundError("cache is not enabled on node"))
+		return
+	}
 
-	return clientErrs, jsonrpcClient.Errors()
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(stats)
+}
+
+// CacheStats returns the statistics of the cache of the node, nil if the cache is disabled
+func (n *Node) CacheStats() *CacheStats {
+	if n.cache == nil {
+		return nil
+	}
+
+	return n.cache.Stats()
 }
 
 func (n *Node) handler() jsonrpc.Handler {
@@ -158,6 +218,7 @@ func (n *Node) handler() jsonrpc.Handler {
 }
 
 func (n *Node) newSession(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg) *session {
+	jsonrpcClient = n.cache.client(jsonrpcClient)
 	return &session{
 		jsonrpcClient:    jsonrpcClient,
 		ethCaller:        newEthCaller(jsonrpcClient, msg),
@@ -165,11 +226,10 @@ func (n *Node) newSession(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg)
 	}
 }
 
-func (n *Node) newHTTPJSONRPCClient(req *http.Request) jsonrpc.Client {
+func (n *Node) newHTTPJSONRPCClient(req *http.Request) *jsonrpc.HTTPClient {
 	httpClient := httpclient.CombineDecorators(
 		httpclient.WithModifier(n.rpc.respModifier),
 		httpclient.WithRequest(req),
-		httpclient.WithPreparer(n.rpc.reqPreparer),
 		httpclient.WithPreparer(
 			request.CombinePreparer(
 				request.RemoveConnectionHeaders(),
@@ -180,6 +240,12 @@ func (n *Node) newHTTPJSONRPCClient(req *http.Request) jsonrpc.Client {
 	return jsonrpc.NewHTTPClient(httpClient)
 }
 
+// Caller returns a caller to the downstream JSON-RPC node which is not attached to any client request
+func (n *Node) Caller() ethereum.Caller {
+	jsonrpcClient := n.cache.client(jsonrpc.NewHTTPClient(httpclient.WithModifier(n.rpc.respModifier)(n.rpc.client)))
+	return newEthCaller(jsonrpcClient, new(jsonrpc.RequestMsg).WithVersion("2.0").WithID("qkm"))
+}
+
 func newEthCaller(jsonrpcClient jsonrpc.Client, msg *jsonrpc.RequestMsg) ethereum.Caller {
 	jsonrpcClient = jsonrpc.WithVersion(msg.Version)(jsonrpcClient)
 	jsonrpcClient = jsonrpc.WithIncrementalID(msg.ID)(jsonrpcClient)
@@ -193,7 +259,6 @@ func (n *Node) newPrivTxMngrClient() tessera.Client {
 
 	httpClient := httpclient.CombineDecorators(
 		httpclient.WithModifier(n.privTxMngr.respModifier),
-		httpclient.WithPreparer(n.privTxMngr.reqPreparer),
 		httpclient.WithPreparer(
 			request.CombinePreparer(
 				request.RemoveConnectionHeaders(),
@@ -206,14 +271,14 @@ func (n *Node) newPrivTxMngrClient() tessera.Client {
 
 type httpDownstream struct {
 	transport    http.RoundTripper
-	reqPreparer  request.Preparer
+	upstreams    *upstreams
 	respModifier response.Modifier
 	client       httpclient.Client
 
 	errorHandler proxy.HandleRoundTripErrorFunc
 }
 
-func newhttpDownstream(cfg *DownstreamConfig) (*httpDownstream, error) {
+func newhttpDownstream(cfg *DownstreamConfig, check healthCheckFunc, logger log.Logger) (*httpDownstream, error) {
 	n := new(httpDownstream)
 	var err error
 	n.transport, err = transport.New(cfg.Transport)
@@ -221,25 +286,33 @@ func newhttpDownstream(cfg *DownstreamConfig) (*httpDownstream, error) {
 		return nil, err
 	}
 
-	n.reqPreparer, err = request.Proxy(cfg.Proxy.Request)
-	if err != nil {
-		return nil, err
-	}
-
 	n.respModifier = response.Proxy(cfg.Proxy.Response)
 
 	n.errorHandler = proxy.HandleRoundTripError
 
-	n.client, err = httpclient.New(&httpclient.Config{Timeout: cfg.ClientTimeout}, n.transport)
+	client, err := httpclient.New(&httpclient.Config{Timeout: cfg.ClientTimeout}, n.transport)
+	if err != nil {
+		return nil, err
+	}
+
+	// Requests are sent to the endpoints of the downstream through the upstreams
+	n.upstreams, err = newUpstreams(cfg, client, check, logger)
 	if err != nil {
 		return nil, err
 	}
+	n.client = n.upstreams
 
 	return n, nil
 }
 
 var ProxyHandler = jsonrpc.DefaultRWHandler(
 	jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		// Requests of a batch are forwarded together once the batch has been served
+		if f := forwarderFromContext(msg.Context()); f != nil {
+			f.forward(rw, msg)
+			return
+		}
+
 		// Sen RPC request
 		resp, err := SessionFromContext(msg.Context()).ClientRPC().Do(msg)
 		if err != nil {
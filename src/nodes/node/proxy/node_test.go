@@ -4,9 +4,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -233,3 +235,205 @@ func TestCustomTesseraHandler(t *testing.T) {
 	expectedRespBody := []byte(`{"jsonrpc":"2.0","result":"q80=","error":null,"id":"test-id"}`)
 	assert.Equal(t, expectedRespBody, rec.Body.Bytes()[:(rec.Body.Len()-1)], "WriteMsg should write correct body")
 }
+
+// batchServer replies to single and batch requests with the params of each request
+func batchServer(batches *[]int) http.Handler {
+	handler := jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rpcRw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		_ = jsonrpc.WriteResult(rpcRw, msg.Params)
+	}))
+
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, _ := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+
+		var msgs []*jsonrpc.RequestMsg
+		if err := json.Unmarshal(b, &msgs); err != nil {
+			msg := new(jsonrpc.RequestMsg)
+			_ = json.Unmarshal(b, msg)
+			handler.ServeRPC(jsonrpc.NewResponseWriter(rw), msg)
+			return
+		}
+		*batches = append(*batches, len(msgs))
+
+		resps := make([]*jsonrpc.ResponseMsg, len(msgs))
+		for i := len(msgs) - 1; i >= 0; i-- {
+			rec := &batchResponseWriter{}
+			handler.ServeRPC(rec, msgs[i])
+			resps[len(msgs)-1-i] = rec.msg
+		}
+
+		rw.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(rw).Encode(resps)
+	})
+}
+
+func interceptingHandler() jsonrpc.Handler {
+	router := jsonrpc.NewRouter().DefaultHandler(ProxyHandler)
+	router.Method("intercepted").Handle(jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		_ = jsonrpc.WriteResult(rw, "intercepted")
+	})))
+
+	return router
+}
+
+func TestRPCNodeHTTPBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	var batches []int
+	rpcServer := httptest.NewServer(batchServer(&batches))
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+		Batch: &BatchConfig{Concurrency: 2, MaxSize: 5},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+	n.Handler = interceptingHandler()
+
+	serve := func(body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(http.MethodPost, "/", strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		rec := httptest.NewRecorder()
+		n.ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("should intercept and forward requests of a batch and return responses in order", func(t *testing.T) {
+		batches = nil
+		rec := serve(`[
+			{"jsonrpc":"2.0","method":"forwarded","params":"a","id":1},
+			{"jsonrpc":"2.0","method":"intercepted","id":2},
+			{"jsonrpc":"2.0","method":"forwarded","params":"b","id":3},
+			{"jsonrpc":"2.0","method":"intercepted","id":4},
+			{"jsonrpc":"2.0","method":"intercepted","id":5}
+		]`)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "application/json", rec.Header().Get("Content-Type"))
+
+		var resps []*jsonrpc.ResponseMsg
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+		require.Len(t, resps, 5)
+		assertResponse(t, resps[0], "2.0", 1, "a")
+		assertResponse(t, resps[1], "2.0", 2, "intercepted")
+		assertResponse(t, resps[2], "2.0", 3, "b")
+		assertResponse(t, resps[3], "2.0", 4, "intercepted")
+		assertResponse(t, resps[4], "2.0", 5, "intercepted")
+		assert.Equal(t, []int{2}, batches, "forwarded requests should be sent in a single batch")
+	})
+
+	t.Run("should return an error for invalid elements and skip notifications", func(t *testing.T) {
+		rec := serve(`[1, {"jsonrpc":"2.0","method":"intercepted"}, {"jsonrpc":"2.0","method":"intercepted","id":"x"}]`)
+
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		var resps []*jsonrpc.ResponseMsg
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resps))
+		require.Len(t, resps, 2)
+		require.NotNil(t, resps[0].Error)
+		assert.Equal(t, -32600, resps[0].Error.Code)
+		assertResponse(t, resps[1], "2.0", "x", "intercepted")
+	})
+
+	t.Run("should return an error for an empty batch", func(t *testing.T) {
+		rec := serve(`[]`)
+
+		resp := new(jsonrpc.ResponseMsg)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, -32600, resp.Error.Code)
+	})
+
+	t.Run("should return an error for a batch larger than the maximum size", func(t *testing.T) {
+		batches = nil
+		rec := serve(`[
+			{"jsonrpc":"2.0","method":"forwarded","params":"a","id":1},
+			{"jsonrpc":"2.0","method":"forwarded","params":"b","id":2},
+			{"jsonrpc":"2.0","method":"forwarded","params":"c","id":3},
+			{"jsonrpc":"2.0","method":"forwarded","params":"d","id":4},
+			{"jsonrpc":"2.0","method":"forwarded","params":"e","id":5},
+			{"jsonrpc":"2.0","method":"forwarded","params":"f","id":6}
+		]`)
+
+		resp := new(jsonrpc.ResponseMsg)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, -32600, resp.Error.Code)
+		assert.Empty(t, batches)
+	})
+}
+
+func TestNodeWebSocketBatch(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcServer := httptest.NewServer(
+		http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+			conn, err := upgrader.Upgrade(rw, req, nil)
+			if err != nil {
+				return
+			}
+
+			go func() {
+				defer conn.Close()
+				for {
+					var msgs []*jsonrpc.RequestMsg
+					if err := conn.ReadJSON(&msgs); err != nil {
+						return
+					}
+
+					resps := make([]*jsonrpc.ResponseMsg, 0, len(msgs))
+					for _, msg := range msgs {
+						resps = append(resps, (&jsonrpc.ResponseMsg{}).WithVersion(msg.Version).WithID(msg.ID).WithResult(msg.Params))
+					}
+
+					if err := conn.WriteJSON(resps); err != nil {
+						return
+					}
+				}
+			}()
+		}),
+	)
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+	n.Handler = interceptingHandler()
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	defer func() { _ = n.Stop(context.Background()) }()
+
+	proxySrv := httptest.NewServer(n)
+	defer proxySrv.Close()
+
+	clientConn, _, err := dialer.Dial(fmt.Sprintf("ws://%v", proxySrv.Listener.Addr().String()), nil)
+	require.NoError(t, err, "Dial must not error")
+	defer clientConn.Close()
+
+	err = clientConn.WriteMessage(websocket.TextMessage, []byte(`[
+		{"jsonrpc":"2.0","method":"intercepted","id":"1"},
+		{"jsonrpc":"2.0","method":"forwarded","params":"a","id":"2"},
+		{"jsonrpc":"2.0","method":"forwarded","params":"b","id":"3"}
+	]`))
+	require.NoError(t, err, "WriteMessage must not error")
+
+	var resps []*jsonrpc.ResponseMsg
+	err = clientConn.ReadJSON(&resps)
+	require.NoError(t, err, "ReadJSON must not error")
+	require.Len(t, resps, 3)
+	assertResponse(t, resps[0], "2.0", "1", "intercepted")
+	assertResponse(t, resps[1], "2.0", "2", "a")
+	assertResponse(t, resps[2], "2.0", "3", "b")
+}
@@ -0,0 +1,175 @@
+package proxynode
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/pkg/tessera"
+	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
+	gorillamux "github.com/gorilla/mux"
+)
+
+// registerTesseraRoutes registers the REST helpers to the private transaction manager of the node.
+// Each route is restricted by the methods allowed on the node as the method it is named after
+func (n *Node) registerTesseraRoutes(router *gorillamux.Router) {
+	r := router.PathPrefix("/tessera").Subrouter()
+	r.Methods(http.MethodPost).Path("/send").HandlerFunc(n.filterTesseraMethod("priv_send", n.tesseraSend))
+	r.Methods(http.MethodPost).Path("/receive").HandlerFunc(n.filterTesseraMethod("priv_receive", n.tesseraReceive))
+	r.Methods(http.MethodPost).Path("/resend").HandlerFunc(n.filterTesseraMethod("priv_resend", n.tesseraResend))
+	r.Methods(http.MethodGet).Path("/transactions/{hash:.+}").HandlerFunc(n.filterTesseraMethod("priv_getTransaction", n.tesseraTransaction))
+	r.Methods(http.MethodPost).Path("/privacy-groups").HandlerFunc(n.filterTesseraMethod("priv_createPrivacyGroup", n.tesseraCreatePrivacyGroup))
+	r.Methods(http.MethodPost).Path("/privacy-groups/find").HandlerFunc(n.filterTesseraMethod("priv_findPrivacyGroup", n.tesseraFindPrivacyGroup))
+	r.Methods(http.MethodGet).Path("/privacy-groups/{id:.+}").HandlerFunc(n.filterTesseraMethod("priv_retrievePrivacyGroup", n.tesseraRetrievePrivacyGroup))
+}
+
+func (n *Node) filterTesseraMethod(method string, h http.HandlerFunc) http.HandlerFunc {
+	return func(rw http.ResponseWriter, req *http.Request) {
+		if !n.methods.IsAllowed(method) {
+			n.logger.Warn("private transaction manager method not allowed", "method", method)
+			http2.WriteHTTPErrorResponse(rw, errors.ForbiddenError("method %q not allowed", method))
+			return
+		}
+
+		h(rw, req)
+	}
+}
+
+type sendResponse struct {
+	Key []byte `json:"key"`
+}
+
+func (n *Node) tesseraSend(rw http.ResponseWriter, req *http.Request) {
+	sendReq := new(tessera.SendRequest)
+	if !n.readTesseraRequest(rw, req, sendReq) {
+		return
+	}
+
+	key, err := n.newPrivTxMngrClient().Send(req.Context(), sendReq)
+	n.writeTesseraResponse(rw, &sendResponse{Key: key}, err)
+}
+
+func (n *Node) tesseraReceive(rw http.ResponseWriter, req *http.Request) {
+	receiveReq := new(tessera.ReceiveRequest)
+	if !n.readTesseraRequest(rw, req, receiveReq) {
+		return
+	}
+
+	resp, err := n.newPrivTxMngrClient().Receive(req.Context(), receiveReq.Key, receiveReq.To)
+	n.writeTesseraResponse(rw, resp, err)
+}
+
+func (n *Node) tesseraResend(rw http.ResponseWriter, req *http.Request) {
+	resendReq := new(tessera.ResendRequest)
+	if !n.readTesseraRequest(rw, req, resendReq) {
+		return
+	}
+
+	err := n.newPrivTxMngrClient().Resend(req.Context(), resendReq)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, tesseraError(err))
+		return
+	}
+
+	rw.WriteHeader(http.StatusNoContent)
+}
+
+func (n *Node) tesseraTransaction(rw http.ResponseWriter, req *http.Request) {
+	if !n.checkPrivTxMngr(rw) {
+		return
+	}
+
+	hash, err := base64.StdEncoding.DecodeString(gorillamux.Vars(req)["hash"])
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError("transaction hash must be base64 encoded"))
+		return
+	}
+
+	resp, err := n.newPrivTxMngrClient().TransactionByHash(req.Context(), hash, req.URL.Query().Get("to"))
+	n.writeTesseraResponse(rw, resp, err)
+}
+
+func (n *Node) tesseraCreatePrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	createReq := new(tessera.CreatePrivacyGroupRequest)
+	if !n.readTesseraRequest(rw, req, createReq) {
+		return
+	}
+
+	resp, err := n.newPrivTxMngrClient().CreatePrivacyGroup(req.Context(), createReq)
+	n.writeTesseraResponse(rw, resp, err)
+}
+
+func (n *Node) tesseraFindPrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	findReq := new(tessera.FindPrivacyGroupRequest)
+	if !n.readTesseraRequest(rw, req, findReq) {
+		return
+	}
+
+	resp, err := n.newPrivTxMngrClient().FindPrivacyGroup(req.Context(), findReq.Addresses)
+	if resp == nil {
+		resp = []*tessera.PrivacyGroup{}
+	}
+	n.writeTesseraResponse(rw, resp, err)
+}
+
+func (n *Node) tesseraRetrievePrivacyGroup(rw http.ResponseWriter, req *http.Request) {
+	if !n.checkPrivTxMngr(rw) {
+		return
+	}
+
+	resp, err := n.newPrivTxMngrClient().RetrievePrivacyGroup(req.Context(), gorillamux.Vars(req)["id"])
+	n.writeTesseraResponse(rw, resp, err)
+}
+
+func (n *Node) checkPrivTxMngr(rw http.ResponseWriter) bool {
+	if n.privTxMngr == nil {
+		http2.WriteHTTPErrorResponse(rw, errors.NotSupportedError("private transaction manager is not configured on node"))
+		return false
+	}
+
+	return true
+}
+
+func (n *Node) readTesseraRequest(rw http.ResponseWriter, req *http.Request, v interface{}) bool {
+	if !n.checkPrivTxMngr(rw) {
+		return false
+	}
+
+	err := jsonutils.UnmarshalBody(req.Body, v)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return false
+	}
+
+	return true
+}
+
+func (n *Node) writeTesseraResponse(rw http.ResponseWriter, resp interface{}, err error) {
+	if err != nil {
+		n.logger.WithError(err).Error("private transaction manager request failed")
+		http2.WriteHTTPErrorResponse(rw, tesseraError(err))
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(rw).Encode(resp)
+}
+
+// tesseraError converts an error of the private transaction manager to a key manager error
+func tesseraError(err error) error {
+	respErr, ok := err.(*tessera.ResponseError)
+	if !ok {
+		return errors.DependencyFailureError(err.Error())
+	}
+
+	switch {
+	case respErr.StatusCode == http.StatusNotFound:
+		return errors.NotFoundError(respErr.Message)
+	case respErr.StatusCode >= http.StatusBadRequest && respErr.StatusCode < http.StatusInternalServerError:
+		return errors.InvalidParameterError(respErr.Message)
+	default:
+		return errors.DependencyFailureError(respErr.Message)
+	}
+}
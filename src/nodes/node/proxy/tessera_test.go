@@ -0,0 +1,136 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/tessera"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// tesseraServer replies with a privacy group, a payload for transaction hash a/+b and a not found error otherwise
+func tesseraServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		b, _ := ioutil.ReadAll(req.Body)
+		switch req.URL.Path {
+		case "/createPrivacyGroup":
+			assert.JSONEq(t, `{"addresses":["A1aV","B1aV"],"from":"A1aV"}`, string(b))
+			rw.Header().Set("Content-Type", "application/json")
+			_, _ = rw.Write([]byte(`{"privacyGroupId":"G1aV","type":"PANTHEON","members":["A1aV","B1aV"]}`))
+		case "/transaction/a/+b":
+			assert.Equal(t, "B1aV", req.URL.Query().Get("to"))
+			rw.Header().Set("Content-Type", "application/json")
+			_, _ = rw.Write([]byte(`{"payload":"AQI="}`))
+		default:
+			rw.WriteHeader(http.StatusNotFound)
+			_, _ = rw.Write([]byte("not found"))
+		}
+	}))
+}
+
+func TestNodeTessera(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ptmServer := tesseraServer(t)
+	defer ptmServer.Close()
+
+	cfg := (&Config{
+		PrivTxManager: &DownstreamConfig{
+			Addr: ptmServer.URL,
+		},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	defer func() { _ = n.Stop(context.Background()) }()
+
+	t.Run("should create a privacy group", func(t *testing.T) {
+		rec := serveNode(n, http.MethodPost, "/tessera/privacy-groups", `{"addresses":["A1aV","B1aV"],"from":"A1aV"}`)
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		pg := new(tessera.PrivacyGroup)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), pg))
+		assert.Equal(t, &tessera.PrivacyGroup{PrivacyGroupID: "G1aV", Type: "PANTHEON", Members: []string{"A1aV", "B1aV"}}, pg)
+	})
+
+	t.Run("should get the payload of a transaction", func(t *testing.T) {
+		rec := serveNode(n, http.MethodGet, "/tessera/transactions/a%2F%2Bb?to=B1aV", "")
+		require.Equal(t, http.StatusOK, rec.Code)
+
+		resp := new(tessera.ReceiveResponse)
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), resp))
+		assert.Equal(t, []byte{1, 2}, resp.Payload)
+	})
+
+	t.Run("should return not found errors of the private transaction manager", func(t *testing.T) {
+		rec := serveNode(n, http.MethodGet, "/tessera/privacy-groups/unknown", "")
+		assert.Equal(t, http.StatusNotFound, rec.Code)
+	})
+
+	t.Run("should reject invalid requests", func(t *testing.T) {
+		rec := serveNode(n, http.MethodPost, "/tessera/receive", `{"key":1}`)
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+}
+
+func TestNodeTesseraMethods(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ptmServer := tesseraServer(t)
+	defer ptmServer.Close()
+
+	cfg := (&Config{
+		PrivTxManager: &DownstreamConfig{
+			Addr: ptmServer.URL,
+		},
+		Methods: &MethodsConfig{
+			Allow: []string{"eth_*", "priv_*"},
+			Deny:  []string{"priv_createPrivacyGroup"},
+		},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	t.Run("should allow the routes of allowed methods", func(t *testing.T) {
+		rec := serveNode(n, http.MethodGet, "/tessera/transactions/a%2F%2Bb?to=B1aV", "")
+		assert.Equal(t, http.StatusOK, rec.Code)
+	})
+
+	t.Run("should reject the routes of denied methods", func(t *testing.T) {
+		rec := serveNode(n, http.MethodPost, "/tessera/privacy-groups", `{"addresses":["A1aV","B1aV"],"from":"A1aV"}`)
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+
+	t.Run("should reject the routes of methods which are not allowed", func(t *testing.T) {
+		n.methods = &MethodsConfig{Allow: []string{"eth_*"}}
+		rec := serveNode(n, http.MethodGet, "/tessera/transactions/a%2F%2Bb?to=B1aV", "")
+		assert.Equal(t, http.StatusForbidden, rec.Code)
+	})
+}
+
+func TestNodeTesseraNotConfigured(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcServer := httptest.NewServer(http.NotFoundHandler())
+	defer rpcServer.Close()
+
+	n, err := New((&Config{RPC: &DownstreamConfig{Addr: rpcServer.URL}}).SetDefault(), testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	rec := serveNode(n, http.MethodPost, "/tessera/privacy-groups/find", `{"addresses":["A1aV"]}`)
+	assert.Equal(t, http.StatusNotImplemented, rec.Code)
+}
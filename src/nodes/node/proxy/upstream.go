@@ -0,0 +1,434 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	httpclient "github.com/consensys/quorum-key-manager/pkg/http/client"
+	"github.com/consensys/quorum-key-manager/pkg/http/request"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+)
+
+// latencyWeight is the weight of a new sample in the moving average of the latency of an endpoint
+const latencyWeight = 0.2
+
+// healthCheckFunc checks an endpoint and returns its current block number, 0 if not relevant
+type healthCheckFunc func(ctx context.Context, client httpclient.Client) (uint64, error)
+
+type endpoint struct {
+	addr     string
+	priority int
+	preparer request.Preparer
+
+	mux         sync.RWMutex
+	healthy     bool
+	reachable   bool
+	latency     time.Duration
+	blockNumber uint64
+}
+
+func (e *endpoint) isHealthy() bool {
+	e.mux.RLock()
+	defer e.mux.RUnlock()
+	return e.healthy
+}
+
+func (e *endpoint) getLatency() time.Duration {
+	e.mux.RLock()
+	defer e.mux.RUnlock()
+	return e.latency
+}
+
+func (e *endpoint) observe(latency time.Duration) {
+	e.mux.Lock()
+	defer e.mux.Unlock()
+
+	if e.latency == 0 {
+		e.latency = latency
+		return
+	}
+	e.latency = time.Duration(latencyWeight*float64(latency) + (1-latencyWeight)*float64(e.latency))
+}
+
+// client sends requests to the endpoint
+func (e *endpoint) client(c httpclient.Client) httpclient.Client {
+	return httpclient.WithPreparer(e.preparer)(c)
+}
+
+// upstreams selects the endpoint serving each request of a downstream. Requests fail over to the next endpoint on connection errors
+// and unavailable endpoints
+type upstreams struct {
+	endpoints []*endpoint
+	strategy  string
+	client    httpclient.Client
+	counter   uint32
+
+	check       healthCheckFunc
+	interval    time.Duration
+	timeout     time.Duration
+	maxBlockLag uint64
+
+	startOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+
+	logger log.Logger
+}
+
+var _ httpclient.Client = &upstreams{}
+var _ request.Preparer = &upstreams{}
+
+func newUpstreams(cfg *DownstreamConfig, client httpclient.Client, check healthCheckFunc, logger log.Logger) (*upstreams, error) {
+	switch cfg.Strategy {
+	case FailoverStrategy, RoundRobinStrategy, LeastLatencyStrategy, "":
+	default:
+		return nil, fmt.Errorf("invalid upstream strategy %q", cfg.Strategy)
+	}
+
+	u := &upstreams{
+		strategy: cfg.Strategy,
+		client:   client,
+		check:    check,
+		stop:     make(chan struct{}),
+		done:     make(chan struct{}),
+		logger:   logger,
+	}
+
+	if cfg.HealthCheck != nil {
+		u.maxBlockLag = cfg.HealthCheck.MaxBlockLag
+		if cfg.HealthCheck.Interval != nil {
+			u.interval = cfg.HealthCheck.Interval.Duration
+		}
+		if cfg.HealthCheck.Timeout != nil {
+			u.timeout = cfg.HealthCheck.Timeout.Duration
+		}
+	}
+	if u.interval <= 0 {
+		u.interval = DefaultHealthCheckInterval
+	}
+	if u.timeout <= 0 {
+		u.timeout = DefaultHealthCheckTimeout
+	}
+
+	// Without endpoints, the downstream address is the only endpoint
+	endpointsCfg := cfg.Endpoints
+	if len(endpointsCfg) == 0 {
+		endpointsCfg = []*EndpointConfig{{}}
+	}
+
+	for _, endpointCfg := range endpointsCfg {
+		reqCfg := new(request.ProxyConfig)
+		if cfg.Proxy != nil && cfg.Proxy.Request != nil {
+			*reqCfg = *cfg.Proxy.Request
+		}
+		if endpointCfg.Addr != "" {
+			reqCfg.Addr = endpointCfg.Addr
+		}
+		if reqCfg.Addr == "" {
+			reqCfg.Addr = cfg.Addr
+		}
+
+		preparer, err := request.Proxy(reqCfg)
+		if err != nil {
+			return nil, err
+		}
+
+		u.endpoints = append(u.endpoints, &endpoint{
+			addr:      reqCfg.Addr,
+			priority:  endpointCfg.Priority,
+			preparer:  preparer,
+			healthy:   true,
+			reachable: true,
+		})
+	}
+
+	return u, nil
+}
+
+// Start runs the health checks of the endpoints, only needed with several endpoints
+func (u *upstreams) Start(context.Context) error {
+	u.startOnce.Do(u.run)
+	return nil
+}
+
+func (u *upstreams) run() {
+	if len(u.endpoints) < 2 || u.check == nil {
+		close(u.done)
+		return
+	}
+
+	go func() {
+		defer close(u.done)
+
+		ticker := time.NewTicker(u.interval)
+		defer ticker.Stop()
+
+		for {
+			u.checkAll()
+
+			select {
+			case <-u.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (u *upstreams) Stop(ctx context.Context) error {
+	// Nothing to wait for if never started
+	u.startOnce.Do(func() { close(u.done) })
+
+	select {
+	case <-u.stop:
+	default:
+		close(u.stop)
+	}
+
+	select {
+	case <-u.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// checkConnectivity checks every endpoint once and returns the errors of the failing ones
+func (u *upstreams) checkConnectivity(ctx context.Context) error {
+	var err error
+	for _, e := range u.endpoints {
+		checkCtx, cancel := context.WithTimeout(ctx, u.timeout)
+		_, checkErr := u.check(checkCtx, e.client(u.client))
+		cancel()
+		if checkErr != nil {
+			err = errors.CombineErrors(err, fmt.Errorf("endpoint %s: %v", e.addr, checkErr))
+		}
+	}
+
+	return err
+}
+
+// checkAll checks all endpoints then ejects the endpoints lagging behind the highest block
+func (u *upstreams) checkAll() {
+	wg := &sync.WaitGroup{}
+	for _, e := range u.endpoints {
+		wg.Add(1)
+		go func(e *endpoint) {
+			defer wg.Done()
+			u.checkEndpoint(e)
+		}(e)
+	}
+	wg.Wait()
+
+	var highest uint64
+	for _, e := range u.endpoints {
+		e.mux.RLock()
+		if e.reachable && e.blockNumber > highest {
+			highest = e.blockNumber
+		}
+		e.mux.RUnlock()
+	}
+
+	for _, e := range u.endpoints {
+		e.mux.Lock()
+		healthy := e.reachable && (u.maxBlockLag == 0 || e.blockNumber+u.maxBlockLag >= highest)
+		if healthy != e.healthy {
+			u.logger.Info("upstream endpoint health changed", "addr", e.addr, "healthy", healthy, "block_number", e.blockNumber, "highest_block_number", highest)
+		}
+		e.healthy = healthy
+		e.mux.Unlock()
+	}
+}
+
+func (u *upstreams) checkEndpoint(e *endpoint) {
+	ctx, cancel := context.WithTimeout(context.Background(), u.timeout)
+	defer cancel()
+
+	start := time.Now()
+	blockNumber, err := u.check(ctx, e.client(u.client))
+	if err != nil {
+		u.logger.Debug("upstream endpoint health check failed", "addr", e.addr, "error", err)
+
+		e.mux.Lock()
+		e.reachable = false
+		e.mux.Unlock()
+		return
+	}
+	e.observe(time.Since(start))
+
+	e.mux.Lock()
+	e.reachable = true
+	e.blockNumber = blockNumber
+	e.mux.Unlock()
+}
+
+// candidates returns the endpoints in order of selection, healthy endpoints first
+func (u *upstreams) candidates() []*endpoint {
+	if len(u.endpoints) == 1 {
+		return u.endpoints
+	}
+
+	var healthy, unhealthy []*endpoint
+	for _, e := range u.endpoints {
+		if e.isHealthy() {
+			healthy = append(healthy, e)
+		} else {
+			unhealthy = append(unhealthy, e)
+		}
+	}
+
+	u.order(healthy)
+	u.order(unhealthy)
+
+	return append(healthy, unhealthy...)
+}
+
+func (u *upstreams) order(endpoints []*endpoint) {
+	if len(endpoints) < 2 {
+		return
+	}
+
+	switch u.strategy {
+	case RoundRobinStrategy:
+		offset := int(atomic.AddUint32(&u.counter, 1)-1) % len(endpoints)
+		rotated := append(append([]*endpoint{}, endpoints[offset:]...), endpoints[:offset]...)
+		copy(endpoints, rotated)
+	case LeastLatencyStrategy:
+		sort.SliceStable(endpoints, func(i, j int) bool {
+			return endpoints[i].getLatency() < endpoints[j].getLatency()
+		})
+	default:
+		sort.SliceStable(endpoints, func(i, j int) bool {
+			return endpoints[i].priority < endpoints[j].priority
+		})
+	}
+}
+
+// Prepare prepares a request for the selected endpoint, used for long-lived connections which stick to an endpoint
+func (u *upstreams) Prepare(req *http.Request) (*http.Request, error) {
+	return u.candidates()[0].preparer.Prepare(req)
+}
+
+// Do sends the request to the selected endpoint and fails over to the next endpoints if it could not connect to the endpoint
+// or the endpoint is unavailable. Requests sending transactions are not failed over once they may have reached the endpoint,
+// so a transaction is never sent twice
+func (u *upstreams) Do(req *http.Request) (*http.Response, error) {
+	candidates := u.candidates()
+
+	// Body must be replayable to be sent to several endpoints
+	req, err := request.Body().Prepare(req)
+	if err != nil {
+		return nil, err
+	}
+
+	sendsTx, err := sendsTransaction(req)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp *http.Response
+	for i, e := range candidates {
+		attempt := req.Clone(req.Context())
+		attempt.Body, err = req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+
+		attempt, err = e.preparer.Prepare(attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		start := time.Now()
+		resp, err = u.client.Do(attempt)
+		if err == nil && !isUnavailable(resp.StatusCode) {
+			e.observe(time.Since(start))
+			return resp, nil
+		}
+
+		if i == len(candidates)-1 || req.Context().Err() != nil || (sendsTx && !isDialError(err)) {
+			break
+		}
+
+		if resp != nil {
+			resp.Body.Close()
+		}
+		u.logger.Debug("upstream endpoint failed, trying next endpoint", "addr", e.addr, "error", err)
+	}
+
+	return resp, err
+}
+
+func (u *upstreams) CloseIdleConnections() {
+	u.client.CloseIdleConnections()
+}
+
+// isDialError indicates whether the request failed before reaching the endpoint
+func isDialError(err error) bool {
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+
+	opErr, ok := err.(*net.OpError)
+	return ok && opErr.Op == "dial"
+}
+
+// sendTxMethods are the JSON-RPC methods sending a transaction, which must not be sent twice
+var sendTxMethods = map[string]bool{
+	"eth_sendRawTransaction":        true,
+	"eth_sendRawPrivateTransaction": true,
+	"eea_sendRawTransaction":        true,
+	"priv_distributeRawTransaction": true,
+}
+
+// sendsTransaction indicates whether a JSON-RPC request, or any request of a batch, sends a transaction
+func sendsTransaction(req *http.Request) (bool, error) {
+	if req.GetBody == nil {
+		return false, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil || body == nil {
+		return false, err
+	}
+	defer body.Close()
+
+	b, err := ioutil.ReadAll(body)
+	if err != nil {
+		return false, err
+	}
+
+	raws := []json.RawMessage{b}
+	if jsonrpc.IsBatch(b) {
+		// Malformed batches are rejected by the node, so they can not send a transaction
+		if raws, err = jsonrpc.UnmarshalBatch(b); err != nil {
+			return false, nil
+		}
+	}
+
+	for _, raw := range raws {
+		msg := struct {
+			Method string `json:"method"`
+		}{}
+		if json.Unmarshal(raw, &msg) == nil && sendTxMethods[msg.Method] {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+func isUnavailable(status int) bool {
+	return status == http.StatusBadGateway || status == http.StatusServiceUnavailable || status == http.StatusGatewayTimeout
+}
@@ -0,0 +1,239 @@
+package proxynode
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newRPCServer replies to health checks with the given sync status and block number and to other requests with its name
+func newRPCServer(name string, syncing bool, blockNumber string) *httptest.Server {
+	return httptest.NewServer(jsonRPCHandler(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		switch msg.Method {
+		case "eth_syncing":
+			_ = jsonrpc.WriteResult(rw, syncing)
+		case "eth_blockNumber":
+			_ = jsonrpc.WriteResult(rw, blockNumber)
+		default:
+			_ = jsonrpc.WriteResult(rw, name)
+		}
+	}))
+}
+
+func jsonRPCHandler(f jsonrpc.HandlerFunc) http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, req *http.Request) {
+		msg := new(jsonrpc.RequestMsg)
+		b, _ := ioutil.ReadAll(req.Body)
+		_ = msg.UnmarshalJSON(b)
+		jsonrpc.DefaultRWHandler(f).ServeRPC(jsonrpc.NewResponseWriter(rw), msg)
+	})
+}
+
+func newTestUpstreams(t *testing.T, ctrl *gomock.Controller, strategy string, maxBlockLag uint64, endpoints ...*EndpointConfig) *upstreams {
+	cfg := (&DownstreamConfig{
+		Endpoints:   endpoints,
+		Strategy:    strategy,
+		HealthCheck: &HealthCheckConfig{MaxBlockLag: maxBlockLag},
+	}).SetDefault()
+
+	u, err := newUpstreams(cfg, http.DefaultClient, rpcHealthCheck, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err)
+
+	return u
+}
+
+func callUpstreams(t *testing.T, u *upstreams) string {
+	resp, err := jsonrpc.NewHTTPClient(u).Do(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("test").WithID(1))
+	require.NoError(t, err)
+
+	var result string
+	require.NoError(t, resp.UnmarshalResult(&result))
+	return result
+}
+
+func TestUpstreams(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	srv1 := newRPCServer("srv1", false, "0x10")
+	defer srv1.Close()
+	srv2 := newRPCServer("srv2", false, "0x10")
+	defer srv2.Close()
+	down := httptest.NewServer(http.NotFoundHandler())
+	down.Close()
+
+	t.Run("should fail with an invalid strategy", func(t *testing.T) {
+		_, err := newUpstreams(&DownstreamConfig{Addr: srv1.URL, Strategy: "random"}, http.DefaultClient, rpcHealthCheck, testutils.NewMockLogger(ctrl))
+		require.Error(t, err)
+	})
+
+	t.Run("should use the downstream address without endpoints", func(t *testing.T) {
+		u, err := newUpstreams((&DownstreamConfig{Addr: srv1.URL}).SetDefault(), http.DefaultClient, rpcHealthCheck, testutils.NewMockLogger(ctrl))
+		require.NoError(t, err)
+
+		assert.Equal(t, "srv1", callUpstreams(t, u))
+	})
+
+	t.Run("should send requests to the endpoint with the lowest priority", func(t *testing.T) {
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: srv1.URL, Priority: 2}, &EndpointConfig{Addr: srv2.URL, Priority: 1})
+
+		assert.Equal(t, "srv2", callUpstreams(t, u))
+		assert.Equal(t, "srv2", callUpstreams(t, u))
+	})
+
+	t.Run("should fail over to the next endpoint on connection errors", func(t *testing.T) {
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: down.URL}, &EndpointConfig{Addr: srv2.URL, Priority: 1})
+
+		assert.Equal(t, "srv2", callUpstreams(t, u))
+	})
+
+	t.Run("should not replay a transaction on an unavailable endpoint", func(t *testing.T) {
+		var hits int32
+		unavailable := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&hits, 1)
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+		next := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&hits, 1)
+		}))
+		defer next.Close()
+
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: unavailable.URL}, &EndpointConfig{Addr: next.URL, Priority: 1})
+
+		body := `{"jsonrpc":"2.0","method":"eth_sendRawTransaction","params":["0xf86c"],"id":1}`
+		req, _ := http.NewRequest(http.MethodPost, "", strings.NewReader(body))
+		resp, err := u.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	})
+
+	t.Run("should not replay a batch sending a transaction on an unavailable endpoint", func(t *testing.T) {
+		var hits int32
+		unavailable := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			atomic.AddInt32(&hits, 1)
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: unavailable.URL}, &EndpointConfig{Addr: srv2.URL, Priority: 1})
+
+		body := `[{"jsonrpc":"2.0","method":"eth_blockNumber","id":1},{"jsonrpc":"2.0","method":"eea_sendRawTransaction","params":["0xf86c"],"id":2}]`
+		req, _ := http.NewRequest(http.MethodPost, "", strings.NewReader(body))
+		resp, err := u.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int32(1), atomic.LoadInt32(&hits))
+	})
+
+	t.Run("should fail over reads on an unavailable endpoint", func(t *testing.T) {
+		unavailable := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer unavailable.Close()
+
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: unavailable.URL}, &EndpointConfig{Addr: srv2.URL, Priority: 1})
+
+		req, _ := http.NewRequest(http.MethodGet, "", nil)
+		resp, err := u.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+		respMsg, err := jsonrpc.NewHTTPClient(u).Do(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_blockNumber").WithID(1))
+		require.NoError(t, err)
+
+		var blockNumber string
+		require.NoError(t, respMsg.UnmarshalResult(&blockNumber))
+		assert.Equal(t, "0x10", blockNumber)
+	})
+
+	t.Run("should spread requests over the endpoints with round-robin", func(t *testing.T) {
+		u := newTestUpstreams(t, ctrl, RoundRobinStrategy, 0, &EndpointConfig{Addr: srv1.URL}, &EndpointConfig{Addr: srv2.URL})
+
+		assert.Equal(t, "srv1", callUpstreams(t, u))
+		assert.Equal(t, "srv2", callUpstreams(t, u))
+		assert.Equal(t, "srv1", callUpstreams(t, u))
+	})
+
+	t.Run("should prefer the endpoint with the lowest latency", func(t *testing.T) {
+		u := newTestUpstreams(t, ctrl, LeastLatencyStrategy, 0, &EndpointConfig{Addr: srv1.URL}, &EndpointConfig{Addr: srv2.URL})
+		u.endpoints[0].latency = 100
+		u.endpoints[1].latency = 10
+
+		assert.Equal(t, "srv2", callUpstreams(t, u))
+	})
+
+	t.Run("should eject unreachable, syncing and lagging endpoints", func(t *testing.T) {
+		syncing := newRPCServer("syncing", true, "0x10")
+		defer syncing.Close()
+		lagging := newRPCServer("lagging", false, "0x1")
+		defer lagging.Close()
+
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 5,
+			&EndpointConfig{Addr: down.URL},
+			&EndpointConfig{Addr: syncing.URL},
+			&EndpointConfig{Addr: lagging.URL},
+			&EndpointConfig{Addr: srv1.URL, Priority: 10},
+		)
+		u.checkAll()
+
+		assert.False(t, u.endpoints[0].isHealthy(), "unreachable endpoint should be ejected")
+		assert.False(t, u.endpoints[1].isHealthy(), "syncing endpoint should be ejected")
+		assert.False(t, u.endpoints[2].isHealthy(), "lagging endpoint should be ejected")
+		assert.True(t, u.endpoints[3].isHealthy())
+		assert.Equal(t, "srv1", callUpstreams(t, u))
+	})
+
+	t.Run("should stick long-lived connections to the selected endpoint", func(t *testing.T) {
+		u := newTestUpstreams(t, ctrl, FailoverStrategy, 0, &EndpointConfig{Addr: srv1.URL}, &EndpointConfig{Addr: srv2.URL, Priority: 1})
+
+		req, _ := http.NewRequest(http.MethodGet, "", nil)
+		req, err := u.Prepare(req)
+		require.NoError(t, err)
+		assert.Equal(t, srv1.URL, "http://"+req.URL.Host)
+	})
+}
+
+func TestCheckConnectivity(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	synced := newRPCServer("synced", false, "0x10")
+	defer synced.Close()
+	syncing := newRPCServer("syncing", true, "0x10")
+	defer syncing.Close()
+
+	t.Run("should succeed if all endpoints are healthy", func(t *testing.T) {
+		cfg := (&Config{RPC: &DownstreamConfig{Addr: synced.URL}}).SetDefault()
+
+		err := CheckConnectivity(context.Background(), cfg, testutils.NewMockLogger(ctrl))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should fail if an endpoint is not healthy", func(t *testing.T) {
+		cfg := (&Config{RPC: &DownstreamConfig{Endpoints: []*EndpointConfig{{Addr: synced.URL}, {Addr: syncing.URL}}}}).SetDefault()
+
+		err := CheckConnectivity(context.Background(), cfg, testutils.NewMockLogger(ctrl))
+
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), syncing.URL)
+		assert.NotContains(t, err.Error(), synced.URL)
+	})
+}
@@ -0,0 +1,464 @@
+package proxynode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"strings"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	"github.com/consensys/quorum-key-manager/pkg/websocket"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	gorillawebsocket "github.com/gorilla/websocket"
+)
+
+// maxPendingNotifications is the maximum number of notifications held while subscribing
+const maxPendingNotifications = 1000
+
+func (n *Node) interceptWS(ctx context.Context, clientConn, serverConn *gorillawebsocket.Conn) (clientErrors, serverErrors <-chan error) {
+	s := newWSSession(ctx, n, clientConn)
+	s.connect(serverConn)
+
+	clientErrs := make(chan error, 1)
+	go func() {
+		clientErrs <- s.serveClient()
+		close(clientErrs)
+	}()
+
+	return clientErrs, s.serverErrs
+}
+
+func (n *Node) serveWSBatch(ctx context.Context, w io.Writer, jsonrpcClient *jsonrpc.WebSocketClient, b []byte) {
+	raws, err := n.unmarshalBatch(b)
+	if err != nil {
+		_ = jsonrpc.WriteError(jsonrpc.NewResponseWriter(w), err)
+		return
+	}
+
+	resps := n.serveBatch(ctx, jsonrpcClient, jsonrpcClient, raws)
+	if len(resps) == 0 {
+		return
+	}
+
+	_ = json.NewEncoder(w).Encode(resps)
+}
+
+// wsSubscription is a subscription created by a client (e.g. eth_subscribe).
+// The client keeps the ID it received when subscribing while the upstream ID changes on every re-subscription
+type wsSubscription struct {
+	clientID, upstreamID string
+
+	version, method string
+	params          interface{}
+}
+
+// wsSession proxies a client websocket connection to the node.
+// Client requests are served concurrently and subscriptions are restored when the server connection is replaced
+type wsSession struct {
+	ctx    context.Context
+	n      *Node
+	logger log.Logger
+
+	clientConn *gorillawebsocket.Conn
+	writeMux   sync.Mutex
+
+	// mux guards the server client and the subscriptions. When both locks are needed, notifMux is acquired before mux
+	mux          sync.RWMutex
+	client       *jsonrpc.WebSocketClient
+	subs         map[string]*wsSubscription
+	upstreamSubs map[string]*wsSubscription
+
+	// Notifications of unknown subscriptions are held while subscribing, so they are not sent to the client before
+	// the subscription ID. notifMux serializes notifications to keep their order
+	notifMux sync.Mutex
+	holds    int
+	pending  []*jsonrpc.RequestMsg
+
+	closed     chan struct{}
+	serverErrs chan error
+	failOnce   sync.Once
+}
+
+func newWSSession(ctx context.Context, n *Node, clientConn *gorillawebsocket.Conn) *wsSession {
+	return &wsSession{
+		ctx:          ctx,
+		n:            n,
+		logger:       n.logger,
+		clientConn:   clientConn,
+		subs:         make(map[string]*wsSubscription),
+		upstreamSubs: make(map[string]*wsSubscription),
+		closed:       make(chan struct{}),
+		serverErrs:   make(chan error, 1),
+	}
+}
+
+// connect attaches a JSON-RPC client to a server connection, it must be called while holding mux when reconnecting
+func (s *wsSession) connect(serverConn *gorillawebsocket.Conn) {
+	client := jsonrpc.NewWebsocketClient(serverConn).WithNotificationHandler(s.handleNotification)
+	_ = client.Start(s.ctx)
+	s.client = client
+
+	go s.watch(client)
+}
+
+func (s *wsSession) currentClient() *jsonrpc.WebSocketClient {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	return s.client
+}
+
+// watch reconnects to the server when the connection of the client drops
+func (s *wsSession) watch(client *jsonrpc.WebSocketClient) {
+	err, ok := <-client.Errors()
+	if !ok {
+		// Client has been stopped
+		s.fail(nil)
+		return
+	}
+
+	select {
+	case <-s.closed:
+		s.fail(nil)
+		return
+	default:
+	}
+
+	s.logger.WithError(err).Warn("websocket connection to node dropped, reconnecting")
+	s.reconnect(err)
+}
+
+func (s *wsSession) reconnect(cause error) {
+	redial := websocket.RedialFromContext(s.ctx)
+	if redial == nil {
+		s.fail(cause)
+		return
+	}
+
+	// The server connection is dialed without holding any lock, so notifications and requests are not blocked meanwhile
+	serverConn, err := redial(s.ctx)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to reconnect to node")
+		s.fail(cause)
+		return
+	}
+
+	// Notifications of the new connection are held until the subscriptions are restored.
+	// hold is called outside of mux, so notifMux is never acquired while holding mux
+	s.hold()
+
+	s.mux.Lock()
+	subs := make([]*wsSubscription, 0, len(s.subs))
+	for _, sub := range s.subs {
+		subs = append(subs, sub)
+	}
+	s.connect(serverConn)
+	s.mux.Unlock()
+
+	s.resubscribe(subs)
+}
+
+// resubscribe restores the subscriptions on the new server connection.
+// Notifications received in the meantime are held until all upstream IDs are known
+func (s *wsSession) resubscribe(subs []*wsSubscription) {
+	defer s.release()
+
+	client := jsonrpc.WithIncrementalID("resubscribe")(s.currentClient())
+	for _, sub := range subs {
+		resp, err := client.Do(new(jsonrpc.RequestMsg).WithVersion(sub.version).WithMethod(sub.method).WithParams(sub.params).WithContext(s.ctx))
+		if err == nil {
+			err = resp.Err()
+		}
+
+		var upstreamID string
+		if err == nil {
+			err = resp.UnmarshalResult(&upstreamID)
+		}
+
+		if err != nil {
+			// The client would silently stop receiving notifications, so we close its connection instead
+			s.logger.WithError(err).Error("failed to restore subscription", "subscription", sub.clientID)
+			s.fail(err)
+			return
+		}
+
+		s.mux.Lock()
+		delete(s.upstreamSubs, sub.upstreamID)
+		sub.upstreamID = upstreamID
+		s.upstreamSubs[upstreamID] = sub
+		s.mux.Unlock()
+
+		s.logger.Debug("subscription restored", "subscription", sub.clientID, "upstream_subscription", upstreamID)
+	}
+
+}
+
+// fail closes the session on the server side, err is nil if the server connection has been closed normally
+func (s *wsSession) fail(err error) {
+	s.failOnce.Do(func() {
+		if err != nil {
+			s.serverErrs <- err
+		}
+		close(s.serverErrs)
+	})
+}
+
+// serveClient reads the client messages and serves them concurrently until the client connection is closed
+func (s *wsSession) serveClient() error {
+	wg := &sync.WaitGroup{}
+	sem := make(chan struct{}, MaxWebSocketConcurrency)
+	defer func() {
+		close(s.closed)
+		_ = s.currentClient().Stop(s.ctx)
+		wg.Wait()
+	}()
+
+	for {
+		typ, b, err := s.clientConn.ReadMessage()
+		if err != nil {
+			return err
+		}
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			s.serveMsg(typ, b)
+		}()
+	}
+}
+
+func (s *wsSession) serveMsg(typ int, b []byte) {
+	buf := new(bytes.Buffer)
+	var written func()
+	if jsonrpc.IsBatch(b) {
+		s.n.serveWSBatch(s.ctx, buf, s.currentClient(), b)
+	} else {
+		written = s.serveRequest(buf, b)
+	}
+
+	if buf.Len() > 0 {
+		s.write(typ, buf.Bytes())
+	}
+
+	if written != nil {
+		written()
+	}
+}
+
+// serveRequest serves a single request, the returned function, if any, must be called once the response has been written
+func (s *wsSession) serveRequest(w io.Writer, b []byte) func() {
+	var rpcRw jsonrpc.ResponseWriter = jsonrpc.NewResponseWriter(w)
+
+	msg := new(jsonrpc.RequestMsg)
+	err := json.Unmarshal(b, msg)
+	if err != nil {
+		_ = jsonrpc.WriteError(rpcRw, jsonrpc.ParseError(err))
+		return nil
+	}
+
+	var written func()
+	switch {
+	case strings.HasSuffix(msg.Method, "_subscribe"):
+		s.hold()
+		subRw := &subscribeResponseWriter{rw: rpcRw, msg: msg}
+		rpcRw = subRw
+		written = func() {
+			if subRw.sub != nil {
+				s.addSubscription(subRw.sub)
+			}
+			s.release()
+		}
+	case strings.HasSuffix(msg.Method, "_unsubscribe"):
+		rpcRw = s.prepareUnsubscribe(rpcRw, msg)
+	}
+
+	// Create and attach session to context then handle message
+	sess := s.n.newSession(s.currentClient(), msg)
+	s.n.handler().ServeRPC(rpcRw, msg.WithContext(WithSession(s.ctx, sess)))
+
+	return written
+}
+
+// prepareUnsubscribe replaces the client subscription ID by the upstream one
+func (s *wsSession) prepareUnsubscribe(rpcRw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) jsonrpc.ResponseWriter {
+	var params []string
+	if err := msg.UnmarshalParams(&params); err != nil || len(params) == 0 {
+		return rpcRw
+	}
+
+	s.mux.RLock()
+	sub, ok := s.subs[params[0]]
+	var upstreamID string
+	if ok {
+		upstreamID = sub.upstreamID
+	}
+	s.mux.RUnlock()
+	if !ok {
+		return rpcRw
+	}
+
+	msg.WithParams([]string{upstreamID})
+
+	return &unsubscribeResponseWriter{rw: rpcRw, s: s, sub: sub}
+}
+
+func (s *wsSession) addSubscription(sub *wsSubscription) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	s.subs[sub.clientID] = sub
+	s.upstreamSubs[sub.upstreamID] = sub
+}
+
+func (s *wsSession) removeSubscription(sub *wsSubscription) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	delete(s.subs, sub.clientID)
+	delete(s.upstreamSubs, sub.upstreamID)
+}
+
+// hold holds the notifications of unknown subscriptions until release is called
+func (s *wsSession) hold() {
+	s.notifMux.Lock()
+	defer s.notifMux.Unlock()
+	s.holds++
+}
+
+// release forwards the held notifications whose subscription is now known, or all of them once nothing is held anymore
+func (s *wsSession) release() {
+	s.notifMux.Lock()
+	defer s.notifMux.Unlock()
+
+	s.holds--
+	var kept []*jsonrpc.RequestMsg
+	for _, msg := range s.pending {
+		if s.holds > 0 && !s.isKnown(msg) {
+			kept = append(kept, msg)
+			continue
+		}
+		s.forwardNotification(msg)
+	}
+	s.pending = kept
+}
+
+func (s *wsSession) handleNotification(msg *jsonrpc.RequestMsg) {
+	s.notifMux.Lock()
+	defer s.notifMux.Unlock()
+
+	if s.holds > 0 && !s.isKnown(msg) {
+		if len(s.pending) < maxPendingNotifications {
+			s.pending = append(s.pending, msg)
+		} else {
+			s.logger.Warn("too many notifications received while subscribing, dropping notification", "method", msg.Method)
+		}
+		return
+	}
+
+	s.forwardNotification(msg)
+}
+
+// isKnown indicates whether a notification belongs to a subscription known by the session
+func (s *wsSession) isKnown(msg *jsonrpc.RequestMsg) bool {
+	params := new(subscriptionParams)
+	if err := msg.UnmarshalParams(params); err != nil || params.Subscription == "" {
+		return true
+	}
+
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+	_, ok := s.upstreamSubs[params.Subscription]
+	return ok
+}
+
+type subscriptionParams struct {
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+}
+
+// wsNotification is a notification written to the client, notifications do not have any ID
+type wsNotification struct {
+	Version string      `json:"jsonrpc"`
+	Method  string      `json:"method"`
+	Params  interface{} `json:"params,omitempty"`
+}
+
+// forwardNotification writes a notification to the client with the client subscription ID
+func (s *wsSession) forwardNotification(msg *jsonrpc.RequestMsg) {
+	notif := &wsNotification{
+		Version: msg.Version,
+		Method:  msg.Method,
+		Params:  msg.Params,
+	}
+
+	params := new(subscriptionParams)
+	if err := msg.UnmarshalParams(params); err == nil && params.Subscription != "" {
+		s.mux.RLock()
+		sub, ok := s.upstreamSubs[params.Subscription]
+		s.mux.RUnlock()
+		if ok && sub.clientID != params.Subscription {
+			params.Subscription = sub.clientID
+			notif.Params = params
+		}
+	}
+
+	b, err := json.Marshal(notif)
+	if err != nil {
+		s.logger.WithError(err).Error("failed to marshal notification")
+		return
+	}
+
+	s.write(gorillawebsocket.TextMessage, b)
+}
+
+// write sends a message to the client, messages of concurrent requests are written one at a time
+func (s *wsSession) write(typ int, b []byte) {
+	s.writeMux.Lock()
+	defer s.writeMux.Unlock()
+
+	err := s.clientConn.WriteMessage(typ, b)
+	if err != nil {
+		s.logger.WithError(err).Debug("failed to write message to client")
+	}
+}
+
+// subscribeResponseWriter records the subscription created by a successful subscribe request
+type subscribeResponseWriter struct {
+	rw  jsonrpc.ResponseWriter
+	msg *jsonrpc.RequestMsg
+	sub *wsSubscription
+}
+
+func (rw *subscribeResponseWriter) WriteMsg(resp *jsonrpc.ResponseMsg) error {
+	var id string
+	if resp.Err() == nil && resp.UnmarshalResult(&id) == nil && id != "" {
+		rw.sub = &wsSubscription{
+			clientID:   id,
+			upstreamID: id,
+			version:    rw.msg.Version,
+			method:     rw.msg.Method,
+			params:     rw.msg.Params,
+		}
+	}
+
+	return rw.rw.WriteMsg(resp)
+}
+
+// unsubscribeResponseWriter removes the subscription of a successful unsubscribe request
+type unsubscribeResponseWriter struct {
+	rw  jsonrpc.ResponseWriter
+	s   *wsSession
+	sub *wsSubscription
+}
+
+func (rw *unsubscribeResponseWriter) WriteMsg(resp *jsonrpc.ResponseMsg) error {
+	var ok bool
+	if resp.Err() == nil && resp.UnmarshalResult(&ok) == nil && ok {
+		rw.s.removeSubscription(rw.sub)
+	}
+
+	return rw.rw.WriteMsg(resp)
+}
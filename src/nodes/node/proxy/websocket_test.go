@@ -0,0 +1,212 @@
+package proxynode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	pkgjson "github.com/consensys/quorum-key-manager/pkg/json"
+	"github.com/consensys/quorum-key-manager/pkg/jsonrpc"
+	pkgwebsocket "github.com/consensys/quorum-key-manager/pkg/websocket"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/golang/mock/gomock"
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// subscriptionServer is a node accepting subscriptions which IDs are prefixed with the number of the connection
+type subscriptionServer struct {
+	mux          sync.Mutex
+	conns        []*websocket.Conn
+	unsubscribed chan string
+}
+
+func (srv *subscriptionServer) ServeHTTP(rw http.ResponseWriter, req *http.Request) {
+	conn, err := upgrader.Upgrade(rw, req, nil)
+	if err != nil {
+		return
+	}
+
+	srv.mux.Lock()
+	srv.conns = append(srv.conns, conn)
+	connNum := len(srv.conns)
+	srv.mux.Unlock()
+
+	go func() {
+		defer conn.Close()
+		for {
+			msg := new(jsonrpc.RequestMsg)
+			if err := conn.ReadJSON(msg); err != nil {
+				return
+			}
+
+			resp := (&jsonrpc.ResponseMsg{}).WithVersion(msg.Version).WithID(msg.ID)
+			switch msg.Method {
+			case "eth_subscribe":
+				subID := fmt.Sprintf("0x%d", connNum)
+				_ = conn.WriteJSON(resp.WithResult(subID))
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(fmt.Sprintf(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":%q,"result":%d}}`, subID, connNum)))
+			case "eth_unsubscribe":
+				var params []string
+				_ = msg.UnmarshalParams(&params)
+				srv.unsubscribed <- params[0]
+				_ = conn.WriteJSON(resp.WithResult(true))
+			default:
+				_ = conn.WriteJSON(resp.WithResult(msg.Params))
+			}
+		}
+	}()
+}
+
+// drop closes the connections to the node without any close message
+func (srv *subscriptionServer) drop() {
+	srv.mux.Lock()
+	defer srv.mux.Unlock()
+	for _, conn := range srv.conns {
+		conn.Close()
+	}
+}
+
+func readNotification(t *testing.T, conn *websocket.Conn) (method, subscription string, result int) {
+	raw := make(map[string]json.RawMessage)
+	err := conn.ReadJSON(&raw)
+	require.NoError(t, err, "ReadJSON must not error")
+
+	_, hasID := raw["id"]
+	assert.False(t, hasID, "Notification must not have an ID")
+
+	params := new(struct {
+		Subscription string `json:"subscription"`
+		Result       int    `json:"result"`
+	})
+	_ = json.Unmarshal(raw["method"], &method)
+	err = json.Unmarshal(raw["params"], params)
+	require.NoError(t, err, "Unmarshal params must not error")
+
+	return method, params.Subscription, params.Result
+}
+
+func TestNodeWebSocketSubscriptions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	srv := &subscriptionServer{unsubscribed: make(chan string, 1)}
+	rpcServer := httptest.NewServer(srv)
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+			Proxy: &ProxyConfig{
+				WebSocket: &pkgwebsocket.ProxyConfig{
+					Reconnect: &pkgwebsocket.ReconnectConfig{Interval: &pkgjson.Duration{Duration: 10 * time.Millisecond}},
+				},
+			},
+		},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	defer func() { _ = n.Stop(context.Background()) }()
+
+	proxySrv := httptest.NewServer(n)
+	defer proxySrv.Close()
+
+	clientConn, _, err := dialer.Dial(fmt.Sprintf("ws://%v", proxySrv.Listener.Addr().String()), nil)
+	require.NoError(t, err, "Dial must not error")
+	defer clientConn.Close()
+
+	err = clientConn.WriteJSON(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_subscribe").WithID(1).WithParams([]string{"newHeads"}))
+	require.NoError(t, err, "WriteJSON must not error")
+
+	respMsg := new(jsonrpc.ResponseMsg)
+	err = clientConn.ReadJSON(respMsg)
+	require.NoError(t, err, "ReadJSON must not error")
+	assertResponse(t, respMsg, "2.0", 1, "0x1")
+
+	method, subID, result := readNotification(t, clientConn)
+	assert.Equal(t, "eth_subscription", method)
+	assert.Equal(t, "0x1", subID)
+	assert.Equal(t, 1, result)
+
+	t.Run("should restore subscriptions with the client ID when the node connection drops", func(t *testing.T) {
+		srv.drop()
+
+		_, subID, result := readNotification(t, clientConn)
+		assert.Equal(t, "0x1", subID, "Client must keep its subscription ID")
+		assert.Equal(t, 2, result, "Notification must come from the new connection")
+	})
+
+	t.Run("should unsubscribe with the upstream ID", func(t *testing.T) {
+		err := clientConn.WriteJSON(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_unsubscribe").WithID(2).WithParams([]string{"0x1"}))
+		require.NoError(t, err, "WriteJSON must not error")
+
+		respMsg := new(jsonrpc.ResponseMsg)
+		err = clientConn.ReadJSON(respMsg)
+		require.NoError(t, err, "ReadJSON must not error")
+		assertResponse(t, respMsg, "2.0", 2, true)
+		assert.Equal(t, "0x2", <-srv.unsubscribed)
+	})
+}
+
+func TestNodeWebSocketConcurrentRequests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	rpcServer := httptest.NewServer(&subscriptionServer{})
+	defer rpcServer.Close()
+
+	cfg := (&Config{
+		RPC: &DownstreamConfig{
+			Addr: rpcServer.URL,
+		},
+	}).SetDefault()
+
+	n, err := New(cfg, testutils.NewMockLogger(ctrl))
+	require.NoError(t, err, "New must not error")
+
+	release := make(chan struct{})
+	router := jsonrpc.NewRouter().DefaultHandler(ProxyHandler)
+	router.Method("slow").Handle(jsonrpc.DefaultRWHandler(jsonrpc.HandlerFunc(func(rw jsonrpc.ResponseWriter, msg *jsonrpc.RequestMsg) {
+		<-release
+		_ = jsonrpc.WriteResult(rw, "slow")
+	})))
+	n.Handler = router
+
+	err = n.Start(context.Background())
+	require.NoError(t, err, "Start must not error")
+	defer func() { _ = n.Stop(context.Background()) }()
+
+	proxySrv := httptest.NewServer(n)
+	defer proxySrv.Close()
+
+	clientConn, _, err := dialer.Dial(fmt.Sprintf("ws://%v", proxySrv.Listener.Addr().String()), nil)
+	require.NoError(t, err, "Dial must not error")
+	defer clientConn.Close()
+
+	err = clientConn.WriteJSON(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("slow").WithID("1"))
+	require.NoError(t, err, "WriteJSON must not error")
+	err = clientConn.WriteJSON(new(jsonrpc.RequestMsg).WithVersion("2.0").WithMethod("eth_blockNumber").WithID("2").WithParams("fast"))
+	require.NoError(t, err, "WriteJSON must not error")
+
+	respMsg := new(jsonrpc.ResponseMsg)
+	err = clientConn.ReadJSON(respMsg)
+	require.NoError(t, err, "ReadJSON must not error")
+	assertResponse(t, respMsg, "2.0", "2", "fast")
+
+	close(release)
+
+	respMsg = new(jsonrpc.ResponseMsg)
+	err = clientConn.ReadJSON(respMsg)
+	require.NoError(t, err, "ReadJSON must not error")
+	assertResponse(t, respMsg, "2.0", "1", "slow")
+}
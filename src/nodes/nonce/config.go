@@ -0,0 +1,11 @@
+package nonce
+
+const (
+	MemoryBackend   = "memory"
+	PostgresBackend = "postgres"
+)
+
+type Config struct {
+	// Backend stores the nonces of the accounts, either in process ('memory') or shared between instances ('postgres')
+	Backend string
+}
@@ -0,0 +1,69 @@
+package nonce
+
+import (
+	"context"
+	"sync"
+)
+
+type memoryNonce struct {
+	mux  sync.Mutex
+	next *uint64
+}
+
+// MemoryStore keeps the nonces in process, for single node deployments
+type MemoryStore struct {
+	mux    sync.Mutex
+	nonces map[string]*memoryNonce
+}
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		nonces: make(map[string]*memoryNonce),
+	}
+}
+
+func (m *MemoryStore) Next(ctx context.Context, key string, fetch FetchFunc) (uint64, error) {
+	n := m.nonce(key)
+
+	// Allocations of an account are serialized so the nonce is fetched only once
+	n.mux.Lock()
+	defer n.mux.Unlock()
+
+	if n.next == nil {
+		next, err := fetch(ctx)
+		if err != nil {
+			return 0, err
+		}
+		n.next = &next
+	}
+
+	nonce := *n.next
+	*n.next++
+
+	return nonce, nil
+}
+
+func (m *MemoryStore) Reset(_ context.Context, key string) error {
+	n := m.nonce(key)
+
+	n.mux.Lock()
+	n.next = nil
+	n.mux.Unlock()
+
+	return nil
+}
+
+func (m *MemoryStore) nonce(key string) *memoryNonce {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	n, ok := m.nonces[key]
+	if !ok {
+		n = &memoryNonce{}
+		m.nonces[key] = n
+	}
+
+	return n
+}
@@ -0,0 +1,80 @@
+package nonce
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+
+	fetchFrom := func(n uint64, calls *int) FetchFunc {
+		return func(context.Context) (uint64, error) {
+			*calls++
+			return n, nil
+		}
+	}
+
+	t.Run("should fetch the nonce once and allocate distinct nonces concurrently", func(t *testing.T) {
+		store := NewMemoryStore()
+		calls := 0
+
+		wg := sync.WaitGroup{}
+		mux := sync.Mutex{}
+		nonces := make(map[uint64]bool)
+		for i := 0; i < 20; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n, err := store.Next(ctx, "key-concurrent", fetchFrom(5, &calls))
+				require.NoError(t, err)
+
+				mux.Lock()
+				nonces[n] = true
+				mux.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, 1, calls)
+		assert.Len(t, nonces, 20)
+		for n := uint64(5); n < 25; n++ {
+			assert.True(t, nonces[n], "nonce %d should be allocated", n)
+		}
+	})
+
+	t.Run("should fetch the nonce again after reset", func(t *testing.T) {
+		store := NewMemoryStore()
+		calls := 0
+
+		n, err := store.Next(ctx, "key-reset", fetchFrom(3, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), n)
+
+		err = store.Reset(ctx, "key-reset")
+		require.NoError(t, err)
+
+		n, err = store.Next(ctx, "key-reset", fetchFrom(10, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), n)
+		assert.Equal(t, 2, calls)
+	})
+
+	t.Run("should fail and not allocate if the nonce cannot be fetched", func(t *testing.T) {
+		store := NewMemoryStore()
+		expectedErr := fmt.Errorf("error")
+
+		_, err := store.Next(ctx, "key-error", func(context.Context) (uint64, error) { return 0, expectedErr })
+		assert.Equal(t, expectedErr, err)
+
+		calls := 0
+		n, err := store.Next(ctx, "key-error", fetchFrom(7, &calls))
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), n)
+	})
+}
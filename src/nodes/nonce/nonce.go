@@ -0,0 +1,36 @@
+package nonce
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+//go:generate mockgen -source=nonce.go -destination=mock/nonce.go -package=mock
+
+// FetchFunc retrieves the next nonce of an account from the chain
+type FetchFunc func(ctx context.Context) (uint64, error)
+
+// Store allocates the nonces of the transactions sent by the accounts
+type Store interface {
+	// Next allocates the next nonce of the account identified by key.
+	// The nonce is retrieved with fetch if it is not known yet
+	Next(ctx context.Context, key string, fetch FetchFunc) (uint64, error)
+
+	// Reset forgets the nonce of the account identified by key so it is retrieved from the chain on the next allocation
+	Reset(ctx context.Context, key string) error
+}
+
+// Key identifies the nonce of an account on the chain of a node.
+// The scope distinguishes the private nonces of an account (ie. privacy group)
+func Key(node string, chainID *big.Int, addr ethcommon.Address, scope string) string {
+	key := fmt.Sprintf("%s:%s:%s", node, chainID.String(), strings.ToLower(addr.Hex()))
+	if scope != "" {
+		key = fmt.Sprintf("%s:%s", key, scope)
+	}
+
+	return key
+}
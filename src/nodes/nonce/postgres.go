@@ -0,0 +1,78 @@
+package nonce
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+)
+
+// No row is returned if the nonce of the account is not known
+const nextNonceQuery = `
+UPDATE nonces SET nonce = nonce + 1, updated_at = now() WHERE key = ?0
+RETURNING nonce - 1`
+
+// The fetched nonce is only used if no other instance has allocated a higher nonce in the meantime
+const initNonceQuery = `
+INSERT INTO nonces AS n (key, nonce, updated_at) VALUES (?0, ?1 + 1, now())
+ON CONFLICT (key) DO UPDATE SET
+	nonce = GREATEST(n.nonce, ?1) + 1,
+	updated_at = now()
+RETURNING nonce - 1`
+
+const resetNonceQuery = `DELETE FROM nonces WHERE key = ?0 RETURNING nonce`
+
+// PostgresStore shares the nonces between the instances of the application
+type PostgresStore struct {
+	client postgres.Client
+	logger log.Logger
+}
+
+var _ Store = &PostgresStore{}
+
+func NewPostgresStore(client postgres.Client, logger log.Logger) *PostgresStore {
+	return &PostgresStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (p *PostgresStore) Next(ctx context.Context, key string, fetch FetchFunc) (uint64, error) {
+	var nonce uint64
+	err := p.client.QueryOne(ctx, &nonce, nextNonceQuery, key)
+	if err == nil {
+		return nonce, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		errMessage := "failed to allocate nonce"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	next, err := fetch(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	err = p.client.QueryOne(ctx, &nonce, initNonceQuery, key, next)
+	if err != nil {
+		errMessage := "failed to initialize nonce"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nonce, nil
+}
+
+func (p *PostgresStore) Reset(ctx context.Context, key string) error {
+	var nonce uint64
+	err := p.client.QueryOne(ctx, &nonce, resetNonceQuery, key)
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to reset nonce"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
@@ -2,18 +2,83 @@ package nodes
 
 import (
 	"github.com/consensys/quorum-key-manager/pkg/app"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	aliasconn "github.com/consensys/quorum-key-manager/src/aliases/connector"
+	aliasent "github.com/consensys/quorum-key-manager/src/aliases/entities"
+	aliaspg "github.com/consensys/quorum-key-manager/src/aliases/store/postgres"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	manifestsmanager "github.com/consensys/quorum-key-manager/src/manifests/manager"
 	nodesapi "github.com/consensys/quorum-key-manager/src/nodes/api"
 	nodesmanager "github.com/consensys/quorum-key-manager/src/nodes/manager"
+	"github.com/consensys/quorum-key-manager/src/nodes/nonce"
+	"github.com/consensys/quorum-key-manager/src/nodes/transactions"
 	"github.com/consensys/quorum-key-manager/src/stores"
 )
 
 func RegisterService(a *app.App, logger log.Logger) error {
+	cfg := new(Config)
+	err := a.ServiceConfig(cfg)
+	if err != nil {
+		return err
+	}
+
+	// Postgres client is shared by the stores of the nodes using the postgres backend
+	var postgresClient *client.PostgresClient
+	getPostgresClient := func() (*client.PostgresClient, error) {
+		if postgresClient != nil {
+			return postgresClient, nil
+		}
+
+		var err2 error
+		postgresClient, err2 = client.NewClient(cfg.Postgres)
+		return postgresClient, err2
+	}
+
+	// Create nonce store, nonces are shared through Postgres if configured so
+	nonceCfg := cfg.Nonce
+	if nonceCfg == nil {
+		nonceCfg = &nonce.Config{}
+	}
+
+	var nonces nonce.Store
+	switch nonceCfg.Backend {
+	case nonce.PostgresBackend:
+		pgClient, err2 := getPostgresClient()
+		if err2 != nil {
+			return err2
+		}
+		nonces = nonce.NewPostgresStore(pgClient, logger.WithComponent("nonces"))
+	case nonce.MemoryBackend, "":
+		nonces = nonce.NewMemoryStore()
+	default:
+		return errors.ConfigError("invalid nonce backend %q", nonceCfg.Backend)
+	}
+
+	// Create transactions store, transactions are kept across restarts in Postgres if configured so
+	txsCfg := cfg.Txs
+	if txsCfg == nil {
+		txsCfg = &transactions.Config{}
+	}
+
+	var txs transactions.Store
+	switch txsCfg.Backend {
+	case transactions.PostgresBackend:
+		pgClient, err2 := getPostgresClient()
+		if err2 != nil {
+			return err2
+		}
+		txs = transactions.NewPostgresStore(pgClient, logger.WithComponent("transactions"))
+	case transactions.MemoryBackend, "":
+		txs = transactions.NewMemoryStore()
+	default:
+		return errors.ConfigError("invalid transactions backend %q", txsCfg.Backend)
+	}
+
 	// Load manifests service
 	manifestManager := new(manifestsmanager.Manager)
-	err := a.Service(manifestManager)
+	err = a.Service(manifestManager)
 	if err != nil {
 		return err
 	}
@@ -32,15 +97,33 @@ func RegisterService(a *app.App, logger log.Logger) error {
 		return err
 	}
 
+	// Aliases referenced by the private transactions are read from the alias registries in Postgres
+	var aliases aliasent.Connector
+	if cfg.Postgres != nil {
+		pgClient, err2 := getPostgresClient()
+		if err2 != nil {
+			return err2
+		}
+		aliases = aliasconn.NewConnector(aliaspg.NewDatabase(pgClient), *authManager, logger.WithComponent("aliases"))
+	}
+
 	// Create and register nodes service
-	nodes := nodesmanager.New(*storeManager, *manifestManager, *authManager, logger)
+	nodes := nodesmanager.New(*storeManager, *manifestManager, *authManager, nonces, txs, aliases, logger)
 	err = a.RegisterService(nodes)
 	if err != nil {
 		return err
 	}
 
+	// Create and register the watcher following the transactions sent through the nodes
+	watcher := transactions.NewWatcher(txs, nodes.Caller, txsCfg, logger.WithComponent("transactions-watcher"))
+	err = a.RegisterService(watcher)
+	if err != nil {
+		return err
+	}
+
 	// Create and register nodes API
 	nodesapi.New(nodes).Register(a.Router())
+	nodesapi.NewTransactionsAPI(txs, nodes, *authManager, logger).Register(a.Router())
 
 	return nil
 }
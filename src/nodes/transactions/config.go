@@ -0,0 +1,22 @@
+package transactions
+
+import "time"
+
+const (
+	MemoryBackend   = "memory"
+	PostgresBackend = "postgres"
+)
+
+const (
+	DefaultWatchInterval = 15 * time.Second
+	DefaultDropTimeout   = 10 * time.Minute
+)
+
+type Config struct {
+	// Backend stores the transactions sent through the nodes, either in process ('memory') or shared between instances ('postgres')
+	Backend string
+	// WatchInterval is the interval between two polls of the receipts of the pending transactions
+	WatchInterval time.Duration
+	// DropTimeout is the time after which a pending transaction unknown to the node is considered dropped
+	DropTimeout time.Duration
+}
@@ -0,0 +1,135 @@
+package transactions
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+type memoryKey struct {
+	node string
+	hash ethcommon.Hash
+}
+
+// MemoryStore keeps the transactions in process, they are lost on restart
+type MemoryStore struct {
+	mux sync.RWMutex
+	txs map[memoryKey]*Transaction
+}
+
+var _ Store = &MemoryStore{}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		txs: make(map[memoryKey]*Transaction),
+	}
+}
+
+func (m *MemoryStore) Add(_ context.Context, tx *Transaction) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	key := memoryKey{tx.Node, tx.Hash}
+	if _, ok := m.txs[key]; ok {
+		return errors.AlreadyExistsError("transaction already exists")
+	}
+
+	now := time.Now().UTC()
+	tx.CreatedAt, tx.UpdatedAt = now, now
+	m.txs[key] = copyTx(tx)
+
+	return nil
+}
+
+func (m *MemoryStore) Update(_ context.Context, tx *Transaction) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	key := memoryKey{tx.Node, tx.Hash}
+	stored, ok := m.txs[key]
+	if !ok {
+		return errors.NotFoundError("transaction not found")
+	}
+
+	tx.CreatedAt, tx.UpdatedAt = stored.CreatedAt, time.Now().UTC()
+	m.txs[key] = copyTx(tx)
+
+	return nil
+}
+
+func (m *MemoryStore) Get(_ context.Context, node string, hash ethcommon.Hash) (*Transaction, error) {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	tx, ok := m.txs[memoryKey{node, hash}]
+	if !ok {
+		return nil, errors.NotFoundError("transaction not found")
+	}
+
+	return copyTx(tx), nil
+}
+
+func (m *MemoryStore) List(_ context.Context, filter *Filter) ([]*Transaction, error) {
+	m.mux.RLock()
+	defer m.mux.RUnlock()
+
+	var txs []*Transaction
+	for _, tx := range m.txs {
+		if filter.match(tx) {
+			txs = append(txs, copyTx(tx))
+		}
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].CreatedAt.After(txs[j].CreatedAt)
+	})
+
+	if filter.Offset >= uint64(len(txs)) {
+		return []*Transaction{}, nil
+	}
+	txs = txs[filter.Offset:]
+
+	if filter.Limit != 0 && filter.Limit < uint64(len(txs)) {
+		txs = txs[:filter.Limit]
+	}
+
+	return txs, nil
+}
+
+func (f *Filter) match(tx *Transaction) bool {
+	switch {
+	case f.Nodes != nil && !contains(f.Nodes, tx.Node):
+		return false
+	case f.Hash != nil && *f.Hash != tx.Hash:
+		return false
+	case f.From != nil && *f.From != tx.From:
+		return false
+	case f.Nonce != nil && *f.Nonce != tx.Nonce:
+		return false
+	case f.Status != "" && f.Status != tx.Status:
+		return false
+	case f.Tenants != nil && tx.Tenant != "" && !contains(f.Tenants, tx.Tenant):
+		return false
+	default:
+		return true
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func copyTx(tx *Transaction) *Transaction {
+	cpy := *tx
+	return &cpy
+}
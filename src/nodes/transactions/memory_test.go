@@ -0,0 +1,80 @@
+package transactions
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+
+	newTx := func(node string, nonce uint64, tenant string) *Transaction {
+		return &Transaction{
+			Hash:   ethcommon.BigToHash(new(big.Int).SetUint64(nonce + 1)),
+			Node:   node,
+			Kind:   KindPublic,
+			From:   from,
+			Nonce:  nonce,
+			Tenant: tenant,
+			Status: StatusPending,
+		}
+	}
+
+	t.Run("should add, get and update a transaction", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := newTx("node", 0, "")
+
+		require.NoError(t, store.Add(ctx, tx))
+		err := store.Add(ctx, tx)
+		assert.True(t, errors.IsAlreadyExistsError(err))
+
+		tx.Status = StatusMined
+		require.NoError(t, store.Update(ctx, tx))
+
+		stored, err := store.Get(ctx, "node", tx.Hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusMined, stored.Status)
+		assert.False(t, stored.CreatedAt.IsZero())
+
+		_, err = store.Get(ctx, "other-node", tx.Hash)
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should list transactions matching the filter, the most recent first", func(t *testing.T) {
+		store := NewMemoryStore()
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			require.NoError(t, store.Add(ctx, newTx("node-one", nonce, "tenantOne")))
+			time.Sleep(time.Millisecond)
+		}
+		require.NoError(t, store.Add(ctx, newTx("node-two", 0, "tenantTwo")))
+
+		txs, err := store.List(ctx, &Filter{Nodes: []string{"node-one"}, Limit: 2, Offset: 1})
+		require.NoError(t, err)
+		require.Len(t, txs, 2)
+		assert.Equal(t, uint64(2), txs[0].Nonce)
+		assert.Equal(t, uint64(1), txs[1].Nonce)
+
+		txs, err = store.List(ctx, &Filter{Tenants: []string{"tenantTwo"}})
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		assert.Equal(t, "node-two", txs[0].Node)
+
+		nonce := uint64(3)
+		txs, err = store.List(ctx, &Filter{From: &from, Nonce: &nonce, Status: StatusPending})
+		require.NoError(t, err)
+		require.Len(t, txs, 1)
+		assert.Equal(t, "node-one", txs[0].Node)
+
+		txs, err = store.List(ctx, &Filter{Offset: 10})
+		require.NoError(t, err)
+		assert.Empty(t, txs)
+	})
+}
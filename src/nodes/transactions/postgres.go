@@ -0,0 +1,218 @@
+package transactions
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/go-pg/pg/v10"
+)
+
+// transactionKey identifies a transaction in the table as transactions are stored per node
+const transactionKey = "node || ':' || hash"
+
+type transactionModel struct {
+	tableName struct{} `pg:"transactions"` // nolint:unused,structcheck // reason
+
+	Node        string `pg:",pk"`
+	Hash        string `pg:",pk"`
+	Kind        string
+	From        string `pg:"from_address"`
+	To          string `pg:"to_address"`
+	Nonce       uint64 `pg:",use_zero"`
+	Raw         []byte
+	Tenant      string
+	Username    string
+	Status      string
+	BlockNumber *uint64
+	ReplacedBy  string
+	CreatedAt   time.Time `pg:"default:now()"`
+	UpdatedAt   time.Time `pg:"default:now()"`
+}
+
+func newTransactionModel(tx *Transaction) *transactionModel {
+	m := &transactionModel{
+		Node:        tx.Node,
+		Hash:        tx.Hash.Hex(),
+		Kind:        string(tx.Kind),
+		From:        strings.ToLower(tx.From.Hex()),
+		Nonce:       tx.Nonce,
+		Raw:         tx.Raw,
+		Tenant:      tx.Tenant,
+		Username:    tx.Username,
+		Status:      string(tx.Status),
+		BlockNumber: tx.BlockNumber,
+		CreatedAt:   tx.CreatedAt,
+		UpdatedAt:   tx.UpdatedAt,
+	}
+
+	if tx.To != nil {
+		m.To = strings.ToLower(tx.To.Hex())
+	}
+	if tx.ReplacedBy != nil {
+		m.ReplacedBy = tx.ReplacedBy.Hex()
+	}
+
+	return m
+}
+
+func (m *transactionModel) toEntity() *Transaction {
+	tx := &Transaction{
+		Hash:        ethcommon.HexToHash(m.Hash),
+		Node:        m.Node,
+		Kind:        Kind(m.Kind),
+		From:        ethcommon.HexToAddress(m.From),
+		Nonce:       m.Nonce,
+		Raw:         m.Raw,
+		Tenant:      m.Tenant,
+		Username:    m.Username,
+		Status:      Status(m.Status),
+		BlockNumber: m.BlockNumber,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+
+	if m.To != "" {
+		to := ethcommon.HexToAddress(m.To)
+		tx.To = &to
+	}
+	if m.ReplacedBy != "" {
+		replacedBy := ethcommon.HexToHash(m.ReplacedBy)
+		tx.ReplacedBy = &replacedBy
+	}
+
+	return tx
+}
+
+// PostgresStore shares the transactions between the instances of the application
+type PostgresStore struct {
+	client postgres.Client
+	logger log.Logger
+}
+
+var _ Store = &PostgresStore{}
+
+func NewPostgresStore(client postgres.Client, logger log.Logger) *PostgresStore {
+	return &PostgresStore{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (p *PostgresStore) Add(ctx context.Context, tx *Transaction) error {
+	now := time.Now().UTC()
+	tx.CreatedAt, tx.UpdatedAt = now, now
+
+	err := p.client.Insert(ctx, newTransactionModel(tx))
+	if err != nil {
+		errMessage := "failed to add transaction"
+		p.logger.With("node", tx.Node, "tx_hash", tx.Hash).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (p *PostgresStore) Update(ctx context.Context, tx *Transaction) error {
+	tx.UpdatedAt = time.Now().UTC()
+
+	err := p.client.UpdatePK(ctx, newTransactionModel(tx))
+	if err != nil {
+		errMessage := "failed to update transaction"
+		p.logger.With("node", tx.Node, "tx_hash", tx.Hash).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
+
+func (p *PostgresStore) Get(ctx context.Context, node string, hash ethcommon.Hash) (*Transaction, error) {
+	m := &transactionModel{Node: node, Hash: hash.Hex()}
+	err := p.client.SelectPK(ctx, m)
+	if err != nil {
+		errMessage := "failed to get transaction"
+		p.logger.With("node", node, "tx_hash", hash).WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return m.toEntity(), nil
+}
+
+func (p *PostgresStore) List(ctx context.Context, filter *Filter) ([]*Transaction, error) {
+	whereCond, whereArgs := filter.condition()
+
+	// Keys are selected first so the transactions can be paginated in order of creation
+	var query string
+	switch {
+	case filter.Limit != 0 || filter.Offset != 0:
+		query = fmt.Sprintf("SELECT (array_agg(%s ORDER BY created_at DESC))[%d:%d] FROM transactions WHERE %s", transactionKey, filter.Offset+1, filter.Offset+filter.Limit, whereCond)
+	default:
+		query = fmt.Sprintf("SELECT array_agg(%s ORDER BY created_at DESC) FROM transactions WHERE %s", transactionKey, whereCond)
+	}
+
+	var keys []string
+	err := p.client.Query(ctx, &keys, query, whereArgs...)
+	if err != nil {
+		errMessage := "failed to list transactions"
+		p.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	txs := []*Transaction{}
+	if len(keys) == 0 {
+		return txs, nil
+	}
+
+	var models []*transactionModel
+	err = p.client.SelectWhere(ctx, &models, transactionKey+" IN (?)", pg.In(keys))
+	if err != nil {
+		errMessage := "failed to list transactions"
+		p.logger.WithError(err).Error(errMessage)
+		return nil, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	for _, m := range models {
+		txs = append(txs, m.toEntity())
+	}
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].CreatedAt.After(txs[j].CreatedAt)
+	})
+
+	return txs, nil
+}
+
+func (f *Filter) condition() (whereCond string, whereArgs []interface{}) {
+	conds := []string{"TRUE"}
+	if f.Nodes != nil {
+		conds = append(conds, "node IN (?)")
+		whereArgs = append(whereArgs, pg.In(f.Nodes))
+	}
+	if f.Hash != nil {
+		conds = append(conds, "hash = ?")
+		whereArgs = append(whereArgs, f.Hash.Hex())
+	}
+	if f.From != nil {
+		conds = append(conds, "from_address = ?")
+		whereArgs = append(whereArgs, strings.ToLower(f.From.Hex()))
+	}
+	if f.Nonce != nil {
+		conds = append(conds, "nonce = ?")
+		whereArgs = append(whereArgs, *f.Nonce)
+	}
+	if f.Status != "" {
+		conds = append(conds, "status = ?")
+		whereArgs = append(whereArgs, string(f.Status))
+	}
+	if f.Tenants != nil {
+		conds = append(conds, "(tenant IS NULL OR tenant = '' OR tenant IN (?))")
+		whereArgs = append(whereArgs, pg.In(f.Tenants))
+	}
+
+	return strings.Join(conds, " AND "), whereArgs
+}
@@ -0,0 +1,85 @@
+package transactions
+
+import (
+	"context"
+	"time"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+//go:generate mockgen -source=transactions.go -destination=mock/transactions.go -package=mock
+
+type Status string
+
+const (
+	StatusPending  Status = "pending"
+	StatusMined    Status = "mined"
+	StatusFailed   Status = "failed"
+	StatusDropped  Status = "dropped"
+	StatusReplaced Status = "replaced"
+)
+
+type Kind string
+
+const (
+	KindPublic        Kind = "public"
+	KindQuorumPrivate Kind = "quorum-private"
+	KindEEAPrivate    Kind = "eea-private"
+)
+
+// Transaction is a transaction signed and sent by the key manager through a node
+type Transaction struct {
+	Hash  ethcommon.Hash
+	Node  string
+	Kind  Kind
+	From  ethcommon.Address
+	To    *ethcommon.Address
+	Nonce uint64
+	Raw   []byte
+
+	// Tenant and Username identify the caller who sent the transaction
+	Tenant   string
+	Username string
+
+	Status      Status
+	BlockNumber *uint64
+	// ReplacedBy is the hash of the transaction mined with the same nonce, if known
+	ReplacedBy *ethcommon.Hash
+
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+// IsFinal indicates whether the status of the transaction will not change anymore
+func (tx *Transaction) IsFinal() bool {
+	return tx.Status != StatusPending
+}
+
+// Filter selects transactions, empty fields match any transaction
+type Filter struct {
+	Nodes  []string
+	Hash   *ethcommon.Hash
+	From   *ethcommon.Address
+	Nonce  *uint64
+	Status Status
+	// Tenants restricts the transactions to the ones sent by the tenants, nil matches any tenant
+	Tenants []string
+
+	Limit  uint64
+	Offset uint64
+}
+
+// Store persists the transactions sent through the nodes
+type Store interface {
+	// Add records a transaction
+	Add(ctx context.Context, tx *Transaction) error
+
+	// Update updates the status of a transaction
+	Update(ctx context.Context, tx *Transaction) error
+
+	// Get returns a transaction by node and hash
+	Get(ctx context.Context, node string, hash ethcommon.Hash) (*Transaction, error)
+
+	// List returns the transactions matching the filter, the most recent first
+	List(ctx context.Context, filter *Filter) ([]*Transaction, error)
+}
@@ -0,0 +1,201 @@
+package transactions
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+)
+
+// CallerFunc returns a caller to the node a transaction has been sent through
+type CallerFunc func(node string) (ethereum.Caller, error)
+
+// Watcher polls the receipts of the pending transactions and updates their status
+// once they are mined, dropped by the node or replaced by another transaction with the same nonce
+type Watcher struct {
+	store       Store
+	callers     CallerFunc
+	interval    time.Duration
+	dropTimeout time.Duration
+
+	startOnce sync.Once
+	stop      chan struct{}
+	done      chan struct{}
+
+	logger log.Logger
+}
+
+func NewWatcher(store Store, callers CallerFunc, cfg *Config, logger log.Logger) *Watcher {
+	w := &Watcher{
+		store:       store,
+		callers:     callers,
+		interval:    DefaultWatchInterval,
+		dropTimeout: DefaultDropTimeout,
+		stop:        make(chan struct{}),
+		done:        make(chan struct{}),
+		logger:      logger,
+	}
+
+	if cfg != nil && cfg.WatchInterval > 0 {
+		w.interval = cfg.WatchInterval
+	}
+	if cfg != nil && cfg.DropTimeout > 0 {
+		w.dropTimeout = cfg.DropTimeout
+	}
+
+	return w
+}
+
+func (w *Watcher) Start(context.Context) error {
+	w.startOnce.Do(func() {
+		go func() {
+			defer close(w.done)
+
+			ticker := time.NewTicker(w.interval)
+			defer ticker.Stop()
+
+			for {
+				select {
+				case <-w.stop:
+					return
+				case <-ticker.C:
+					w.watch()
+				}
+			}
+		}()
+	})
+
+	return nil
+}
+
+func (w *Watcher) Stop(ctx context.Context) error {
+	// Nothing to wait for if never started
+	w.startOnce.Do(func() { close(w.done) })
+
+	select {
+	case <-w.stop:
+	default:
+		close(w.stop)
+	}
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (w *Watcher) Error() error {
+	return nil
+}
+
+func (w *Watcher) Close() error {
+	return nil
+}
+
+// watch checks all the pending transactions, a poll is cancelled after one interval
+func (w *Watcher) watch() {
+	ctx, cancel := context.WithTimeout(context.Background(), w.interval)
+	defer cancel()
+
+	txs, err := w.store.List(ctx, &Filter{Status: StatusPending})
+	if err != nil {
+		w.logger.WithError(err).Error("failed to list pending transactions")
+		return
+	}
+
+	for _, tx := range txs {
+		if ctx.Err() != nil {
+			return
+		}
+
+		caller, err := w.callers(tx.Node)
+		if err != nil {
+			w.logger.WithError(err).Debug("node of pending transaction not available", "node", tx.Node, "tx_hash", tx.Hash)
+			continue
+		}
+
+		err = w.check(ctx, caller, tx)
+		if err != nil {
+			w.logger.WithError(err).Warn("failed to check pending transaction", "node", tx.Node, "tx_hash", tx.Hash)
+		}
+	}
+}
+
+// check updates the status of a pending transaction
+func (w *Watcher) check(ctx context.Context, caller ethereum.Caller, tx *Transaction) error {
+	// Nonce is read before the receipt so a transaction mined in between is not mistaken for a replaced one.
+	// Private nonces of EEA transactions are not related to the account nonce
+	var nonceUsed bool
+	if tx.Kind != KindEEAPrivate {
+		n, err := caller.Eth().GetTransactionCount(ctx, tx.From, ethereum.LatestBlockNumber)
+		if err != nil {
+			return err
+		}
+		nonceUsed = n > tx.Nonce
+	}
+
+	receipt, err := caller.Eth().GetTransactionReceipt(ctx, tx.Hash)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case receipt != nil:
+		blockNumber := uint64(receipt.BlockNumber)
+		tx.BlockNumber = &blockNumber
+		tx.Status = StatusMined
+		if !receipt.Succeeded() {
+			tx.Status = StatusFailed
+		}
+	case nonceUsed:
+		tx.Status = StatusReplaced
+		tx.ReplacedBy, err = w.replacement(ctx, caller, tx)
+		if err != nil {
+			return err
+		}
+	default:
+		if time.Since(tx.CreatedAt) < w.dropTimeout {
+			return nil
+		}
+
+		info, err2 := caller.Eth().GetTransactionByHash(ctx, tx.Hash)
+		if err2 != nil || info != nil {
+			return err2
+		}
+		tx.Status = StatusDropped
+	}
+
+	w.logger.Info("transaction status updated", "node", tx.Node, "tx_hash", tx.Hash, "status", tx.Status)
+	return w.store.Update(ctx, tx)
+}
+
+// replacement finds, among the transactions sent with the same nonce, the one which has been mined
+func (w *Watcher) replacement(ctx context.Context, caller ethereum.Caller, tx *Transaction) (*ethcommon.Hash, error) {
+	nonce := tx.Nonce
+	candidates, err := w.store.List(ctx, &Filter{Nodes: []string{tx.Node}, From: &tx.From, Nonce: &nonce})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, candidate := range candidates {
+		if candidate.Hash == tx.Hash || candidate.Kind == KindEEAPrivate {
+			continue
+		}
+
+		receipt, err := caller.Eth().GetTransactionReceipt(ctx, candidate.Hash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return &candidate.Hash, nil
+		}
+	}
+
+	// Replaced by a transaction not sent through the key manager
+	return nil, nil
+}
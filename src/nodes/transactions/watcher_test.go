@@ -0,0 +1,119 @@
+package transactions
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	mockethereum "github.com/consensys/quorum-key-manager/pkg/ethereum/mock"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	ctx := context.Background()
+	caller := mockethereum.NewMockCaller(ctrl)
+	ethCaller := mockethereum.NewMockEthCaller(ctrl)
+	caller.EXPECT().Eth().Return(ethCaller).AnyTimes()
+
+	from := ethcommon.HexToAddress("0x78e6e236592597c09d5c137c2af40aecd42d12a2")
+	hash := ethcommon.HexToHash("0x6052dd2131667ef3e0a0666f2812db2defceaec91c470bb43de92268e8306778")
+	otherHash := ethcommon.HexToHash("0xe670ec64341771606e55d6b4ca35a1a6b75ee3d5145a99d05921026d1527331a")
+
+	newWatcher := func(store Store) *Watcher {
+		callers := func(string) (ethereum.Caller, error) { return caller, nil }
+		return NewWatcher(store, callers, &Config{DropTimeout: time.Minute}, testutils.NewMockLogger(ctrl))
+	}
+
+	addTx := func(t *testing.T, store Store, hash ethcommon.Hash, kind Kind) *Transaction {
+		tx := &Transaction{Hash: hash, Node: "node", Kind: kind, From: from, Nonce: 5, Status: StatusPending}
+		require.NoError(t, store.Add(ctx, tx))
+		return tx
+	}
+
+	t.Run("should mark a transaction with a successful receipt as mined", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := addTx(t, store, hash, KindPublic)
+
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), from, ethereum.LatestBlockNumber).Return(uint64(6), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), hash).Return(&ethereum.Receipt{TxHash: hash, BlockNumber: 12, Status: 1}, nil)
+
+		require.NoError(t, newWatcher(store).check(ctx, caller, tx))
+
+		stored, err := store.Get(ctx, "node", hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusMined, stored.Status)
+		assert.Equal(t, uint64(12), *stored.BlockNumber)
+	})
+
+	t.Run("should mark a reverted transaction as failed", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := addTx(t, store, hash, KindEEAPrivate)
+
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), hash).Return(&ethereum.Receipt{TxHash: hash, BlockNumber: 12, Status: 0}, nil)
+
+		require.NoError(t, newWatcher(store).check(ctx, caller, tx))
+
+		stored, err := store.Get(ctx, "node", hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusFailed, stored.Status)
+	})
+
+	t.Run("should mark a transaction as replaced by the mined transaction with the same nonce", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := addTx(t, store, hash, KindPublic)
+		addTx(t, store, otherHash, KindPublic)
+
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), from, ethereum.LatestBlockNumber).Return(uint64(6), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), otherHash).Return(&ethereum.Receipt{TxHash: otherHash, BlockNumber: 12, Status: 1}, nil)
+
+		require.NoError(t, newWatcher(store).check(ctx, caller, tx))
+
+		stored, err := store.Get(ctx, "node", hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusReplaced, stored.Status)
+		assert.Equal(t, otherHash, *stored.ReplacedBy)
+	})
+
+	t.Run("should keep a recent pending transaction", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := addTx(t, store, hash, KindPublic)
+
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), from, ethereum.LatestBlockNumber).Return(uint64(5), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), hash).Return(nil, nil)
+
+		require.NoError(t, newWatcher(store).check(ctx, caller, tx))
+
+		stored, err := store.Get(ctx, "node", hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusPending, stored.Status)
+	})
+
+	t.Run("should mark an old transaction unknown to the node as dropped", func(t *testing.T) {
+		store := NewMemoryStore()
+		tx := addTx(t, store, hash, KindPublic)
+		tx.CreatedAt = time.Now().Add(-time.Hour)
+
+		ethCaller.EXPECT().GetTransactionCount(gomock.Any(), from, ethereum.LatestBlockNumber).Return(uint64(5), nil)
+		ethCaller.EXPECT().GetTransactionReceipt(gomock.Any(), hash).Return(nil, nil)
+		ethCaller.EXPECT().GetTransactionByHash(gomock.Any(), hash).Return(nil, nil)
+
+		require.NoError(t, newWatcher(store).check(ctx, caller, tx))
+
+		stored, err := store.Get(ctx, "node", hash)
+		require.NoError(t, err)
+		assert.Equal(t, StatusDropped, stored.Status)
+	})
+
+	t.Run("should stop without having been started", func(t *testing.T) {
+		require.NoError(t, newWatcher(NewMemoryStore()).Stop(ctx))
+	})
+}
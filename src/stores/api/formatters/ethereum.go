@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	common2 "github.com/consensys/quorum-key-manager/pkg/common"
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/pkg/ethereum"
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
@@ -11,6 +12,7 @@ import (
 	quorumtypes "github.com/consensys/quorum/core/types"
 	"github.com/ethereum/go-ethereum/common/math"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	signer "github.com/ethereum/go-ethereum/signer/core"
 )
 
@@ -61,6 +63,10 @@ func FormatTransaction(tx *types.SignETHTransactionRequest) (*ethtypes.Transacti
 
 	switch tx.TransactionType {
 	case types.LegacyTxType:
+		if len(tx.AccessList) > 0 {
+			return nil, errors.InvalidFormatError(fmt.Sprintf("accessList cannot be set for a %s transaction, use %s instead", types.LegacyTxType, types.AccessListTxType))
+		}
+
 		txData = &ethtypes.LegacyTx{
 			Nonce:    uint64(tx.Nonce),
 			GasPrice: tx.GasPrice.ToInt(),
@@ -107,6 +113,39 @@ func FormatTransaction(tx *types.SignETHTransactionRequest) (*ethtypes.Transacti
 	return ethtypes.NewTx(txData), nil
 }
 
+// FormatImportEthAccountPrivateKey returns the private key of an import request given either as raw private key,
+// keystore v3 JSON with its password or BIP-39 mnemonic with its derivation path
+func FormatImportEthAccountPrivateKey(req *types.ImportEthAccountRequest) ([]byte, error) {
+	sources := 0
+	for _, isSet := range []bool{len(req.PrivateKey) > 0, len(req.Keystore) > 0, req.Mnemonic != ""} {
+		if isSet {
+			sources++
+		}
+	}
+	if sources != 1 {
+		return nil, errors.InvalidFormatError("exactly one of privateKey, keystore or mnemonic must be set")
+	}
+
+	switch {
+	case len(req.Keystore) > 0:
+		privKey, err := pkgcrypto.DecryptKeystore(req.Keystore, req.Password)
+		if err != nil {
+			return nil, errors.InvalidFormatError(fmt.Sprintf("failed to decrypt keystore: %v", err))
+		}
+
+		return crypto.FromECDSA(privKey), nil
+	case req.Mnemonic != "":
+		privKey, err := pkgcrypto.DeriveECDSAFromMnemonic(req.Mnemonic, req.MnemonicPassphrase, req.DerivationPath)
+		if err != nil {
+			return nil, errors.InvalidFormatError(err.Error())
+		}
+
+		return crypto.FromECDSA(privKey), nil
+	default:
+		return req.PrivateKey, nil
+	}
+}
+
 func FormatPrivateTransaction(tx *types.SignQuorumPrivateTransactionRequest) *quorumtypes.Transaction {
 	if tx.To == nil {
 		return quorumtypes.NewContractCreation(uint64(tx.Nonce), tx.Value.ToInt(), uint64(tx.GasLimit), tx.GasPrice.ToInt(), tx.Data)
@@ -148,6 +187,9 @@ func FormatEthAccResponse(ethAcc *entities.ETHAccount) *types.EthAccountResponse
 		CreatedAt:           ethAcc.Metadata.CreatedAt,
 		UpdatedAt:           ethAcc.Metadata.UpdatedAt,
 		Disabled:            ethAcc.Metadata.Disabled,
+		Tenant:              ethAcc.Metadata.Tenant,
+		Shared:              ethAcc.Metadata.Shared,
+		Exportable:          ethAcc.Metadata.Exportable,
 	}
 
 	if !ethAcc.Metadata.DeletedAt.IsZero() {
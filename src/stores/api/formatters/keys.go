@@ -2,9 +2,16 @@ package formatters
 
 import (
 	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	qkmjwt "github.com/consensys/quorum-key-manager/pkg/jwt"
 
 	"github.com/consensys/quorum-key-manager/src/stores/api/types"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
@@ -16,6 +23,9 @@ func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
 		Disabled:         key.Metadata.Disabled,
+		Tenant:           key.Metadata.Tenant,
+		Shared:           key.Metadata.Shared,
+		Exportable:       key.Metadata.Exportable,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
 	}
@@ -26,3 +36,81 @@ func FormatKeyResponse(key *entities.Key) *types.KeyResponse {
 
 	return resp
 }
+
+func FormatSignOptions(req *types.SignBase64PayloadRequest) *entities.SignOptions {
+	return &entities.SignOptions{
+		Hash:     entities.HashAlgorithm(req.HashAlgorithm),
+		Encoding: entities.SignatureEncoding(req.Encoding),
+		LowS:     req.LowS,
+	}
+}
+
+// FormatImportKeyPrivateKey returns the private key of an import request given either as raw private key or as PEM block,
+// optionally encrypted with a passphrase
+func FormatImportKeyPrivateKey(req *types.ImportKeyRequest) ([]byte, error) {
+	if req.PEM == "" {
+		return req.PrivateKey, nil
+	}
+
+	if len(req.PrivateKey) > 0 {
+		return nil, errors.InvalidFormatError("only one of privateKey or pem must be set")
+	}
+
+	if entities.Curve(req.Curve) != entities.Secp256k1 {
+		return nil, errors.InvalidFormatError(fmt.Sprintf("PEM private keys are only supported for the %s curve", entities.Secp256k1))
+	}
+
+	privKey, err := pkgcrypto.ParseECDSAPEM([]byte(req.PEM), req.Passphrase)
+	if err != nil {
+		return nil, errors.InvalidFormatError(fmt.Sprintf("invalid PEM private key: %v", err))
+	}
+
+	return crypto.FromECDSA(privKey), nil
+}
+
+// FormatJWSAlgorithm returns the JWS algorithm (RFC 7518) signing with keys of the given algorithm
+func FormatJWSAlgorithm(algo *entities.Algorithm) (string, error) {
+	if algo.Type == entities.Ecdsa && algo.EllipticCurve == entities.Secp256k1 {
+		return qkmjwt.ES256KAlg, nil
+	}
+
+	return "", errors.NotSupportedError(fmt.Sprintf("%s keys on the %s curve have no JWS algorithm", algo.Type, algo.EllipticCurve))
+}
+
+// unsupportedJWSHeaders are the header parameters changing how the JWS is processed (RFC 7515 and RFC 7797), which are not honored when signing
+var unsupportedJWSHeaders = []string{"b64", "crit"}
+
+// FormatJWS returns the protected header and the payload of a JWS request, alg and kid can not be overridden by the request header
+func FormatJWS(req *types.SignJWTRequest, alg, kid string) (header map[string]interface{}, payload []byte, err error) {
+	if len(req.Claims) > 0 && len(req.Payload) > 0 {
+		return nil, nil, errors.InvalidFormatError("only one of claims or payload must be set")
+	}
+
+	for _, param := range unsupportedJWSHeaders {
+		if _, ok := req.Header[param]; ok {
+			return nil, nil, errors.InvalidFormatError(fmt.Sprintf("header parameter %q is not supported", param))
+		}
+	}
+
+	header = map[string]interface{}{}
+	for k, v := range req.Header {
+		header[k] = v
+	}
+	header["alg"] = alg
+	header["kid"] = kid
+
+	if len(req.Payload) > 0 {
+		return header, req.Payload, nil
+	}
+
+	if _, ok := header["typ"]; !ok {
+		header["typ"] = "JWT"
+	}
+
+	payload, err = json.Marshal(req.Claims)
+	if err != nil {
+		return nil, nil, errors.InvalidFormatError(fmt.Sprintf("invalid claims: %v", err))
+	}
+
+	return header, payload, nil
+}
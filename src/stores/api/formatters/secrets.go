@@ -12,6 +12,8 @@ func FormatSecretResponse(secret *entities.Secret) *types.SecretResponse {
 		Value:     secret.Value,
 		Tags:      secret.Tags,
 		Disabled:  secret.Metadata.Disabled,
+		Tenant:    secret.Metadata.Tenant,
+		Shared:    secret.Metadata.Shared,
 		CreatedAt: secret.Metadata.CreatedAt,
 		UpdatedAt: secret.Metadata.UpdatedAt,
 	}
@@ -43,6 +43,7 @@ func (h *EthHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("/{address}/sign-eea-transaction").HandlerFunc(h.signEEATransaction)
 	r.Methods(http.MethodPost).Path("/{address}/sign-typed-data").HandlerFunc(h.signTypedData)
 	r.Methods(http.MethodPost).Path("/{address}/sign-message").HandlerFunc(h.signMessage)
+	r.Methods(http.MethodPost).Path("/{address}/export").HandlerFunc(h.export)
 	r.Methods(http.MethodPut).Path("/{address}/restore").HandlerFunc(h.restore)
 	r.Methods(http.MethodPatch).Path("/{address}").HandlerFunc(h.update)
 	r.Methods(http.MethodGet).Path("/{address}").HandlerFunc(h.getOne)
@@ -89,7 +90,7 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags})
+	ethAcc, err := ethStore.Create(ctx, keyID, &entities.Attributes{Tags: createReq.Tags, Shared: createReq.Shared, Exportable: createReq.Exportable})
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
 		return
@@ -99,7 +100,7 @@ func (h *EthHandler) create(rw http.ResponseWriter, request *http.Request) {
 }
 
 // @Summary Import Ethereum Account
-// @Description Import an ECDSA Secp256k1 key representing an Ethereum account
+// @Description Import an ECDSA Secp256k1 key representing an Ethereum account, given as private key, keystore v3 JSON and password or BIP-39 mnemonic and derivation path
 // @Accept  json
 // @Produce  json
 // @Tags Ethereum
@@ -123,6 +124,12 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 		return
 	}
 
+	privKey, err := formatters.FormatImportEthAccountPrivateKey(importReq)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
 	userInfo := authenticator.UserInfoContextFromContext(ctx)
 	ethStore, err := h.stores.GetEthStore(ctx, StoreNameFromContext(ctx), userInfo)
 	if err != nil {
@@ -137,7 +144,7 @@ func (h *EthHandler) importAccount(rw http.ResponseWriter, request *http.Request
 		keyID = generateRandomKeyID()
 	}
 
-	ethAcc, err := ethStore.Import(ctx, keyID, importReq.PrivateKey, &entities.Attributes{Tags: importReq.Tags})
+	ethAcc, err := ethStore.Import(ctx, keyID, privKey, &entities.Attributes{Tags: importReq.Tags, Shared: importReq.Shared, Exportable: importReq.Exportable})
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
 		return
@@ -201,6 +208,7 @@ func (h *EthHandler) update(rw http.ResponseWriter, request *http.Request) {
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/ethereum/{address}/sign-message [post]
 func (h *EthHandler) signMessage(rw http.ResponseWriter, request *http.Request) {
@@ -230,6 +238,49 @@ func (h *EthHandler) signMessage(rw http.ResponseWriter, request *http.Request)
 	_, _ = rw.Write([]byte(hexutil.Encode(signature)))
 }
 
+// @Summary Export an Ethereum Account
+// @Description Export an exportable Ethereum Account as a keystore v3 (Web3 Secret Storage) JSON encrypted with the passphrase
+// @Tags Ethereum
+// @Accept json
+// @Produce json
+// @Param storeName path string true "Store Identifier"
+// @Param address path string true "Ethereum address"
+// @Param request body types.ExportEthAccountRequest true "Export request"
+// @Success 200 {object} object "Keystore v3 JSON"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden or account not exportable"
+// @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 501 {object} ErrorResponse "Export not supported by the store"
+// @Router /stores/{storeName}/ethereum/{address}/export [post]
+func (h *EthHandler) export(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	exportReq := &types.ExportEthAccountRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, exportReq)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	ethStore, err := h.stores.GetEthStore(ctx, StoreNameFromContext(ctx), userInfo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	keyJSON, err := ethStore.Export(ctx, getAddress(request), exportReq.Passphrase)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, _ = rw.Write(keyJSON)
+}
+
 // @Summary Sign Typed Data (EIP-712)
 // @Description Sign Typed Data, following EIP-712, using selected Ethereum Account
 // @Tags Ethereum
@@ -243,6 +294,7 @@ func (h *EthHandler) signMessage(rw http.ResponseWriter, request *http.Request)
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/ethereum/{address}/sign-typed-data [post]
 func (h *EthHandler) signTypedData(rw http.ResponseWriter, request *http.Request) {
@@ -286,6 +338,7 @@ func (h *EthHandler) signTypedData(rw http.ResponseWriter, request *http.Request
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/ethereum/{address}/sign-transaction [post]
 func (h *EthHandler) signTransaction(rw http.ResponseWriter, request *http.Request) {
@@ -334,6 +387,7 @@ func (h *EthHandler) signTransaction(rw http.ResponseWriter, request *http.Reque
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/ethereum/{address}/sign-eea-transaction [post]
 func (h *EthHandler) signEEATransaction(rw http.ResponseWriter, request *http.Request) {
@@ -377,6 +431,7 @@ func (h *EthHandler) signEEATransaction(rw http.ResponseWriter, request *http.Re
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Account not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/ethereum/{address}/sign-quorum-private-transaction [post]
 func (h *EthHandler) signPrivateTransaction(rw http.ResponseWriter, request *http.Request) {
@@ -5,6 +5,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -18,8 +19,12 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/api/types/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
@@ -211,6 +216,100 @@ func (s *ethHandlerTestSuite) TestImport() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
+	s.Run("should execute request with a keystore successfully", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		privKey, _ := crypto.ToECDSA(importEthAccountRequest.PrivateKey)
+		keystoreJSON, _ := keystore.EncryptKey(&keystore.Key{
+			Id:         uuid.New(),
+			Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+			PrivateKey: privKey,
+		}, "my-password", keystore.LightScryptN, keystore.LightScryptP)
+		expectedPrivKey := importEthAccountRequest.PrivateKey
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Keystore = keystoreJSON
+		importEthAccountRequest.Password = "my-password"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		acc := testutils2.FakeETHAccount()
+		s.ethStore.EXPECT().Import(gomock.Any(), importEthAccountRequest.KeyID, expectedPrivKey, gomock.Any()).Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should execute request with a mnemonic successfully", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Mnemonic = "test test test test test test test test test test test junk"
+		importEthAccountRequest.DerivationPath = "m/44'/60'/0'/0/1"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		acc := testutils2.FakeETHAccount()
+		s.ethStore.EXPECT().Import(
+			gomock.Any(),
+			importEthAccountRequest.KeyID,
+			hexutil.MustDecode("0x59c6995e998f97a5a0044966f0945389dc9e86dae88c7a8412f4603b6b78690d"),
+			gomock.Any(),
+		).Return(acc, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if the keystore password is invalid", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		privKey, _ := crypto.ToECDSA(importEthAccountRequest.PrivateKey)
+		keystoreJSON, _ := keystore.EncryptKey(&keystore.Key{
+			Id:         uuid.New(),
+			Address:    crypto.PubkeyToAddress(privKey.PublicKey),
+			PrivateKey: privKey,
+		}, "my-password", keystore.LightScryptN, keystore.LightScryptP)
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Keystore = keystoreJSON
+		importEthAccountRequest.Password = "wrong-password"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if the keystore KDF parameters are out of bounds", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.PrivateKey = nil
+		importEthAccountRequest.Keystore = []byte(`{"version":3,"crypto":{"cipher":"aes-128-ctr","ciphertext":"00","cipherparams":{"iv":"00"},"kdf":"scrypt","kdfparams":{"dklen":32,"n":1073741824,"p":1,"r":8,"salt":"00"},"mac":"00"}}`)
+		importEthAccountRequest.Password = "my-password"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	s.Run("should fail with 400 if both private key and mnemonic are set", func() {
+		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
+		importEthAccountRequest.Mnemonic = "test test test test test test test test test test test junk"
+		requestBytes, _ := json.Marshal(importEthAccountRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, "/stores/EthStores/ethereum/import", bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		importEthAccountRequest := testutils.FakeImportEthAccountRequest()
@@ -323,6 +422,44 @@ func (s *ethHandlerTestSuite) TestSignMessage() {
 	})
 }
 
+func (s *ethHandlerTestSuite) TestExport() {
+	s.Run("should export account as keystore successfully", func() {
+		requestBytes, _ := json.Marshal(&apiTypes.ExportEthAccountRequest{Passphrase: "my-passphrase"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		keyJSON := []byte(`{"version":3}`)
+		s.ethStore.EXPECT().Export(gomock.Any(), ethcommon.HexToAddress(accAddress), "my-passphrase").Return(keyJSON, nil)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), string(keyJSON), rw.Body.String())
+		assert.Equal(s.T(), http.StatusOK, rw.Code)
+	})
+
+	s.Run("should fail with 400 if passphrase is missing", func() {
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader([]byte("{}"))).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
+	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
+	s.Run("should fail with correct error code if use case fails", func() {
+		requestBytes, _ := json.Marshal(&apiTypes.ExportEthAccountRequest{Passphrase: "my-passphrase"})
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/export", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.ethStore.EXPECT().Export(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, errors.ForbiddenError("error"))
+
+		s.router.ServeHTTP(rw, httpRequest)
+		assert.Equal(s.T(), http.StatusForbidden, rw.Code)
+	})
+}
+
 func (s *ethHandlerTestSuite) TestSignTransaction() {
 	s.Run("should execute request successfully with default type DYNAMIC_FEE", func() {
 		signTransactionRequest := testutils.FakeSignETHTransactionRequest("")
@@ -356,7 +493,7 @@ func (s *ethHandlerTestSuite) TestSignTransaction() {
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
 
-	s.Run("should execute request successfully for DYNAMIC_FEE", func() {
+	s.Run("should execute request successfully for ACCESS_LIST", func() {
 		signTransactionRequest := testutils.FakeSignETHTransactionRequest(apiTypes.AccessListTxType)
 		requestBytes, _ := json.Marshal(signTransactionRequest)
 
@@ -364,7 +501,12 @@ func (s *ethHandlerTestSuite) TestSignTransaction() {
 		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
 
 		signedRaw := []byte("signedRaw")
-		s.ethStore.EXPECT().SignTransaction(gomock.Any(), ethcommon.HexToAddress(accAddress), signTransactionRequest.ChainID.ToInt(), gomock.Any()).Return(signedRaw, nil)
+		s.ethStore.EXPECT().SignTransaction(gomock.Any(), ethcommon.HexToAddress(accAddress), signTransactionRequest.ChainID.ToInt(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _ ethcommon.Address, _ *big.Int, tx *ethtypes.Transaction) ([]byte, error) {
+				assert.Equal(s.T(), uint8(ethtypes.AccessListTxType), tx.Type())
+				assert.Equal(s.T(), signTransactionRequest.AccessList, tx.AccessList())
+				return signedRaw, nil
+			})
 
 		s.router.ServeHTTP(rw, httpRequest)
 
@@ -387,6 +529,19 @@ func (s *ethHandlerTestSuite) TestSignTransaction() {
 		assert.Equal(s.T(), hexutil.Encode(signedRaw), rw.Body.String())
 		assert.Equal(s.T(), http.StatusOK, rw.Code)
 	})
+	s.Run("should fail with 400 if an access list is set on a LEGACY transaction", func() {
+		signTransactionRequest := testutils.FakeSignETHTransactionRequest(apiTypes.LegacyTxType)
+		signTransactionRequest.AccessList = testutils.FakeSignETHTransactionRequest(apiTypes.AccessListTxType).AccessList
+		requestBytes, _ := json.Marshal(signTransactionRequest)
+
+		rw := httptest.NewRecorder()
+		httpRequest := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/stores/%s/ethereum/%s/sign-transaction", ethStoreName, accAddress), bytes.NewReader(requestBytes)).WithContext(s.ctx)
+
+		s.router.ServeHTTP(rw, httpRequest)
+
+		assert.Equal(s.T(), http.StatusBadRequest, rw.Code)
+	})
+
 	// Sufficient test to check that the mapping to HTTP errors is working. All other status code tests are done in integration tests
 	s.Run("should fail with correct error code if use case fails", func() {
 		signTransactionRequest := testutils.FakeSignETHTransactionRequest("")
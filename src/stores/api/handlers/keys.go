@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"net/http"
 
+	"github.com/consensys/quorum-key-manager/pkg/crypto"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	jsonutils "github.com/consensys/quorum-key-manager/pkg/json"
+	qkmjwt "github.com/consensys/quorum-key-manager/pkg/jwt"
 	"github.com/consensys/quorum-key-manager/src/auth/authenticator"
 	http2 "github.com/consensys/quorum-key-manager/src/infra/http"
 	"github.com/consensys/quorum-key-manager/src/stores"
@@ -30,6 +32,8 @@ func NewKeysHandler(storesConnector stores.Stores) *KeysHandler {
 func (h *KeysHandler) Register(r *mux.Router) {
 	r.Methods(http.MethodPost).Path("/{id}/import").HandlerFunc(h.importKey)
 	r.Methods(http.MethodPost).Path("/{id}/sign").HandlerFunc(h.sign)
+	r.Methods(http.MethodPost).Path("/{id}/sign-jwt").HandlerFunc(h.signJWT)
+	r.Methods(http.MethodPost).Path("/{id}/export").HandlerFunc(h.export)
 	r.Methods(http.MethodGet).Path("").HandlerFunc(h.list)
 	r.Methods(http.MethodGet).Path("/{id}").HandlerFunc(h.getOne)
 	r.Methods(http.MethodPatch).Path("/{id}").HandlerFunc(h.update)
@@ -81,7 +85,9 @@ func (h *KeysHandler) create(rw http.ResponseWriter, request *http.Request) {
 			EllipticCurve: entities.Curve(createKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: createKeyRequest.Tags,
+			Tags:       createKeyRequest.Tags,
+			Shared:     createKeyRequest.Shared,
+			Exportable: createKeyRequest.Exportable,
 		})
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
@@ -92,7 +98,7 @@ func (h *KeysHandler) create(rw http.ResponseWriter, request *http.Request) {
 }
 
 // @Summary Import Key
-// @Description Import a private Key using the specified Ecliptic Curve and Signing algorithm
+// @Description Import a private Key, given as raw bytes or PEM block (PKCS#8, encrypted PKCS#8 or SEC 1), using the specified Ecliptic Curve and Signing algorithm
 // @Tags Keys
 // @Accept json
 // @Produce json
@@ -117,6 +123,12 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
+	privKey, err := formatters.FormatImportKeyPrivateKey(importKeyRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
 	userInfo := authenticator.UserInfoContextFromContext(ctx)
 	keyStore, err := h.stores.GetKeyStore(ctx, StoreNameFromContext(ctx), userInfo)
 	if err != nil {
@@ -127,13 +139,15 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 	key, err := keyStore.Import(
 		ctx,
 		getID(request),
-		importKeyRequest.PrivateKey,
+		privKey,
 		&entities.Algorithm{
 			Type:          entities.KeyType(importKeyRequest.SigningAlgorithm),
 			EllipticCurve: entities.Curve(importKeyRequest.Curve),
 		},
 		&entities.Attributes{
-			Tags: importKeyRequest.Tags,
+			Tags:       importKeyRequest.Tags,
+			Shared:     importKeyRequest.Shared,
+			Exportable: importKeyRequest.Exportable,
 		})
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
@@ -144,18 +158,21 @@ func (h *KeysHandler) importKey(rw http.ResponseWriter, request *http.Request) {
 }
 
 // @Summary Sign random payload
-// @Description Sign a random payload using the selected key pair
+// @Description Sign a random payload using the selected key pair, optionally hashing it first (none, sha256, keccak256 or sha512)
+// @Description and encoding the signature as raw R || S, R || S || V, ASN.1 DER or JWS, with S normalized to the lower half of the curve order if lowS is set
 // @Tags Keys
 // @Accept json
 // @Produce json
 // @Param storeName path string true "Store identifier"
 // @Param id path string true "Key identifier"
 // @Param request body types.SignBase64PayloadRequest true "Signing request"
-// @Success 200 {string} {string}"signature in base64"
+// @Success 200 {string} {string}"signature in base64, or base64url for the JWS encoding"
 // @Failure 400 {object} ErrorResponse "Invalid request format"
 // @Failure 401 {object} ErrorResponse "Unauthorized"
 // @Failure 403 {object} ErrorResponse "Forbidden"
 // @Failure 404 {object} ErrorResponse "Store/Key not found"
+// @Failure 422 {object} ErrorResponse "Invalid data length or options for the key"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
 // @Failure 500 {object} ErrorResponse "Internal server error"
 // @Router /stores/{storeName}/keys/{id}/sign [post]
 func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
@@ -175,15 +192,192 @@ func (h *KeysHandler) sign(rw http.ResponseWriter, request *http.Request) {
 		return
 	}
 
-	signature, err := keyStore.Sign(ctx, getID(request), signPayloadRequest.Data, nil)
+	signOpts := formatters.FormatSignOptions(signPayloadRequest)
+	signature, err := keyStore.SignWithOptions(ctx, getID(request), signPayloadRequest.Data, signOpts)
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
 		return
 	}
 
+	// JWS signatures are already encoded in base64url
+	if signOpts.Encoding == entities.JWSEncoding {
+		_, _ = rw.Write(signature)
+		return
+	}
+
 	_, _ = rw.Write([]byte(base64.StdEncoding.EncodeToString(signature)))
 }
 
+// @Summary Sign a JWT or JWS
+// @Description Sign JWT claims or an arbitrary JWS payload with the selected key and return the compact serialization.
+// @Description The protected header holds the algorithm of the key (ES256K for Secp256k1/ECDSA) and the key ID in kid.
+// @Description If detached, the payload is omitted from the serialization (RFC 7515 appendix F)
+// @Tags Keys
+// @Accept json
+// @Produce plain
+// @Param storeName path string true "Store identifier"
+// @Param id path string true "Key identifier"
+// @Param request body types.SignJWTRequest true "JWT signing request"
+// @Success 200 {string} string "JWS compact serialization"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 404 {object} ErrorResponse "Store/Key not found"
+// @Failure 429 {object} ErrorResponse "Rate limit exceeded"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 501 {object} ErrorResponse "No JWS algorithm for the key"
+// @Router /stores/{storeName}/keys/{id}/sign-jwt [post]
+func (h *KeysHandler) signJWT(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	signJWTRequest := &types.SignJWTRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, signJWTRequest)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	keyStore, err := h.stores.GetKeyStore(ctx, StoreNameFromContext(ctx), userInfo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	id := getID(request)
+	key, err := keyStore.Get(ctx, id)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	alg, err := formatters.FormatJWSAlgorithm(key.Algo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	header, payload, err := formatters.FormatJWS(signJWTRequest, alg, id)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	token, err := qkmjwt.CompactJWS(header, payload, signJWTRequest.Detached, func(signingInput string) (string, error) {
+		signature, signErr := keyStore.SignWithOptions(ctx, id, []byte(signingInput), &entities.SignOptions{
+			Hash:     entities.Sha256,
+			Encoding: entities.JWSEncoding,
+			LowS:     true,
+		})
+		return string(signature), signErr
+	})
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	_, _ = rw.Write([]byte(token))
+}
+
+// @Summary Get the JSON Web Key Set of a store
+// @Description Publish the public keys of the store usable to verify JWS as a JWKS (RFC 7517). Disabled keys, keys without JWS algorithm and keys which cannot be read are omitted
+// @Tags Keys
+// @Produce json
+// @Param storeName path string true "Store identifier"
+// @Success 200 {object} jwt.JWKsResponse "JSON Web Key Set"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden"
+// @Failure 404 {object} ErrorResponse "Store not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Router /stores/{storeName}/jwks [get]
+func (h *KeysHandler) jwks(rw http.ResponseWriter, request *http.Request) {
+	rw.Header().Set("Content-Type", "application/json")
+	ctx := request.Context()
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	keyStore, err := h.stores.GetKeyStore(ctx, StoreNameFromContext(ctx), userInfo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	ids, err := keyStore.List(ctx, 0, 0)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	// Keys which cannot be read or encoded are omitted, so a single key does not prevent verifying the others
+	keySet := &qkmjwt.JWKsResponse{Keys: []qkmjwt.JWKsKey{}}
+	for _, id := range ids {
+		key, err := keyStore.Get(ctx, id)
+		if err != nil || key.Metadata.Disabled {
+			continue
+		}
+
+		if _, err = formatters.FormatJWSAlgorithm(key.Algo); err != nil {
+			continue
+		}
+
+		jwk, err := qkmjwt.NewSecp256k1JWK(key.ID, key.PublicKey)
+		if err != nil {
+			continue
+		}
+		keySet.Keys = append(keySet.Keys, *jwk)
+	}
+
+	_ = json.NewEncoder(rw).Encode(keySet)
+}
+
+// @Summary Export a key
+// @Description Export the private key of an exportable key as a PKCS#8 PEM block encrypted with the passphrase
+// @Tags Keys
+// @Accept json
+// @Produce plain
+// @Param storeName path string true "Store identifier"
+// @Param id path string true "Key identifier"
+// @Param request body types.ExportKeyRequest true "Export request"
+// @Success 200 {string} string "ENCRYPTED PRIVATE KEY PEM block"
+// @Failure 400 {object} ErrorResponse "Invalid request format"
+// @Failure 401 {object} ErrorResponse "Unauthorized"
+// @Failure 403 {object} ErrorResponse "Forbidden or key not exportable"
+// @Failure 404 {object} ErrorResponse "Store/Key not found"
+// @Failure 500 {object} ErrorResponse "Internal server error"
+// @Failure 501 {object} ErrorResponse "Export not supported by the store"
+// @Router /stores/{storeName}/keys/{id}/export [post]
+func (h *KeysHandler) export(rw http.ResponseWriter, request *http.Request) {
+	ctx := request.Context()
+
+	exportReq := &types.ExportKeyRequest{}
+	err := jsonutils.UnmarshalBody(request.Body, exportReq)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.InvalidFormatError(err.Error()))
+		return
+	}
+
+	userInfo := authenticator.UserInfoContextFromContext(ctx)
+	keyStore, err := h.stores.GetKeyStore(ctx, StoreNameFromContext(ctx), userInfo)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	der, err := keyStore.Export(ctx, getID(request), nil)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, err)
+		return
+	}
+
+	pemBlock, err := crypto.EncryptPKCS8PEM(der, exportReq.Passphrase)
+	if err != nil {
+		http2.WriteHTTPErrorResponse(rw, errors.CryptoOperationError("failed to encrypt private key"))
+		return
+	}
+
+	rw.Header().Set("Content-Type", "application/x-pem-file")
+	_, _ = rw.Write(pemBlock)
+}
+
 // @Summary Get key by ID
 // @Description Retrieve a key pair by its identifier
 // @Tags Keys
@@ -69,7 +69,8 @@ func (h *SecretsHandler) set(rw http.ResponseWriter, request *http.Request) {
 	}
 
 	secret, err := secretStore.Set(ctx, id, setSecretRequest.Value, &entities.Attributes{
-		Tags: setSecretRequest.Tags,
+		Tags:   setSecretRequest.Tags,
+		Shared: setSecretRequest.Shared,
 	})
 	if err != nil {
 		http2.WriteHTTPErrorResponse(rw, err)
@@ -47,6 +47,9 @@ func (h *StoresHandler) Register(router *mux.Router) {
 	keysSubrouter := storeSubrouter.PathPrefix("/keys").Subrouter()
 	h.keys.Register(keysSubrouter)
 
+	// Register JSON Web Key Set of the keys on /stores/{storeName}/jwks
+	storeSubrouter.Methods(http.MethodGet).Path("/jwks").HandlerFunc(h.keys.jwks)
+
 	// Register ethereum handler on /stores/{storeName}/ethereum
 	ethSubrouter := storeSubrouter.PathPrefix("/ethereum").Subrouter()
 	h.eth.Register(ethSubrouter)
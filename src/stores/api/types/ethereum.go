@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/json"
 	"time"
 
 	"github.com/ethereum/go-ethereum/core/types"
@@ -17,14 +18,27 @@ const (
 )
 
 type CreateEthAccountRequest struct {
-	KeyID string            `json:"keyId,omitempty" example:"my-key-account"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	KeyID      string            `json:"keyId,omitempty" example:"my-key-account"`
+	Tags       map[string]string `json:"tags,omitempty"`
+	Shared     bool              `json:"shared,omitempty" example:"false"`
+	Exportable bool              `json:"exportable,omitempty" example:"false"`
 }
 
 type ImportEthAccountRequest struct {
-	KeyID      string            `json:"keyId,omitempty" example:"my-imported-key-account"`
-	PrivateKey hexutil.Bytes     `json:"privateKey" validate:"required" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
-	Tags       map[string]string `json:"tags,omitempty"`
+	KeyID              string            `json:"keyId,omitempty" example:"my-imported-key-account"`
+	PrivateKey         hexutil.Bytes     `json:"privateKey,omitempty" validate:"required_without_all=Keystore Mnemonic" example:"0x56202652FDFFD802B7252A456DBD8F3ECC0352BBDE76C23B40AFE8AEBD714E2E" swaggertype:"string"`
+	Keystore           json.RawMessage   `json:"keystore,omitempty" swaggertype:"object"`
+	Password           string            `json:"password,omitempty" example:"my-password"`
+	Mnemonic           string            `json:"mnemonic,omitempty" example:"test test test test test test test test test test test junk"`
+	MnemonicPassphrase string            `json:"mnemonicPassphrase,omitempty" example:"my-passphrase"`
+	DerivationPath     string            `json:"derivationPath,omitempty" example:"m/44'/60'/0'/0/0"`
+	Tags               map[string]string `json:"tags,omitempty"`
+	Shared             bool              `json:"shared,omitempty" example:"false"`
+	Exportable         bool              `json:"exportable,omitempty" example:"false"`
+}
+
+type ExportEthAccountRequest struct {
+	Passphrase string `json:"passphrase" validate:"required" example:"my-passphrase"`
 }
 
 type UpdateEthAccountRequest struct {
@@ -118,4 +132,7 @@ type EthAccountResponse struct {
 	Tags                map[string]string `json:"tags,omitempty"`
 	Address             common.Address    `json:"address" example:"0x664895b5fE3ddf049d2Fb508cfA03923859763C6" swaggertype:"string"`
 	Disabled            bool              `json:"disabled" example:"false"`
+	Tenant              string            `json:"tenant,omitempty" example:"tenant-one"`
+	Shared              bool              `json:"shared" example:"false"`
+	Exportable          bool              `json:"exportable" example:"false"`
 }
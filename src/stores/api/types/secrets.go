@@ -3,8 +3,9 @@ package types
 import "time"
 
 type SetSecretRequest struct {
-	Value string            `json:"value" validate:"required" example:"my-value"`
-	Tags  map[string]string `json:"tags,omitempty"`
+	Value  string            `json:"value" validate:"required" example:"my-value"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Shared bool              `json:"shared,omitempty" example:"false"`
 }
 
 type SecretResponse struct {
@@ -13,6 +14,8 @@ type SecretResponse struct {
 	Tags      map[string]string `json:"tags,omitempty"`
 	Version   string            `json:"version" example:"1"`
 	Disabled  bool              `json:"disabled" example:"false"`
+	Tenant    string            `json:"tenant,omitempty" example:"tenant-one"`
+	Shared    bool              `json:"shared" example:"false"`
 	CreatedAt time.Time         `json:"createdAt" example:"2020-07-09T12:35:42.115395Z"`
 	UpdatedAt time.Time         `json:"updatedAt" example:"2020-07-09T12:35:42.115395Z"`
 	DeletedAt *time.Time        `json:"deletedAt,omitempty" example:"2020-07-09T12:35:42.115395Z"`
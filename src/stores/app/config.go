@@ -2,8 +2,10 @@ package app
 
 import (
 	pg "github.com/consensys/quorum-key-manager/src/infra/postgres/client"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 )
 
 type Config struct {
-	Postgres *pg.Config
+	Postgres  *pg.Config
+	RateLimit *limiter.Config
 }
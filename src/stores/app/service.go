@@ -2,12 +2,14 @@ package app
 
 import (
 	"github.com/consensys/quorum-key-manager/pkg/app"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/infra/postgres/client"
 	manifestsmanager "github.com/consensys/quorum-key-manager/src/manifests/manager"
 	storesapi "github.com/consensys/quorum-key-manager/src/stores/api"
 	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 	storesmanager "github.com/consensys/quorum-key-manager/src/stores/manager"
 )
 
@@ -25,6 +27,23 @@ func RegisterService(a *app.App, logger log.Logger) error {
 	}
 	db := postgres.New(logger, postgresClient)
 
+	// Create rate limiter, counters are shared through Postgres if configured so
+	rateLimitCfg := cfg.RateLimit
+	if rateLimitCfg == nil {
+		rateLimitCfg = &limiter.Config{}
+	}
+
+	var buckets limiter.Buckets
+	switch rateLimitCfg.Backend {
+	case limiter.PostgresBackend:
+		buckets = limiter.NewPostgresBuckets(postgresClient, logger.WithComponent("rate-limiter"))
+	case limiter.MemoryBackend, "":
+		buckets = limiter.NewMemoryBuckets()
+	default:
+		return errors.ConfigError("invalid rate limit backend %q", rateLimitCfg.Backend)
+	}
+	rateLimiter := limiter.New(rateLimitCfg, buckets, logger.WithComponent("rate-limiter"))
+
 	// Load manifests service
 	m := new(manifestsmanager.Manager)
 	err = a.Service(m)
@@ -40,7 +59,7 @@ func RegisterService(a *app.App, logger log.Logger) error {
 	}
 
 	// Create and register the stores service
-	stores := storesmanager.New(*m, *authManager, db, logger)
+	stores := storesmanager.New(*m, *authManager, db, rateLimiter, logger)
 	err = a.RegisterService(stores)
 	if err != nil {
 		return err
@@ -26,7 +26,7 @@ func (c Connector) Create(ctx context.Context, id string, attr *entities.Attribu
 		return nil, err
 	}
 
-	acc, err := c.db.Add(ctx, newEthAccount(key, attr))
+	acc, err := c.db.Add(ctx, newEthAccount(key, attr, c.authorizator.Tenant()))
 	if err != nil {
 		return nil, err
 	}
@@ -34,13 +34,15 @@ func TestCreate(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should create eth account successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, nil)
 
 		rAcc, err := connector.Create(ctx, key.ID, attributes)
 
@@ -52,7 +54,8 @@ func TestCreate(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, nil)
 
 		rAcc, err := connector.Create(ctx, key.ID, attributes)
 
@@ -82,7 +85,8 @@ func TestCreate(t *testing.T) {
 	t.Run("should fail to create ethAccount if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, expectedErr)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, expectedErr)
 
 		_, err := connector.Create(ctx, key.ID, attributes)
 
@@ -21,6 +21,17 @@ func (c Connector) Decrypt(ctx context.Context, addr common.Address, data []byte
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.limiter.Acquire(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	result, err := c.store.Decrypt(ctx, acc.KeyID, data)
 	if err != nil {
 		return nil, err
@@ -24,6 +24,11 @@ func (c Connector) Delete(ctx context.Context, addr ethcommon.Address) error {
 		return err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
 		err = dbtx.Delete(ctx, addr.Hex())
 		if err != nil {
@@ -32,8 +32,9 @@ func TestDeleteKey(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
@@ -43,6 +44,7 @@ func TestDeleteKey(t *testing.T) {
 	t.Run("should delete ethAccount successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
 
@@ -56,6 +58,7 @@ func TestDeleteKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(rErr)
 
@@ -86,6 +89,7 @@ func TestDeleteKey(t *testing.T) {
 	t.Run("should fail to delete key if db fail to delete", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), acc.Address.Hex()).Return(expectedErr)
 
 		err := connector.Delete(ctx, acc.Address)
@@ -97,6 +101,7 @@ func TestDeleteKey(t *testing.T) {
 	t.Run("should fail to delete key if store fail to delete", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(expectedErr)
 
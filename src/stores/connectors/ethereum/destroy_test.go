@@ -32,8 +32,9 @@ func TestDestroyKey(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
@@ -43,6 +44,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should destroy ethAccount successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(nil)
 
@@ -56,6 +58,7 @@ func TestDestroyKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(rErr)
 
@@ -86,6 +89,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should fail to destroy key if db fail to destroy", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), acc.Address.Hex()).Return(expectedErr)
 
 		err := connector.Destroy(ctx, acc.Address)
@@ -97,6 +101,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should fail to destroy key if store fail to destroy", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(expectedErr)
 
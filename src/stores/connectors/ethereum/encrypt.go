@@ -21,6 +21,17 @@ func (c Connector) Encrypt(ctx context.Context, addr ethcommon.Address, data []b
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.limiter.Acquire(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	result, err := c.store.Encrypt(ctx, acc.KeyID, data)
 	if err != nil {
 		return nil, err
@@ -32,12 +32,15 @@ func TestEncryptKey(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
 
 		rResult, err := connector.Encrypt(ctx, acc.Address, data)
@@ -68,6 +71,8 @@ func TestEncryptKey(t *testing.T) {
 	t.Run("should fail to encrypt data if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
 
 		_, err := connector.Encrypt(ctx, acc.Address, data)
@@ -13,6 +13,7 @@ type Connector struct {
 	logger       log.Logger
 	db           database.ETHAccounts
 	authorizator auth.Authorizator
+	limiter      stores.Limiter
 }
 
 var _ stores.EthStore = Connector{}
@@ -22,11 +23,12 @@ var ethAlgo = &entities.Algorithm{
 	EllipticCurve: entities.Secp256k1,
 }
 
-func NewConnector(store stores.KeyStore, db database.ETHAccounts, authorizator auth.Authorizator, logger log.Logger) *Connector {
+func NewConnector(store stores.KeyStore, db database.ETHAccounts, authorizator auth.Authorizator, limiter stores.Limiter, logger log.Logger) *Connector {
 	return &Connector{
 		store:        store,
 		logger:       logger,
 		db:           db,
 		authorizator: authorizator,
+		limiter:      limiter,
 	}
 }
@@ -0,0 +1,72 @@
+package eth
+
+import (
+	"context"
+
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/google/uuid"
+)
+
+// scrypt parameters of the exported keystores, the same as the ones used by geth by default
+var (
+	scryptN = keystore.StandardScryptN
+	scryptP = keystore.StandardScryptP
+)
+
+func (c Connector) Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error) {
+	logger := c.logger.With("address", addr.Hex())
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount})
+	if err != nil {
+		return nil, err
+	}
+
+	acc, err := c.db.Get(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+
+	// Shared accounts can be used by every tenant but only exported by their owner
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if passphrase == "" {
+		errMessage := "passphrase must be provided"
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	if !acc.Metadata.Exportable {
+		errMessage := "account is not exportable, accounts must be created or imported as exportable"
+		logger.Error(errMessage)
+		return nil, errors.ForbiddenError(errMessage)
+	}
+
+	der, err := c.store.Export(ctx, acc.KeyID, ethAlgo)
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := pkgcrypto.ParseECDSAPKCS8(der)
+	if err != nil {
+		errMessage := "failed to parse exported private key"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	keyJSON, err := keystore.EncryptKey(&keystore.Key{Id: uuid.New(), Address: acc.Address, PrivateKey: privKey}, passphrase, scryptN, scryptP)
+	if err != nil {
+		errMessage := "failed to encrypt keystore"
+		logger.WithError(err).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	logger.Info("ethereum account exported successfully")
+	return keyJSON, nil
+}
@@ -0,0 +1,116 @@
+package eth
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExportAccount(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+
+	expectedErr := fmt.Errorf("error")
+	acc := testutils2.FakeETHAccount()
+	acc.Metadata.Exportable = true
+	privKey, err := crypto.HexToECDSA("db337ca3295e4050586793f252e641f3b3a83739018fa4cce01a81ca920e7e1c")
+	require.NoError(t, err)
+	der, err := pkgcrypto.MarshalECDSAPKCS8(privKey)
+	require.NoError(t, err)
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockETHAccounts(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
+
+	connector := NewConnector(store, db, auth, limiter, logger)
+
+	t.Run("should export account as keystore successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
+		store.EXPECT().Export(gomock.Any(), acc.KeyID, ethAlgo).Return(der, nil)
+
+		keyJSON, err := connector.Export(ctx, acc.Address, "my-passphrase")
+		require.NoError(t, err)
+
+		key, err := keystore.DecryptKey(keyJSON, "my-passphrase")
+		require.NoError(t, err)
+		assert.Equal(t, acc.Address, key.Address)
+		assert.Equal(t, crypto.FromECDSA(privKey), crypto.FromECDSA(key.PrivateKey))
+	})
+
+	t.Run("should fail with InvalidParameterError if passphrase is empty", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
+
+		_, err := connector.Export(ctx, acc.Address, "")
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount}).Return(expectedErr)
+
+		_, err := connector.Export(ctx, acc.Address, "my-passphrase")
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with NotFoundError if a shared account belongs to another tenant", func(t *testing.T) {
+		sharedAcc := testutils2.FakeETHAccount()
+		sharedAcc.Metadata.Tenant = "tenantTwo"
+		sharedAcc.Metadata.Shared = true
+		sharedAcc.Metadata.Exportable = true
+		tenantAuth := authorizator.New([]types.Permission{types.ExportEth}, "tenantOne", logger)
+		tenantConnector := NewConnector(store, db, tenantAuth, limiter, logger)
+
+		db.EXPECT().Get(gomock.Any(), sharedAcc.Address.Hex()).Return(sharedAcc, nil)
+
+		_, err := tenantConnector.Export(ctx, sharedAcc.Address, "")
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if account is not exportable", func(t *testing.T) {
+		nonExportableAcc := testutils2.FakeETHAccount()
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), nonExportableAcc.Address.Hex()).Return(nonExportableAcc, nil)
+		auth.EXPECT().CheckOwnership(nonExportableAcc.Metadata.Tenant, false).Return(nil)
+
+		_, err := connector.Export(ctx, nonExportableAcc.Address, "my-passphrase")
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with same error if export fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
+		store.EXPECT().Export(gomock.Any(), acc.KeyID, ethAlgo).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, acc.Address, "my-passphrase")
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
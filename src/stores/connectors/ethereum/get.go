@@ -22,6 +22,11 @@ func (c Connector) Get(ctx context.Context, addr ethcommon.Address) (*entities.E
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("ethereum account retrieved successfully")
 	return acc, nil
 }
@@ -39,6 +44,11 @@ func (c Connector) GetDeleted(ctx context.Context, addr ethcommon.Address) (*ent
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("deleted ethereum account retrieved successfully")
 	return acc, nil
 }
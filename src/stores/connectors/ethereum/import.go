@@ -33,7 +33,7 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, attr *
 		return nil, err
 	}
 
-	acc, err := c.db.Add(ctx, newEthAccount(key, attr))
+	acc, err := c.db.Add(ctx, newEthAccount(key, attr, c.authorizator.Tenant()))
 	if err != nil {
 		return nil, err
 	}
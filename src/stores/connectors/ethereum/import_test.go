@@ -35,13 +35,15 @@ func TestImport(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should import eth account successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, nil)
 
 		rAcc, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -53,7 +55,8 @@ func TestImport(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, nil)
 
 		rAcc, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -83,7 +86,8 @@ func TestImport(t *testing.T) {
 	t.Run("should fail to create ethAccount if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceEthAccount}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, ethAlgo, attributes).Return(key, nil)
-		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes)).Return(acc, expectedErr)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Add(gomock.Any(), newEthAccount(key, attributes, "tenantOne")).Return(acc, expectedErr)
 
 		_, err := connector.Import(ctx, key.ID, privKey, attributes)
 
@@ -14,7 +14,7 @@ func (c Connector) List(ctx context.Context, limit, offset uint64) ([]common.Add
 		return nil, err
 	}
 
-	strAddr, err := c.db.SearchAddresses(ctx, false, limit, offset)
+	strAddr, err := c.db.SearchAddresses(ctx, c.authorizator.AccessibleTenants(), false, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -34,7 +34,7 @@ func (c Connector) ListDeleted(ctx context.Context, limit, offset uint64) ([]com
 		return nil, err
 	}
 
-	strAddr, err := c.db.SearchAddresses(ctx, true, limit, offset)
+	strAddr, err := c.db.SearchAddresses(ctx, c.authorizator.AccessibleTenants(), true, limit, offset)
 	if err != nil {
 		return nil, err
 	}
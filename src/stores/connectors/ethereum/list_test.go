@@ -23,13 +23,15 @@ func TestListEthAccounts(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockKeyStore(ctrl)
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should list ethAccounts successfully", func(t *testing.T) {
 		accOne := testutils2.FakeETHAccount()
@@ -38,7 +40,8 @@ func TestListEthAccounts(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
-		db.EXPECT().SearchAddresses(gomock.Any(), false, limit, offset).Return([]string{accOne.Address.String(), accTwo.Address.String()}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchAddresses(gomock.Any(), tenants, false, limit, offset).Return([]string{accOne.Address.String(), accTwo.Address.String()}, nil)
 
 		accAddrs, err := connector.List(ctx, limit, offset)
 
@@ -57,7 +60,8 @@ func TestListEthAccounts(t *testing.T) {
 
 	t.Run("should fail to list ethAccounts if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
-		db.EXPECT().SearchAddresses(gomock.Any(), false, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchAddresses(gomock.Any(), tenants, false, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.List(ctx, 0, 0)
 
@@ -72,13 +76,15 @@ func TestListDeletedEthAccounts(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockKeyStore(ctrl)
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should list deleted ethAccounts successfully", func(t *testing.T) {
 		accOne := testutils2.FakeETHAccount()
@@ -87,7 +93,8 @@ func TestListDeletedEthAccounts(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
-		db.EXPECT().SearchAddresses(gomock.Any(), true, limit, offset).Return([]string{accOne.Address.String(), accTwo.Address.String()}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchAddresses(gomock.Any(), tenants, true, limit, offset).Return([]string{accOne.Address.String(), accTwo.Address.String()}, nil)
 
 		accAddrs, err := connector.ListDeleted(ctx, limit, offset)
 
@@ -106,7 +113,8 @@ func TestListDeletedEthAccounts(t *testing.T) {
 
 	t.Run("should fail to list deleted ethAccounts if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
-		db.EXPECT().SearchAddresses(gomock.Any(), true, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchAddresses(gomock.Any(), tenants, true, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.ListDeleted(ctx, uint64(0), uint64(0))
 
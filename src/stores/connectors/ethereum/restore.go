@@ -29,6 +29,11 @@ func (c Connector) Restore(ctx context.Context, addr ethcommon.Address) error {
 		return err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
 		err = dbtx.Restore(ctx, addr.Hex())
 		if err != nil {
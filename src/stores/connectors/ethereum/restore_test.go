@@ -30,8 +30,9 @@ func TestRestoreEthAccount(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
@@ -43,6 +44,7 @@ func TestRestoreEthAccount(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), acc.KeyID).Return(nil)
 
@@ -57,6 +59,7 @@ func TestRestoreEthAccount(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError(""))
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), acc.KeyID).Return(rErr)
 
@@ -68,7 +71,8 @@ func TestRestoreEthAccount(t *testing.T) {
 	t.Run("should be idempotent if ethAccount already exists", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceEthAccount}).Return(nil)
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
-		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
 
 		err := connector.Restore(ctx, acc.Address)
 
@@ -100,6 +104,7 @@ func TestRestoreEthAccount(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError(""))
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), acc.Address.Hex()).Return(expectedErr)
 
 		err := connector.Restore(ctx, acc.Address)
@@ -113,6 +118,7 @@ func TestRestoreEthAccount(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(nil, errors.NotFoundError(""))
 		db.EXPECT().GetDeleted(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), acc.Address.Hex()).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), acc.KeyID).Return(expectedErr)
 
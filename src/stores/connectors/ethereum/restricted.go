@@ -0,0 +1,210 @@
+package eth
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/pkg/ethereum"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+	quorumtypes "github.com/consensys/quorum/core/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core"
+)
+
+// RestrictedConnector limits an Ethereum store to the accounts a user has been scoped to.
+// Accounts can not be created or imported and listed accounts are filtered after pagination
+type RestrictedConnector struct {
+	stores.EthStore
+	addresses map[common.Address]bool
+	logger    log.Logger
+}
+
+var _ stores.EthStore = RestrictedConnector{}
+
+func NewRestrictedConnector(store stores.EthStore, addresses []string, logger log.Logger) *RestrictedConnector {
+	addrs := map[common.Address]bool{}
+	for _, addr := range addresses {
+		addrs[common.HexToAddress(addr)] = true
+	}
+
+	return &RestrictedConnector{
+		EthStore:  store,
+		addresses: addrs,
+		logger:    logger,
+	}
+}
+
+func (c RestrictedConnector) Create(context.Context, string, *entities.Attributes) (*entities.ETHAccount, error) {
+	errMessage := "credentials restricted to a set of accounts can not create accounts"
+	c.logger.Error(errMessage)
+	return nil, errors.ForbiddenError(errMessage)
+}
+
+func (c RestrictedConnector) Import(context.Context, string, []byte, *entities.Attributes) (*entities.ETHAccount, error) {
+	errMessage := "credentials restricted to a set of accounts can not import accounts"
+	c.logger.Error(errMessage)
+	return nil, errors.ForbiddenError(errMessage)
+}
+
+func (c RestrictedConnector) Get(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Get(ctx, addr)
+}
+
+func (c RestrictedConnector) List(ctx context.Context, limit, offset uint64) ([]common.Address, error) {
+	addrs, err := c.EthStore.List(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.filter(addrs), nil
+}
+
+func (c RestrictedConnector) Update(ctx context.Context, addr common.Address, attr *entities.Attributes) (*entities.ETHAccount, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Update(ctx, addr, attr)
+}
+
+func (c RestrictedConnector) Delete(ctx context.Context, addr common.Address) error {
+	if err := c.checkAddress(addr); err != nil {
+		return err
+	}
+
+	return c.EthStore.Delete(ctx, addr)
+}
+
+func (c RestrictedConnector) GetDeleted(ctx context.Context, addr common.Address) (*entities.ETHAccount, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.GetDeleted(ctx, addr)
+}
+
+func (c RestrictedConnector) ListDeleted(ctx context.Context, limit, offset uint64) ([]common.Address, error) {
+	addrs, err := c.EthStore.ListDeleted(ctx, limit, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.filter(addrs), nil
+}
+
+func (c RestrictedConnector) Restore(ctx context.Context, addr common.Address) error {
+	if err := c.checkAddress(addr); err != nil {
+		return err
+	}
+
+	return c.EthStore.Restore(ctx, addr)
+}
+
+func (c RestrictedConnector) Destroy(ctx context.Context, addr common.Address) error {
+	if err := c.checkAddress(addr); err != nil {
+		return err
+	}
+
+	return c.EthStore.Destroy(ctx, addr)
+}
+
+func (c RestrictedConnector) Sign(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Sign(ctx, addr, data)
+}
+
+func (c RestrictedConnector) SignMessage(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.SignMessage(ctx, addr, data)
+}
+
+func (c RestrictedConnector) SignTypedData(ctx context.Context, addr common.Address, typedData *core.TypedData) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.SignTypedData(ctx, addr, typedData)
+}
+
+func (c RestrictedConnector) SignTransaction(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.SignTransaction(ctx, addr, chainID, tx)
+}
+
+func (c RestrictedConnector) SignEEA(ctx context.Context, addr common.Address, chainID *big.Int, tx *types.Transaction, args *ethereum.PrivateArgs) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.SignEEA(ctx, addr, chainID, tx, args)
+}
+
+func (c RestrictedConnector) SignPrivate(ctx context.Context, addr common.Address, tx *quorumtypes.Transaction) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.SignPrivate(ctx, addr, tx)
+}
+
+func (c RestrictedConnector) Encrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Encrypt(ctx, addr, data)
+}
+
+func (c RestrictedConnector) Decrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Decrypt(ctx, addr, data)
+}
+
+func (c RestrictedConnector) Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error) {
+	if err := c.checkAddress(addr); err != nil {
+		return nil, err
+	}
+
+	return c.EthStore.Export(ctx, addr, passphrase)
+}
+
+func (c RestrictedConnector) checkAddress(addr common.Address) error {
+	if c.addresses[addr] {
+		return nil
+	}
+
+	errMessage := "credentials are not allowed to access this account"
+	c.logger.With("account", addr.Hex()).Error(errMessage)
+	return errors.ForbiddenError(errMessage)
+}
+
+func (c RestrictedConnector) filter(addrs []common.Address) []common.Address {
+	var allowed []common.Address
+	for _, addr := range addrs {
+		if c.addresses[addr] {
+			allowed = append(allowed, addr)
+		}
+	}
+
+	return allowed
+}
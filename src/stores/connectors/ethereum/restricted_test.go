@@ -0,0 +1,57 @@
+package eth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRestrictedConnector(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	allowed := common.HexToAddress("0x905B88EFf8Bda1543d4d6f4aA05afef143D27E18")
+	other := common.HexToAddress("0x7E654d251Da770A068413677967F6d3Ea2FeA9E4")
+	data := []byte("0x123")
+	result := []byte("0x456")
+
+	store := mock.NewMockEthStore(ctrl)
+	connector := NewRestrictedConnector(store, []string{"0x905b88eff8bda1543d4d6f4aa05afef143d27e18"}, testutils.NewMockLogger(ctrl))
+
+	t.Run("should sign with allowed account successfully", func(t *testing.T) {
+		store.EXPECT().SignMessage(gomock.Any(), allowed, data).Return(result, nil)
+
+		rResult, err := connector.SignMessage(ctx, allowed, data)
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, rResult)
+	})
+
+	t.Run("should fail with ForbiddenError if account is not allowed", func(t *testing.T) {
+		_, err := connector.SignMessage(ctx, other, data)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should filter listed accounts", func(t *testing.T) {
+		store.EXPECT().List(gomock.Any(), uint64(10), uint64(0)).Return([]common.Address{other, allowed}, nil)
+
+		addrs, err := connector.List(ctx, 10, 0)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []common.Address{allowed}, addrs)
+	})
+
+	t.Run("should fail with ForbiddenError on account creation", func(t *testing.T) {
+		_, err := connector.Create(ctx, "my-account", nil)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+}
@@ -210,6 +210,17 @@ func (c Connector) sign(ctx context.Context, addr common.Address, data []byte) (
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.limiter.Acquire(ctx, addr.Hex())
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	signature, err := c.store.Sign(ctx, acc.KeyID, data, ethAlgo)
 	if err != nil {
 		return nil, err
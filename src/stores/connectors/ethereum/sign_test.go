@@ -38,8 +38,9 @@ func TestSignMessage(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should sign successfully", func(t *testing.T) {
 		acc := testutils2.FakeETHAccount()
@@ -48,6 +49,8 @@ func TestSignMessage(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), acc.KeyID, crypto.Keccak256([]byte(expectedData)), ethAlgo).Return(ecdsaSignature, nil)
 
 		expectedSignature := hexutil.Encode(ecdsaSignature) + "1b"
@@ -67,6 +70,8 @@ func TestSignMessage(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), acc.KeyID, crypto.Keccak256([]byte(expectedData)), ethAlgo).Return(ecdsaSignatureMalleable, nil)
 
 		expectedSignature := hexutil.Encode(append(R.Bytes(), S.Bytes()...)) + "1c"
@@ -85,6 +90,8 @@ func TestSignMessage(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), acc.KeyID, crypto.Keccak256([]byte(expectedData)), ethAlgo).Return(ecdsaSignature, nil)
 
 		_, err := connector.SignMessage(ctx, acc.Address, data)
@@ -116,11 +123,40 @@ func TestSignMessage(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 
+	t.Run("should fail with same error if account belongs to another tenant", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(expectedErr)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if rate limit is exceeded", func(t *testing.T) {
+		acc := testutils2.FakeETHAccount()
+
+		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(nil, expectedErr)
+
+		_, err := connector.SignMessage(ctx, acc.Address, data)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
 	t.Run("should fail to sign if store fails", func(t *testing.T) {
 		acc := testutils2.FakeETHAccount()
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), acc.KeyID, crypto.Keccak256([]byte(expectedData)), ethAlgo).Return(nil, expectedErr)
 
 		_, err := connector.SignMessage(ctx, acc.Address, data)
@@ -141,8 +177,9 @@ func TestSignTransaction(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	acc := testutils2.FakeETHAccount()
 	chainID := big.NewInt(1)
@@ -159,6 +196,8 @@ func TestSignTransaction(t *testing.T) {
 	t.Run("should sign a payload successfully with appended V value", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, types.NewEIP155Signer(chainID).Hash(tx).Bytes(), ethAlgo).Return(ecdsaSignature, nil)
 
 		signedRaw, err := connector.SignTransaction(ctx, acc.Address, chainID, tx)
@@ -186,6 +225,8 @@ func TestSignTransaction(t *testing.T) {
 	t.Run("should fail with same error if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, gomock.Any(), ethAlgo).Return(nil, expectedErr)
 
 		signedRaw, err := connector.SignTransaction(ctx, acc.Address, chainID, tx)
@@ -205,8 +246,9 @@ func TestSignPrivate(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	acc := testutils2.FakeETHAccount()
 	tx := quorumtypes.NewTransaction(
@@ -222,6 +264,8 @@ func TestSignPrivate(t *testing.T) {
 	t.Run("should sign a payload successfully with appended V value", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, quorumtypes.QuorumPrivateTxSigner{}.Hash(tx).Bytes(), ethAlgo).Return(ecdsaSignature, nil)
 
 		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
@@ -249,6 +293,8 @@ func TestSignPrivate(t *testing.T) {
 	t.Run("should fail with same error if store fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, gomock.Any(), ethAlgo).Return(nil, expectedErr)
 
 		signedRaw, err := connector.SignPrivate(ctx, acc.Address, tx)
@@ -268,8 +314,9 @@ func TestSignEEA(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	acc := testutils2.FakeETHAccount()
 	chainID := big.NewInt(1)
@@ -293,6 +340,8 @@ func TestSignEEA(t *testing.T) {
 	t.Run("should sign a payload with privacyFor successfully with appended V value", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID,
 			hexutil.MustDecode("0x5749cc0adae7a54f9c5148a9e21719a2b472dec7b7ae7c1d68bf35e2e161f94d"),
 			ethAlgo).Return(ecdsaSignature, nil)
@@ -322,6 +371,8 @@ func TestSignEEA(t *testing.T) {
 	t.Run("should fail with same error if Sign fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionSign, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(ctx, acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, acc.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), acc.Address.Hex()).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, acc.KeyID, gomock.Any(), ethAlgo).Return(nil, expectedErr)
 
 		signedRaw, err := connector.SignEEA(ctx, acc.Address, chainID, tx, privateArgs)
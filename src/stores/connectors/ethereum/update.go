@@ -24,6 +24,11 @@ func (c Connector) Update(ctx context.Context, addr ethcommon.Address, attr *ent
 	if err != nil {
 		return nil, err
 	}
+
+	err = c.authorizator.CheckOwnership(acc.Metadata.Tenant, false)
+	if err != nil {
+		return nil, err
+	}
 	acc.Tags = attr.Tags
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.ETHAccounts) error {
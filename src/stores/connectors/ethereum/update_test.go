@@ -32,8 +32,9 @@ func TestUpdateKey(t *testing.T) {
 	db := mock2.NewMockETHAccounts(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.ETHAccounts) error) error {
@@ -45,6 +46,7 @@ func TestUpdateKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), acc).Return(acc, nil)
 		store.EXPECT().Update(gomock.Any(), acc.KeyID, attributes).Return(key, nil)
 
@@ -59,6 +61,7 @@ func TestUpdateKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), acc).Return(acc, nil)
 		store.EXPECT().Update(gomock.Any(), acc.KeyID, attributes).Return(nil, rErr)
 
@@ -89,6 +92,7 @@ func TestUpdateKey(t *testing.T) {
 	t.Run("should fail to update key if db fail to update", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), acc).Return(nil, expectedErr)
 
 		_, err := connector.Update(ctx, acc.Address, attributes)
@@ -100,6 +104,7 @@ func TestUpdateKey(t *testing.T) {
 	t.Run("should fail to update key if store fail to update", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&authtypes.Operation{Action: authtypes.ActionWrite, Resource: authtypes.ResourceEthAccount}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), acc.Address.Hex()).Return(acc, nil)
+		auth.EXPECT().CheckOwnership(acc.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), acc).Return(acc, nil)
 		store.EXPECT().Update(gomock.Any(), acc.KeyID, attributes).Return(nil, expectedErr)
 
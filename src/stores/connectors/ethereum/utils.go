@@ -5,7 +5,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 )
 
-func newEthAccount(key *entities.Key, attr *entities.Attributes) *entities.ETHAccount {
+func newEthAccount(key *entities.Key, attr *entities.Attributes, tenant string) *entities.ETHAccount {
 	pubKey, _ := crypto.UnmarshalPubkey(key.PublicKey)
 	return &entities.ETHAccount{
 		KeyID:               key.ID,
@@ -14,9 +14,12 @@ func newEthAccount(key *entities.Key, attr *entities.Attributes) *entities.ETHAc
 		PublicKey:           key.PublicKey,
 		CompressedPublicKey: crypto.CompressPubkey(pubKey),
 		Metadata: &entities.Metadata{
-			Disabled:  key.Metadata.Disabled,
-			CreatedAt: key.Metadata.CreatedAt,
-			UpdatedAt: key.Metadata.UpdatedAt,
+			Disabled:   key.Metadata.Disabled,
+			CreatedAt:  key.Metadata.CreatedAt,
+			UpdatedAt:  key.Metadata.UpdatedAt,
+			Tenant:     tenant,
+			Shared:     attr.Shared,
+			Exportable: attr.Exportable,
 		},
 	}
 }
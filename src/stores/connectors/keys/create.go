@@ -33,6 +33,10 @@ func (c Connector) Create(ctx context.Context, id string, alg *entities.Algorith
 		return nil, err
 	}
 
+	key.Metadata.Tenant = c.authorizator.Tenant()
+	key.Metadata.Shared = attr.Shared
+	key.Metadata.Exportable = attr.Exportable
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
@@ -31,24 +31,28 @@ func TestCreateKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should create key successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, key.Algo, attributes).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(key, nil)
 
 		rKey, err := connector.Create(ctx, key.ID, key.Algo, attributes)
 
 		assert.NoError(t, err)
 		assert.Equal(t, rKey, key)
+		assert.Equal(t, "tenantOne", rKey.Metadata.Tenant)
 	})
 
 	t.Run("should create key successfully if it already exists in the vault", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, key.Algo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(key, nil)
 
 		rKey, err := connector.Create(ctx, key.ID, key.Algo, attributes)
@@ -78,6 +82,7 @@ func TestCreateKey(t *testing.T) {
 	t.Run("should fail to create key if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Create(gomock.Any(), key.ID, key.Algo, attributes).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(nil, expectedErr)
 
 		_, err := connector.Create(ctx, key.ID, key.Algo, attributes)
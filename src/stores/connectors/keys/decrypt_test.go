@@ -30,11 +30,15 @@ func TestDecryptKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should decrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(result, nil)
 
 		rResult, err := connector.Decrypt(ctx, key.ID, data)
@@ -54,6 +58,9 @@ func TestDecryptKey(t *testing.T) {
 
 	t.Run("should fail to decrypt data if decrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Decrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
 
 		_, err := connector.Decrypt(ctx, key.ID, data)
@@ -19,6 +19,16 @@ func (c Connector) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
 		derr := dbtx.Delete(ctx, id)
 		if derr != nil {
@@ -30,8 +30,9 @@ func TestDeleteKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
@@ -40,6 +41,8 @@ func TestDeleteKey(t *testing.T) {
 
 	t.Run("should delete key successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
 
@@ -52,6 +55,8 @@ func TestDeleteKey(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(rErr)
 
@@ -71,6 +76,8 @@ func TestDeleteKey(t *testing.T) {
 
 	t.Run("should fail to delete key if db fail to delete", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), key.ID).Return(expectedErr)
 
 		err := connector.Delete(ctx, key.ID)
@@ -81,6 +88,8 @@ func TestDeleteKey(t *testing.T) {
 
 	t.Run("should fail to delete key if store fail to delete", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), key.ID).Return(expectedErr)
 
@@ -18,7 +18,12 @@ func (c Connector) Destroy(ctx context.Context, id string) error {
 		return err
 	}
 
-	_, err = c.db.GetDeleted(ctx, id)
+	key, err := c.db.GetDeleted(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, false)
 	if err != nil {
 		return err
 	}
@@ -30,8 +30,9 @@ func TestDestroyKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
@@ -41,6 +42,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should destroy key successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(nil)
 
@@ -54,6 +56,7 @@ func TestDestroyKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(rErr)
 
@@ -84,6 +87,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should fail to destroy key if db fail to purge", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), key.ID).Return(expectedErr)
 
 		err := connector.Destroy(ctx, key.ID)
@@ -95,6 +99,7 @@ func TestDestroyKey(t *testing.T) {
 	t.Run("should fail to destroy key if store fail to destroy", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), key.ID).Return(expectedErr)
 
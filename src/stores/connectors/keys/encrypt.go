@@ -14,6 +14,22 @@ func (c Connector) Encrypt(ctx context.Context, id string, data []byte) ([]byte,
 		return nil, err
 	}
 
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	release, err := c.limiter.Acquire(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
 	result, err := c.store.Encrypt(ctx, id, data)
 	if err != nil {
 		return nil, err
@@ -30,11 +30,15 @@ func TestEncrypt(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should encrypt data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(result, nil)
 
 		rResult, err := connector.Encrypt(ctx, key.ID, data)
@@ -54,6 +58,9 @@ func TestEncrypt(t *testing.T) {
 
 	t.Run("should fail to encrypt data if encrypt fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionEncrypt, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Encrypt(gomock.Any(), key.ID, data).Return(nil, expectedErr)
 
 		_, err := connector.Encrypt(ctx, key.ID, data)
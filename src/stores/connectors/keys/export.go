@@ -0,0 +1,47 @@
+package keys
+
+import (
+	"context"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
+)
+
+func (c Connector) Export(ctx context.Context, id string, algo *entities.Algorithm) ([]byte, error) {
+	logger := c.logger.With("id", id)
+
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Shared keys can be used by every tenant but only exported by their owner
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, false)
+	if err != nil {
+		return nil, err
+	}
+
+	if !key.Metadata.Exportable {
+		errMessage := "key is not exportable, keys must be created or imported as exportable"
+		logger.Error(errMessage)
+		return nil, errors.ForbiddenError(errMessage)
+	}
+
+	if algo == nil {
+		algo = key.Algo
+	}
+
+	result, err := c.store.Export(ctx, id, algo)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.Info("key exported successfully")
+	return result, nil
+}
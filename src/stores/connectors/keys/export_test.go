@@ -0,0 +1,104 @@
+package keys
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/auth/authorizator"
+	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
+	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportKey(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	result := []byte("pkcs8")
+	key := testutils2.FakeKey()
+	key.Metadata.Exportable = true
+	expectedErr := fmt.Errorf("error")
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
+
+	connector := NewConnector(store, db, auth, limiter, logger)
+
+	t.Run("should export key successfully", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
+		store.EXPECT().Export(gomock.Any(), key.ID, key.Algo).Return(result, nil)
+
+		rResult, err := connector.Export(ctx, key.ID, nil)
+
+		assert.NoError(t, err)
+		assert.Equal(t, result, rResult)
+	})
+
+	t.Run("should fail with same error if authorization fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey}).Return(expectedErr)
+
+		_, err := connector.Export(ctx, key.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with same error if key belongs to another tenant", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(expectedErr)
+
+		_, err := connector.Export(ctx, key.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+
+	t.Run("should fail with NotFoundError if a shared key belongs to another tenant", func(t *testing.T) {
+		sharedKey := testutils2.FakeKey()
+		sharedKey.Metadata.Tenant = "tenantTwo"
+		sharedKey.Metadata.Shared = true
+		sharedKey.Metadata.Exportable = true
+		tenantAuth := authorizator.New([]types.Permission{types.ExportKey}, "tenantOne", logger)
+		tenantConnector := NewConnector(store, db, tenantAuth, limiter, logger)
+
+		db.EXPECT().Get(gomock.Any(), sharedKey.ID).Return(sharedKey, nil)
+
+		_, err := tenantConnector.Export(ctx, sharedKey.ID, nil)
+
+		assert.True(t, errors.IsNotFoundError(err))
+	})
+
+	t.Run("should fail with ForbiddenError if key is not exportable", func(t *testing.T) {
+		nonExportableKey := testutils2.FakeKey()
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), nonExportableKey.ID).Return(nonExportableKey, nil)
+		auth.EXPECT().CheckOwnership(nonExportableKey.Metadata.Tenant, false).Return(nil)
+
+		_, err := connector.Export(ctx, nonExportableKey.ID, nil)
+
+		assert.True(t, errors.IsForbiddenError(err))
+	})
+
+	t.Run("should fail with same error if export fails", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionExport, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
+		store.EXPECT().Export(gomock.Any(), key.ID, key.Algo).Return(nil, expectedErr)
+
+		_, err := connector.Export(ctx, key.ID, nil)
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
@@ -21,6 +21,11 @@ func (c Connector) Get(ctx context.Context, id string) (*entities.Key, error) {
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("key retrieved successfully")
 	return key, nil
 }
@@ -38,6 +43,11 @@ func (c Connector) GetDeleted(ctx context.Context, id string) (*entities.Key, er
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("deleted key retrieved successfully")
 	return key, nil
 }
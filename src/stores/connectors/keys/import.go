@@ -39,6 +39,10 @@ func (c Connector) Import(ctx context.Context, id string, privKey []byte, alg *e
 		return nil, err
 	}
 
+	key.Metadata.Tenant = c.authorizator.Tenant()
+	key.Metadata.Shared = attr.Shared
+	key.Metadata.Exportable = attr.Exportable
+
 	key, err = c.db.Add(ctx, key)
 	if err != nil {
 		return nil, err
@@ -32,24 +32,28 @@ func TestImportKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should import key successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, attributes).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(key, nil)
 
 		rKey, err := connector.Import(ctx, key.ID, privKey, key.Algo, attributes)
 
 		assert.NoError(t, err)
 		assert.Equal(t, rKey, key)
+		assert.Equal(t, "tenantOne", rKey.Metadata.Tenant)
 	})
 
 	t.Run("should import key successfully if it already exists in the vault", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(key, nil)
 
 		rKey, err := connector.Import(ctx, key.ID, privKey, key.Algo, attributes)
@@ -80,6 +84,7 @@ func TestImportKey(t *testing.T) {
 	t.Run("should fail to import key if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		store.EXPECT().Import(gomock.Any(), key.ID, privKey, key.Algo, attributes).Return(key, nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
 		db.EXPECT().Add(gomock.Any(), key).Return(nil, expectedErr)
 
 		_, err := connector.Import(ctx, key.ID, privKey, key.Algo, attributes)
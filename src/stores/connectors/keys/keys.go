@@ -14,16 +14,18 @@ type Connector struct {
 	db           database.Keys
 	logger       log.Logger
 	authorizator auth.Authorizator
+	limiter      stores.Limiter
 }
 
-var _ stores.KeyStore = Connector{}
+var _ stores.KeyConnector = Connector{}
 
-func NewConnector(store stores.KeyStore, db database.Keys, authorizator auth.Authorizator, logger log.Logger) *Connector {
+func NewConnector(store stores.KeyStore, db database.Keys, authorizator auth.Authorizator, limiter stores.Limiter, logger log.Logger) *Connector {
 	return &Connector{
 		store:        store,
 		db:           db,
 		logger:       logger,
 		authorizator: authorizator,
+		limiter:      limiter,
 	}
 }
 
@@ -22,13 +22,15 @@ func TestListKey(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockKeyStore(ctrl)
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should list keys successfully", func(t *testing.T) {
 		keyOne := testutils2.FakeKey()
@@ -37,7 +39,8 @@ func TestListKey(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), false, limit, offset).Return([]string{keyOne.ID, keyTwo.ID}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, false, limit, offset).Return([]string{keyOne.ID, keyTwo.ID}, nil)
 
 		keyIDs, err := connector.List(ctx, limit, offset)
 
@@ -56,7 +59,8 @@ func TestListKey(t *testing.T) {
 
 	t.Run("should fail to list keys if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, false, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.List(ctx, uint64(0), uint64(0))
 
@@ -71,13 +75,15 @@ func TestListDeletedKey(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockKeyStore(ctrl)
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should list deleted key successfully", func(t *testing.T) {
 		keyOne := testutils2.FakeKey()
@@ -86,7 +92,8 @@ func TestListDeletedKey(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), true, limit, offset).Return([]string{keyOne.ID, keyTwo.ID}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, true, limit, offset).Return([]string{keyOne.ID, keyTwo.ID}, nil)
 
 		keyIDs, err := connector.ListDeleted(ctx, limit, offset)
 
@@ -105,7 +112,8 @@ func TestListDeletedKey(t *testing.T) {
 
 	t.Run("should fail to list deleted key if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), true, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, true, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.ListDeleted(ctx, uint64(0), uint64(0))
 
@@ -23,7 +23,12 @@ func (c Connector) Restore(ctx context.Context, id string) error {
 		return nil
 	}
 
-	_, err = c.db.GetDeleted(ctx, id)
+	key, err := c.db.GetDeleted(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, false)
 	if err != nil {
 		return err
 	}
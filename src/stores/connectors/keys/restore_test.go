@@ -30,8 +30,9 @@ func TestRestoreKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
@@ -43,6 +44,7 @@ func TestRestoreKey(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), key.ID).Return(nil)
 
@@ -54,7 +56,8 @@ func TestRestoreKey(t *testing.T) {
 	t.Run("should be idempotent when key already exists", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceKey}).Return(nil)
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
-		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
 
 		err := connector.Restore(ctx, key.ID)
 
@@ -67,6 +70,7 @@ func TestRestoreKey(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), key.ID).Return(rErr)
 
@@ -100,6 +104,7 @@ func TestRestoreKey(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), key.ID).Return(expectedErr)
 
 		err := connector.Restore(ctx, key.ID)
@@ -113,6 +118,7 @@ func TestRestoreKey(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetDeleted(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), key.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), key.ID).Return(expectedErr)
 
@@ -2,8 +2,15 @@ package keys
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"fmt"
 
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/ethereum/go-ethereum/crypto"
 
 	"github.com/consensys/quorum-key-manager/src/stores/entities"
 )
@@ -11,21 +18,16 @@ import (
 func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	logger := c.logger.With("id", id)
 
-	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey})
+	key, err := c.signingKey(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
 	if algo == nil {
-		key, derr := c.db.Get(ctx, id)
-		if derr != nil {
-			return nil, derr
-		}
-
 		algo = key.Algo
 	}
 
-	result, err := c.store.Sign(ctx, id, data, algo)
+	result, err := c.sign(ctx, id, data, algo)
 	if err != nil {
 		return nil, err
 	}
@@ -33,3 +35,147 @@ func (c Connector) Sign(ctx context.Context, id string, data []byte, algo *entit
 	logger.Debug("payload signed successfully")
 	return result, nil
 }
+
+func (c Connector) SignWithOptions(ctx context.Context, id string, data []byte, opts *entities.SignOptions) ([]byte, error) {
+	logger := c.logger.With("id", id)
+
+	if opts == nil {
+		opts = &entities.SignOptions{}
+	}
+
+	if !isSupportedEncoding(opts.Encoding) {
+		errMessage := fmt.Sprintf("unsupported signature encoding %q", opts.Encoding)
+		logger.Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	key, err := c.signingKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	digest, err := hashData(data, opts.Hash)
+	if err != nil {
+		logger.WithError(err).Error("failed to hash payload")
+		return nil, err
+	}
+
+	if key.Algo.Type == entities.Eddsa {
+		if (opts.Encoding != "" && opts.Encoding != entities.RawEncoding) || opts.LowS {
+			errMessage := "only the raw signature encoding is supported for EdDSA keys"
+			logger.With("encoding", opts.Encoding, "low_s", opts.LowS).Error(errMessage)
+			return nil, errors.InvalidParameterError(errMessage)
+		}
+
+		return c.sign(ctx, id, digest, key.Algo)
+	}
+
+	// As specified by ECDSA, digests longer than the curve order are truncated to their leftmost bits
+	if opts.Hash == entities.Sha512 {
+		digest = digest[:crypto.DigestLength]
+	}
+	if len(digest) != crypto.DigestLength {
+		errMessage := fmt.Sprintf("data is required to be exactly %d bytes (%d) when it is not hashed", crypto.DigestLength, len(digest))
+		logger.With("data_length", len(digest)).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+
+	signature, err := c.sign(ctx, id, digest, key.Algo)
+	if err != nil {
+		return nil, err
+	}
+
+	if opts.LowS {
+		signature, err = pkgcrypto.NormalizeECDSASignatureLowS(signature)
+		if err != nil {
+			errMessage := "failed to normalize ECDSA signature"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.CryptoOperationError(errMessage)
+		}
+	}
+
+	result, err := encodeECDSASignature(signature, digest, key.PublicKey, opts.Encoding)
+	if err != nil {
+		errMessage := "failed to encode ECDSA signature"
+		logger.WithError(err).With("encoding", opts.Encoding).Error(errMessage)
+		return nil, errors.CryptoOperationError(errMessage)
+	}
+
+	logger.Debug("payload signed successfully", "hash", opts.Hash, "encoding", opts.Encoding)
+	return result, nil
+}
+
+func (c Connector) signingKey(ctx context.Context, id string) (*entities.Key, error) {
+	err := c.authorizator.CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey})
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := c.db.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func (c Connector) sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	release, err := c.limiter.Acquire(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return c.store.Sign(ctx, id, data, algo)
+}
+
+func hashData(data []byte, hash entities.HashAlgorithm) ([]byte, error) {
+	switch hash {
+	case "", entities.NoHash:
+		return data, nil
+	case entities.Sha256:
+		digest := sha256.Sum256(data)
+		return digest[:], nil
+	case entities.Keccak256:
+		return crypto.Keccak256(data), nil
+	case entities.Sha512:
+		digest := sha512.Sum512(data)
+		return digest[:], nil
+	default:
+		return nil, errors.InvalidParameterError(fmt.Sprintf("unsupported hash algorithm %q", hash))
+	}
+}
+
+func isSupportedEncoding(encoding entities.SignatureEncoding) bool {
+	switch encoding {
+	case "", entities.RawEncoding, entities.RSVEncoding, entities.DEREncoding, entities.JWSEncoding:
+		return true
+	default:
+		return false
+	}
+}
+
+func encodeECDSASignature(signature, digest, pubKey []byte, encoding entities.SignatureEncoding) ([]byte, error) {
+	switch encoding {
+	case "", entities.RawEncoding:
+		return signature, nil
+	case entities.RSVEncoding:
+		recID, err := pkgcrypto.ECDSARecoveryID(digest, signature, pubKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return append(append([]byte{}, signature...), recID), nil
+	case entities.DEREncoding:
+		return pkgcrypto.MarshalECDSASignatureDER(signature)
+	case entities.JWSEncoding:
+		return []byte(base64.RawURLEncoding.EncodeToString(signature)), nil
+	default:
+		return nil, fmt.Errorf("unsupported signature encoding %q", encoding)
+	}
+}
@@ -2,18 +2,27 @@ package keys
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/sha512"
+	"encoding/asn1"
+	"encoding/base64"
 	"fmt"
+	"math/big"
 	"testing"
 
+	"github.com/consensys/quorum-key-manager/pkg/errors"
 	mock3 "github.com/consensys/quorum-key-manager/src/auth/mock"
 	"github.com/consensys/quorum-key-manager/src/auth/types"
 
 	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
 	mock2 "github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/entities"
 	testutils2 "github.com/consensys/quorum-key-manager/src/stores/entities/testutils"
 	"github.com/consensys/quorum-key-manager/src/stores/mock"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestSignKey(t *testing.T) {
@@ -31,11 +40,15 @@ func TestSignKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	t.Run("should sign data successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(result, nil)
 
 		rResult, err := connector.Sign(ctx, key.ID, data, algo)
@@ -47,6 +60,8 @@ func TestSignKey(t *testing.T) {
 	t.Run("should sign data with key algo successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(ctx, key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Sign(ctx, key.ID, data, key.Algo).Return(result, nil)
 
 		rResult, err := connector.Sign(ctx, key.ID, data, nil)
@@ -64,8 +79,34 @@ func TestSignKey(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 
+	t.Run("should fail with same error if key belongs to another tenant", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(expectedErr)
+
+		_, err := connector.Sign(ctx, key.ID, data, algo)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
+	t.Run("should fail with same error if rate limit is exceeded", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(nil, expectedErr)
+
+		_, err := connector.Sign(ctx, key.ID, data, algo)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
 	t.Run("should fail to sign data if sign fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), key.ID).Return(func() {}, nil)
 		store.EXPECT().Sign(gomock.Any(), key.ID, data, algo).Return(nil, expectedErr)
 
 		_, err := connector.Sign(ctx, key.ID, data, algo)
@@ -84,3 +125,107 @@ func TestSignKey(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 }
+
+func TestSignKeyWithOptions(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	privKey, _ := crypto.HexToECDSA("56202652fdffd802b7252a456dbd8f3ecc0352bbde76c23b40afe8aebd714e2e")
+	data := []byte("my data to sign")
+	key := testutils2.FakeKey()
+	key.PublicKey = crypto.FromECDSAPub(&privKey.PublicKey)
+
+	// signDigest signs as the stores do, returning R || S with a high S if requested
+	signDigest := func(digest []byte, highS bool) []byte {
+		sig, err := crypto.Sign(digest, privKey)
+		require.NoError(t, err)
+		if highS {
+			s := new(big.Int).Sub(crypto.S256().Params().N, new(big.Int).SetBytes(sig[32:64]))
+			s.FillBytes(sig[32:64])
+		}
+		return sig[:64]
+	}
+
+	store := mock.NewMockKeyStore(ctrl)
+	db := mock2.NewMockKeys(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
+
+	connector := NewConnector(store, db, auth, limiter, logger)
+
+	expectSign := func(k *entities.Key, digest, signature []byte) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), k.ID).Return(k, nil)
+		auth.EXPECT().CheckOwnership(k.Metadata.Tenant, k.Metadata.Shared).Return(nil)
+		limiter.EXPECT().Acquire(gomock.Any(), k.ID).Return(func() {}, nil)
+		store.EXPECT().Sign(gomock.Any(), k.ID, digest, k.Algo).Return(signature, nil)
+	}
+
+	t.Run("should hash with keccak256 and return the R || S || V signature", func(t *testing.T) {
+		digest := crypto.Keccak256(data)
+		expectSign(key, digest, signDigest(digest, false))
+
+		signature, err := connector.SignWithOptions(ctx, key.ID, data, &entities.SignOptions{Hash: entities.Keccak256, Encoding: entities.RSVEncoding})
+
+		require.NoError(t, err)
+		require.Len(t, signature, 65)
+		pubKey, err := crypto.Ecrecover(digest, signature)
+		require.NoError(t, err)
+		assert.Equal(t, key.PublicKey, pubKey)
+	})
+
+	t.Run("should hash with sha512, normalize S and return the DER signature", func(t *testing.T) {
+		digest := sha512.Sum512(data)
+		expectSign(key, digest[:32], signDigest(digest[:32], true))
+
+		signature, err := connector.SignWithOptions(ctx, key.ID, data, &entities.SignOptions{Hash: entities.Sha512, Encoding: entities.DEREncoding, LowS: true})
+
+		require.NoError(t, err)
+		sig := struct{ R, S *big.Int }{}
+		_, err = asn1.Unmarshal(signature, &sig)
+		require.NoError(t, err)
+		assert.True(t, sig.S.Cmp(new(big.Int).Rsh(crypto.S256().Params().N, 1)) <= 0)
+		assert.True(t, ecdsa.Verify(&privKey.PublicKey, digest[:], sig.R, sig.S))
+	})
+
+	t.Run("should return the JWS signature", func(t *testing.T) {
+		digest := crypto.Keccak256(data)
+		rawSignature := signDigest(digest, false)
+		expectSign(key, digest, rawSignature)
+
+		signature, err := connector.SignWithOptions(ctx, key.ID, digest, &entities.SignOptions{Encoding: entities.JWSEncoding})
+
+		require.NoError(t, err)
+		assert.Equal(t, base64.RawURLEncoding.EncodeToString(rawSignature), string(signature))
+	})
+
+	t.Run("should fail with InvalidParameterError if data is not hashed and is not a digest", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, key.Metadata.Shared).Return(nil)
+
+		_, err := connector.SignWithOptions(ctx, key.ID, data, nil)
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if encoding is not supported", func(t *testing.T) {
+		_, err := connector.SignWithOptions(ctx, key.ID, data, &entities.SignOptions{Encoding: "hex"})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+
+	t.Run("should fail with InvalidParameterError if encoding is not supported by EdDSA keys", func(t *testing.T) {
+		eddsaKey := testutils2.FakeKey()
+		eddsaKey.Algo = &entities.Algorithm{Type: entities.Eddsa, EllipticCurve: entities.Babyjubjub}
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionSign, Resource: types.ResourceKey}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), eddsaKey.ID).Return(eddsaKey, nil)
+		auth.EXPECT().CheckOwnership(eddsaKey.Metadata.Tenant, eddsaKey.Metadata.Shared).Return(nil)
+
+		_, err := connector.SignWithOptions(ctx, eddsaKey.ID, data, &entities.SignOptions{Encoding: entities.DEREncoding})
+
+		assert.True(t, errors.IsInvalidParameterError(err))
+	})
+}
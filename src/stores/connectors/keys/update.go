@@ -24,6 +24,11 @@ func (c Connector) Update(ctx context.Context, id string, attr *entities.Attribu
 	if err != nil {
 		return nil, err
 	}
+
+	err = c.authorizator.CheckOwnership(key.Metadata.Tenant, false)
+	if err != nil {
+		return nil, err
+	}
 	key.Tags = attr.Tags
 
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Keys) error {
@@ -31,8 +31,9 @@ func TestUpdateKey(t *testing.T) {
 	db := mock2.NewMockKeys(ctrl)
 	logger := testutils.NewMockLogger(ctrl)
 	auth := mock3.NewMockAuthorizator(ctrl)
+	limiter := mock.NewMockLimiter(ctrl)
 
-	connector := NewConnector(store, db, auth, logger)
+	connector := NewConnector(store, db, auth, limiter, logger)
 
 	db.EXPECT().RunInTransaction(gomock.Any(), gomock.Any()).
 		DoAndReturn(func(ctx context.Context, persist func(dbtx database.Keys) error) error {
@@ -45,6 +46,7 @@ func TestUpdateKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), key).Return(updatedKey, nil)
 		store.EXPECT().Update(gomock.Any(), key.ID, attributes).Return(updatedKey, nil)
 
@@ -59,6 +61,7 @@ func TestUpdateKey(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), key).Return(key, nil)
 		store.EXPECT().Update(gomock.Any(), key.ID, attributes).Return(nil, rErr)
 
@@ -89,6 +92,7 @@ func TestUpdateKey(t *testing.T) {
 	t.Run("should fail to update key if db fail to update", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), key).Return(nil, expectedErr)
 
 		_, err := connector.Update(ctx, key.ID, attributes)
@@ -100,6 +104,7 @@ func TestUpdateKey(t *testing.T) {
 	t.Run("should fail to update key if store fail to update", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceKey}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), key.ID).Return(key, nil)
+		auth.EXPECT().CheckOwnership(key.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Update(gomock.Any(), key).Return(key, nil)
 		store.EXPECT().Update(gomock.Any(), key.ID, attributes).Return(nil, expectedErr)
 
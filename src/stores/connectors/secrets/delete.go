@@ -18,6 +18,16 @@ func (c Connector) Delete(ctx context.Context, id string) error {
 		return err
 	}
 
+	secret, err := c.db.Get(ctx, id, "")
+	if err != nil {
+		return err
+	}
+
+	err = c.authorizator.CheckOwnership(secret.Metadata.Tenant, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
 		derr := dbtx.Delete(ctx, id)
 		if derr != nil {
@@ -41,6 +41,8 @@ func TestDeleteSecret(t *testing.T) {
 		secret := testutils2.FakeSecret()
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), secret.ID).Return(nil)
 
@@ -54,6 +56,8 @@ func TestDeleteSecret(t *testing.T) {
 		rErr := errors.NotSupportedError("not supported")
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), secret.ID).Return(rErr)
 
@@ -76,6 +80,8 @@ func TestDeleteSecret(t *testing.T) {
 		secret := testutils2.FakeSecret()
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), secret.ID).Return(expectedErr)
 
 		err := connector.Delete(ctx, secret.ID)
@@ -87,6 +93,8 @@ func TestDeleteSecret(t *testing.T) {
 		secret := testutils2.FakeSecret()
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceSecret}).Return(nil)
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Delete(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Delete(gomock.Any(), secret.ID).Return(expectedErr)
 
@@ -41,6 +41,7 @@ func TestDestroySecret(t *testing.T) {
 	t.Run("should destroy secret successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), secret.ID).Return(nil)
 
@@ -54,6 +55,7 @@ func TestDestroySecret(t *testing.T) {
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), secret.ID).Return(rErr)
 
@@ -82,6 +84,7 @@ func TestDestroySecret(t *testing.T) {
 	t.Run("should fail to destroy secret if db fail to purge", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), secret.ID).Return(expectedErr)
 
 		err := connector.Destroy(ctx, secret.ID)
@@ -92,6 +95,7 @@ func TestDestroySecret(t *testing.T) {
 	t.Run("should fail to destroy secret if store fail to destroy", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDestroy, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Purge(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Destroy(gomock.Any(), secret.ID).Return(expectedErr)
 
@@ -31,6 +31,11 @@ func (c Connector) Get(ctx context.Context, id, version string) (*entities.Secre
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	secretVault, err := c.store.Get(ctx, id, version)
 	if err != nil {
 		return nil, err
@@ -54,6 +59,11 @@ func (c Connector) GetDeleted(ctx context.Context, id string) (*entities.Secret,
 		return nil, err
 	}
 
+	err = c.authorizator.CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared)
+	if err != nil {
+		return nil, err
+	}
+
 	logger.Debug("deleted secret retrieved successfully")
 	return secret, nil
 }
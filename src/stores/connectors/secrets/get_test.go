@@ -34,6 +34,7 @@ func TestGetSecret(t *testing.T) {
 	t.Run("should get secret successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared).Return(nil)
 		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
 
 		rSecret, err := connector.Get(ctx, secret.ID, secret.Metadata.Version)
@@ -64,6 +65,7 @@ func TestGetSecret(t *testing.T) {
 	t.Run("should fail to get secret value", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared).Return(nil)
 		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, expectedErr)
 
 		_, err := connector.Get(ctx, secret.ID, secret.Metadata.Version)
@@ -91,6 +93,7 @@ func TestGetDeletedSecret(t *testing.T) {
 	t.Run("should get deleted secret successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared).Return(nil)
 
 		rSecret, err := connector.GetDeleted(ctx, secret.ID)
 
@@ -12,7 +12,7 @@ func (c Connector) List(ctx context.Context, limit, offset uint64) ([]string, er
 		return nil, err
 	}
 
-	ids, err := c.db.SearchIDs(ctx, false, limit, offset)
+	ids, err := c.db.SearchIDs(ctx, c.authorizator.AccessibleTenants(), false, limit, offset)
 	if err != nil {
 		return nil, err
 	}
@@ -27,7 +27,7 @@ func (c Connector) ListDeleted(ctx context.Context, limit, offset uint64) ([]str
 		return nil, err
 	}
 
-	ids, err := c.db.SearchIDs(ctx, true, limit, offset)
+	ids, err := c.db.SearchIDs(ctx, c.authorizator.AccessibleTenants(), true, limit, offset)
 	if err != nil {
 		return nil, err
 	}
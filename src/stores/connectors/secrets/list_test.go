@@ -22,6 +22,7 @@ func TestListSecret(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockSecretStore(ctrl)
 	db := mock2.NewMockSecrets(ctrl)
@@ -36,7 +37,8 @@ func TestListSecret(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), false, limit, offset).Return([]string{secretOne.ID, secretTwo.ID}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, false, limit, offset).Return([]string{secretOne.ID, secretTwo.ID}, nil)
 
 		secretIDs, err := connector.List(ctx, limit, offset)
 
@@ -55,7 +57,8 @@ func TestListSecret(t *testing.T) {
 
 	t.Run("should fail to list deleted secret if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), false, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, false, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.List(ctx, uint64(0), uint64(0))
 
@@ -70,6 +73,7 @@ func TestListDeletedSecret(t *testing.T) {
 	defer ctrl.Finish()
 
 	expectedErr := fmt.Errorf("error")
+	tenants := []string{"tenantOne"}
 
 	store := mock.NewMockSecretStore(ctrl)
 	db := mock2.NewMockSecrets(ctrl)
@@ -85,7 +89,8 @@ func TestListDeletedSecret(t *testing.T) {
 		offset := uint64(4)
 
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), true, limit, offset).Return([]string{secretOne.ID, secretTwo.ID}, nil)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, true, limit, offset).Return([]string{secretOne.ID, secretTwo.ID}, nil)
 
 		secretIDs, err := connector.ListDeleted(ctx, limit, offset)
 
@@ -104,7 +109,8 @@ func TestListDeletedSecret(t *testing.T) {
 
 	t.Run("should fail to list deleted secret if db fails", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
-		db.EXPECT().SearchIDs(gomock.Any(), true, uint64(0), uint64(0)).Return(nil, expectedErr)
+		auth.EXPECT().AccessibleTenants().Return(tenants)
+		db.EXPECT().SearchIDs(gomock.Any(), tenants, true, uint64(0), uint64(0)).Return(nil, expectedErr)
 
 		_, err := connector.ListDeleted(ctx, uint64(0), uint64(0))
 
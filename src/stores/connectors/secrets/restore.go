@@ -29,6 +29,11 @@ func (c Connector) Restore(ctx context.Context, id string) error {
 		return err
 	}
 
+	err = c.authorizator.CheckOwnership(secret.Metadata.Tenant, false)
+	if err != nil {
+		return err
+	}
+
 	err = c.db.RunInTransaction(ctx, func(dbtx database.Secrets) error {
 		err = dbtx.Restore(ctx, secret.ID)
 		if err != nil {
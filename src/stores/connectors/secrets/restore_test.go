@@ -44,6 +44,7 @@ func TestRestoreSecret(t *testing.T) {
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
 
@@ -56,6 +57,7 @@ func TestRestoreSecret(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionDelete, Resource: types.ResourceSecret}).Return(nil)
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionRead, Resource: types.ResourceSecret}).Return(nil)
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, secret.Metadata.Shared).Return(nil)
 		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
 		store.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(secret, nil)
 
@@ -72,6 +74,7 @@ func TestRestoreSecret(t *testing.T) {
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), secret.ID).Return(rErr)
 
@@ -108,6 +111,7 @@ func TestRestoreSecret(t *testing.T) {
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(expectedErr)
 
 		err := connector.Restore(ctx, secret.ID)
@@ -122,6 +126,7 @@ func TestRestoreSecret(t *testing.T) {
 		db.EXPECT().Get(gomock.Any(), secret.ID, secret.Metadata.Version).Return(nil, errors.NotFoundError("error"))
 		db.EXPECT().GetLatestVersion(gomock.Any(), secret.ID, false).Return(secret.Metadata.Version, nil)
 		db.EXPECT().GetDeleted(gomock.Any(), secret.ID).Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(nil)
 		db.EXPECT().Restore(gomock.Any(), secret.ID).Return(nil)
 		store.EXPECT().Restore(gomock.Any(), secret.ID).Return(expectedErr)
 
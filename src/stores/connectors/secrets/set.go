@@ -19,6 +19,20 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	// A new version of an existing secret can only be set by its owner and keeps its tenant
+	tenant := c.authorizator.Tenant()
+	current, err := c.db.Get(ctx, id, "")
+	switch {
+	case err == nil:
+		err = c.authorizator.CheckOwnership(current.Metadata.Tenant, false)
+		if err != nil {
+			return nil, err
+		}
+		tenant = current.Metadata.Tenant
+	case !errors.IsNotFoundError(err):
+		return nil, err
+	}
+
 	secret, err := c.store.Set(ctx, id, value, attr)
 	if err != nil && errors.IsAlreadyExistsError(err) {
 		secret, err = c.store.Get(ctx, id, "")
@@ -27,6 +41,9 @@ func (c Connector) Set(ctx context.Context, id, value string, attr *entities.Att
 		return nil, err
 	}
 
+	secret.Metadata.Tenant = tenant
+	secret.Metadata.Shared = attr.Shared
+
 	_, err = c.db.Add(ctx, secret)
 	if err != nil {
 		return nil, err
@@ -36,6 +36,8 @@ func TestSetSecret(t *testing.T) {
 
 	t.Run("should set secret successfully", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(nil, errors.NotFoundError("error"))
 		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(secret, nil)
 		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
 
@@ -43,10 +45,13 @@ func TestSetSecret(t *testing.T) {
 
 		assert.NoError(t, err)
 		assert.Equal(t, rSecret, secret)
+		assert.Equal(t, "tenantOne", rSecret.Metadata.Tenant)
 	})
 
 	t.Run("should create key successfully if it already exists in the vault", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(nil, errors.NotFoundError("error"))
 		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(nil, errors.AlreadyExistsError("error"))
 		store.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
 		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
@@ -66,8 +71,39 @@ func TestSetSecret(t *testing.T) {
 		assert.Equal(t, err, expectedErr)
 	})
 
+	t.Run("should set a new version of an existing secret successfully and keep its tenant", func(t *testing.T) {
+		current := testutils2.FakeSecret()
+		current.Metadata.Tenant = "tenantTwo"
+
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(current, nil)
+		auth.EXPECT().CheckOwnership("tenantTwo", false).Return(nil)
+		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(secret, nil)
+		db.EXPECT().Add(gomock.Any(), secret).Return(secret, nil)
+
+		rSecret, err := connector.Set(ctx, secret.ID, secret.Value, attributes)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "tenantTwo", rSecret.Metadata.Tenant)
+	})
+
+	t.Run("should fail with same error if existing secret belongs to another tenant", func(t *testing.T) {
+		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(secret, nil)
+		auth.EXPECT().CheckOwnership(secret.Metadata.Tenant, false).Return(expectedErr)
+
+		_, err := connector.Set(ctx, secret.ID, secret.Value, attributes)
+
+		assert.Error(t, err)
+		assert.Equal(t, err, expectedErr)
+	})
+
 	t.Run("should fail to delete secret if store fail to set", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(nil, errors.NotFoundError("error"))
 		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(nil, expectedErr)
 
 		_, err := connector.Set(ctx, secret.ID, secret.Value, attributes)
@@ -78,6 +114,8 @@ func TestSetSecret(t *testing.T) {
 
 	t.Run("should fail to set secret if db fail to add", func(t *testing.T) {
 		auth.EXPECT().CheckPermission(&types.Operation{Action: types.ActionWrite, Resource: types.ResourceSecret}).Return(nil)
+		auth.EXPECT().Tenant().Return("tenantOne")
+		db.EXPECT().Get(gomock.Any(), secret.ID, "").Return(nil, errors.NotFoundError("error"))
 		store.EXPECT().Set(gomock.Any(), secret.ID, secret.Value, attributes).Return(secret, nil)
 		db.EXPECT().Add(gomock.Any(), secret).Return(nil, expectedErr)
 
@@ -18,7 +18,7 @@ func (c *Connector) GetSecretStore(_ context.Context, storeName string, userInfo
 	c.mux.RLock()
 	defer c.mux.RUnlock()
 
-	if storeBundle, ok := c.secrets[storeName]; ok {
+	if storeBundle, ok := c.secrets[storeName]; ok && inStoreScope(storeName, userInfo) {
 		permissions := c.authManager.UserPermissions(userInfo)
 		resolver := authorizator.New(permissions, userInfo.Tenant, storeBundle.logger)
 
@@ -36,10 +36,10 @@ func (c *Connector) GetSecretStore(_ context.Context, storeName string, userInfo
 	return nil, errors.NotFoundError(errMessage)
 }
 
-func (c *Connector) GetKeyStore(_ context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.KeyStore, error) {
+func (c *Connector) GetKeyStore(_ context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.KeyConnector, error) {
 	c.mux.RLock()
 	defer c.mux.RUnlock()
-	if storeBundle, ok := c.keys[storeName]; ok {
+	if storeBundle, ok := c.keys[storeName]; ok && inStoreScope(storeName, userInfo) {
 		permissions := c.authManager.UserPermissions(userInfo)
 		resolver := authorizator.New(permissions, userInfo.Tenant, storeBundle.logger)
 
@@ -48,7 +48,7 @@ func (c *Connector) GetKeyStore(_ context.Context, storeName string, userInfo *a
 		}
 
 		if store, ok := storeBundle.store.(stores.KeyStore); ok {
-			return keys.NewConnector(store, c.db.Keys(storeName), resolver, storeBundle.logger), nil
+			return keys.NewConnector(store, c.db.Keys(storeName), resolver, c.limiter.For(storeName, userInfo), storeBundle.logger), nil
 		}
 	}
 
@@ -95,7 +95,7 @@ func (c *Connector) GetEthStoreByAddr(ctx context.Context, addr common.Address,
 }
 
 func (c *Connector) getEthStore(_ context.Context, storeName string, userInfo *authtypes.UserInfo) (stores.EthStore, error) {
-	if storeBundle, ok := c.ethAccounts[storeName]; ok {
+	if storeBundle, ok := c.ethAccounts[storeName]; ok && inStoreScope(storeName, userInfo) {
 		permissions := c.authManager.UserPermissions(userInfo)
 		resolver := authorizator.New(permissions, userInfo.Tenant, storeBundle.logger)
 
@@ -104,7 +104,12 @@ func (c *Connector) getEthStore(_ context.Context, storeName string, userInfo *a
 		}
 
 		if store, ok := storeBundle.store.(stores.KeyStore); ok {
-			return eth.NewConnector(store, c.db.ETHAccounts(storeName), resolver, storeBundle.logger), nil
+			connector := eth.NewConnector(store, c.db.ETHAccounts(storeName), resolver, c.limiter.For(storeName, userInfo), storeBundle.logger)
+			if len(userInfo.Addresses) > 0 {
+				return eth.NewRestrictedConnector(connector, userInfo.Addresses, storeBundle.logger), nil
+			}
+
+			return connector, nil
 		}
 	}
 
@@ -112,3 +117,18 @@ func (c *Connector) getEthStore(_ context.Context, storeName string, userInfo *a
 	c.logger.Error(errMessage, "store_name", storeName)
 	return nil, errors.NotFoundError(errMessage)
 }
+
+// inStoreScope indicates whether the credentials of the user give access to the store. Credentials not restricted to a set of stores access all stores
+func inStoreScope(storeName string, userInfo *authtypes.UserInfo) bool {
+	if len(userInfo.Stores) == 0 {
+		return true
+	}
+
+	for _, s := range userInfo.Stores {
+		if s == storeName {
+			return true
+		}
+	}
+
+	return false
+}
@@ -40,6 +40,10 @@ func (c *Connector) ListAllAccounts(ctx context.Context, userInfo *authtypes.Use
 func (c *Connector) listStores(list map[string]*storeBundle, kind manifest.Kind, userInfo *authtypes.UserInfo) []string {
 	var storeNames []string
 	for k, storeBundle := range list {
+		if !inStoreScope(k, userInfo) {
+			continue
+		}
+
 		permissions := c.authManager.UserPermissions(userInfo)
 		resolver := authorizator.New(permissions, userInfo.Tenant, storeBundle.logger)
 
@@ -8,6 +8,7 @@ import (
 	manifest "github.com/consensys/quorum-key-manager/src/manifests/entities"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 )
 
 type Connector struct {
@@ -19,7 +20,8 @@ type Connector struct {
 	keys        map[string]*storeBundle
 	ethAccounts map[string]*storeBundle
 
-	db database.Database
+	db      database.Database
+	limiter *limiter.RateLimiter
 }
 
 type storeBundle struct {
@@ -30,7 +32,7 @@ type storeBundle struct {
 
 var _ stores.Stores = &Connector{}
 
-func NewConnector(authMngr auth.Manager, db database.Database, logger log.Logger) *Connector {
+func NewConnector(authMngr auth.Manager, db database.Database, rateLimiter *limiter.RateLimiter, logger log.Logger) *Connector {
 	return &Connector{
 		logger:      logger,
 		mux:         sync.RWMutex{},
@@ -39,5 +41,6 @@ func NewConnector(authMngr auth.Manager, db database.Database, logger log.Logger
 		keys:        make(map[string]*storeBundle),
 		ethAccounts: make(map[string]*storeBundle),
 		db:          db,
+		limiter:     rateLimiter,
 	}
 }
@@ -6,36 +6,37 @@ package mock
 
 import (
 	context "context"
+	reflect "reflect"
+
 	database "github.com/consensys/quorum-key-manager/src/stores/database"
 	entities "github.com/consensys/quorum-key-manager/src/stores/entities"
 	gomock "github.com/golang/mock/gomock"
-	reflect "reflect"
 )
 
-// MockDatabase is a mock of Database interface
+// MockDatabase is a mock of Database interface.
 type MockDatabase struct {
 	ctrl     *gomock.Controller
 	recorder *MockDatabaseMockRecorder
 }
 
-// MockDatabaseMockRecorder is the mock recorder for MockDatabase
+// MockDatabaseMockRecorder is the mock recorder for MockDatabase.
 type MockDatabaseMockRecorder struct {
 	mock *MockDatabase
 }
 
-// NewMockDatabase creates a new mock instance
+// NewMockDatabase creates a new mock instance.
 func NewMockDatabase(ctrl *gomock.Controller) *MockDatabase {
 	mock := &MockDatabase{ctrl: ctrl}
 	mock.recorder = &MockDatabaseMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockDatabase) EXPECT() *MockDatabaseMockRecorder {
 	return m.recorder
 }
 
-// ETHAccounts mocks base method
+// ETHAccounts mocks base method.
 func (m *MockDatabase) ETHAccounts(storeID string) database.ETHAccounts {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ETHAccounts", storeID)
@@ -43,41 +44,41 @@ func (m *MockDatabase) ETHAccounts(storeID string) database.ETHAccounts {
 	return ret0
 }
 
-// ETHAccounts indicates an expected call of ETHAccounts
+// ETHAccounts indicates an expected call of ETHAccounts.
 func (mr *MockDatabaseMockRecorder) ETHAccounts(storeID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ETHAccounts", reflect.TypeOf((*MockDatabase)(nil).ETHAccounts), storeID)
 }
 
-// Ping mocks base method
-func (m *MockDatabase) Ping(ctx context.Context) error {
+// Keys mocks base method.
+func (m *MockDatabase) Keys(storeID string) database.Keys {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Ping", ctx)
-	ret0, _ := ret[0].(error)
+	ret := m.ctrl.Call(m, "Keys", storeID)
+	ret0, _ := ret[0].(database.Keys)
 	return ret0
 }
 
-// Ping indicates an expected call of Ping
-func (mr *MockDatabaseMockRecorder) Ping(ctx interface{}) *gomock.Call {
+// Keys indicates an expected call of Keys.
+func (mr *MockDatabaseMockRecorder) Keys(storeID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockDatabase)(nil).Ping), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockDatabase)(nil).Keys), storeID)
 }
 
-// Keys mocks base method
-func (m *MockDatabase) Keys(storeID string) database.Keys {
+// Ping mocks base method.
+func (m *MockDatabase) Ping(ctx context.Context) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Keys", storeID)
-	ret0, _ := ret[0].(database.Keys)
+	ret := m.ctrl.Call(m, "Ping", ctx)
+	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Keys indicates an expected call of Keys
-func (mr *MockDatabaseMockRecorder) Keys(storeID interface{}) *gomock.Call {
+// Ping indicates an expected call of Ping.
+func (mr *MockDatabaseMockRecorder) Ping(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Keys", reflect.TypeOf((*MockDatabase)(nil).Keys), storeID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ping", reflect.TypeOf((*MockDatabase)(nil).Ping), ctx)
 }
 
-// Secrets mocks base method
+// Secrets mocks base method.
 func (m *MockDatabase) Secrets(storeID string) database.Secrets {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Secrets", storeID)
@@ -85,80 +86,80 @@ func (m *MockDatabase) Secrets(storeID string) database.Secrets {
 	return ret0
 }
 
-// Secrets indicates an expected call of Secrets
+// Secrets indicates an expected call of Secrets.
 func (mr *MockDatabaseMockRecorder) Secrets(storeID interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Secrets", reflect.TypeOf((*MockDatabase)(nil).Secrets), storeID)
 }
 
-// MockETHAccounts is a mock of ETHAccounts interface
+// MockETHAccounts is a mock of ETHAccounts interface.
 type MockETHAccounts struct {
 	ctrl     *gomock.Controller
 	recorder *MockETHAccountsMockRecorder
 }
 
-// MockETHAccountsMockRecorder is the mock recorder for MockETHAccounts
+// MockETHAccountsMockRecorder is the mock recorder for MockETHAccounts.
 type MockETHAccountsMockRecorder struct {
 	mock *MockETHAccounts
 }
 
-// NewMockETHAccounts creates a new mock instance
+// NewMockETHAccounts creates a new mock instance.
 func NewMockETHAccounts(ctrl *gomock.Controller) *MockETHAccounts {
 	mock := &MockETHAccounts{ctrl: ctrl}
 	mock.recorder = &MockETHAccountsMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockETHAccounts) EXPECT() *MockETHAccountsMockRecorder {
 	return m.recorder
 }
 
-// RunInTransaction mocks base method
-func (m *MockETHAccounts) RunInTransaction(ctx context.Context, persistFunc func(database.ETHAccounts) error) error {
+// Add mocks base method.
+func (m *MockETHAccounts) Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Add", ctx, account)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RunInTransaction indicates an expected call of RunInTransaction
-func (mr *MockETHAccountsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+// Add indicates an expected call of Add.
+func (mr *MockETHAccountsMockRecorder) Add(ctx, account interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockETHAccounts)(nil).RunInTransaction), ctx, persistFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockETHAccounts)(nil).Add), ctx, account)
 }
 
-// Get mocks base method
-func (m *MockETHAccounts) Get(ctx context.Context, addr string) (*entities.ETHAccount, error) {
+// Delete mocks base method.
+func (m *MockETHAccounts) Delete(ctx context.Context, addr string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, addr)
-	ret0, _ := ret[0].(*entities.ETHAccount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Delete", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Get indicates an expected call of Get
-func (mr *MockETHAccountsMockRecorder) Get(ctx, addr interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockETHAccountsMockRecorder) Delete(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockETHAccounts)(nil).Get), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockETHAccounts)(nil).Delete), ctx, addr)
 }
 
-// GetDeleted mocks base method
-func (m *MockETHAccounts) GetDeleted(ctx context.Context, addr string) (*entities.ETHAccount, error) {
+// Get mocks base method.
+func (m *MockETHAccounts) Get(ctx context.Context, addr string) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeleted", ctx, addr)
+	ret := m.ctrl.Call(m, "Get", ctx, addr)
 	ret0, _ := ret[0].(*entities.ETHAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDeleted indicates an expected call of GetDeleted
-func (mr *MockETHAccountsMockRecorder) GetDeleted(ctx, addr interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockETHAccountsMockRecorder) Get(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockETHAccounts)(nil).GetDeleted), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockETHAccounts)(nil).Get), ctx, addr)
 }
 
-// GetAll mocks base method
+// GetAll mocks base method.
 func (m *MockETHAccounts) GetAll(ctx context.Context) ([]*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAll", ctx)
@@ -167,13 +168,13 @@ func (m *MockETHAccounts) GetAll(ctx context.Context) ([]*entities.ETHAccount, e
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll
+// GetAll indicates an expected call of GetAll.
 func (mr *MockETHAccountsMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockETHAccounts)(nil).GetAll), ctx)
 }
 
-// GetAllDeleted mocks base method
+// GetAllDeleted mocks base method.
 func (m *MockETHAccounts) GetAllDeleted(ctx context.Context) ([]*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllDeleted", ctx)
@@ -182,167 +183,167 @@ func (m *MockETHAccounts) GetAllDeleted(ctx context.Context) ([]*entities.ETHAcc
 	return ret0, ret1
 }
 
-// GetAllDeleted indicates an expected call of GetAllDeleted
+// GetAllDeleted indicates an expected call of GetAllDeleted.
 func (mr *MockETHAccountsMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockETHAccounts)(nil).GetAllDeleted), ctx)
 }
 
-// SearchAddresses mocks base method
-func (m *MockETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
+// GetDeleted mocks base method.
+func (m *MockETHAccounts) GetDeleted(ctx context.Context, addr string) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchAddresses", ctx, isDeleted, limit, offset)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, addr)
+	ret0, _ := ret[0].(*entities.ETHAccount)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchAddresses indicates an expected call of SearchAddresses
-func (mr *MockETHAccountsMockRecorder) SearchAddresses(ctx, isDeleted, limit, offset interface{}) *gomock.Call {
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockETHAccountsMockRecorder) GetDeleted(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAddresses", reflect.TypeOf((*MockETHAccounts)(nil).SearchAddresses), ctx, isDeleted, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockETHAccounts)(nil).GetDeleted), ctx, addr)
 }
 
-// Add mocks base method
-func (m *MockETHAccounts) Add(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
+// Purge mocks base method.
+func (m *MockETHAccounts) Purge(ctx context.Context, addr string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, account)
-	ret0, _ := ret[0].(*entities.ETHAccount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Purge", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Add indicates an expected call of Add
-func (mr *MockETHAccountsMockRecorder) Add(ctx, account interface{}) *gomock.Call {
+// Purge indicates an expected call of Purge.
+func (mr *MockETHAccountsMockRecorder) Purge(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockETHAccounts)(nil).Add), ctx, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockETHAccounts)(nil).Purge), ctx, addr)
 }
 
-// Update mocks base method
-func (m *MockETHAccounts) Update(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
+// Restore mocks base method.
+func (m *MockETHAccounts) Restore(ctx context.Context, addr string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, account)
-	ret0, _ := ret[0].(*entities.ETHAccount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Restore", ctx, addr)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Update indicates an expected call of Update
-func (mr *MockETHAccountsMockRecorder) Update(ctx, account interface{}) *gomock.Call {
+// Restore indicates an expected call of Restore.
+func (mr *MockETHAccountsMockRecorder) Restore(ctx, addr interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockETHAccounts)(nil).Update), ctx, account)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockETHAccounts)(nil).Restore), ctx, addr)
 }
 
-// Delete mocks base method
-func (m *MockETHAccounts) Delete(ctx context.Context, addr string) error {
+// RunInTransaction mocks base method.
+func (m *MockETHAccounts) RunInTransaction(ctx context.Context, persistFunc func(database.ETHAccounts) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, addr)
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete
-func (mr *MockETHAccountsMockRecorder) Delete(ctx, addr interface{}) *gomock.Call {
+// RunInTransaction indicates an expected call of RunInTransaction.
+func (mr *MockETHAccountsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockETHAccounts)(nil).Delete), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockETHAccounts)(nil).RunInTransaction), ctx, persistFunc)
 }
 
-// Restore mocks base method
-func (m *MockETHAccounts) Restore(ctx context.Context, addr string) error {
+// SearchAddresses mocks base method.
+func (m *MockETHAccounts) SearchAddresses(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Restore", ctx, addr)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SearchAddresses", ctx, tenants, isDeleted, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Restore indicates an expected call of Restore
-func (mr *MockETHAccountsMockRecorder) Restore(ctx, addr interface{}) *gomock.Call {
+// SearchAddresses indicates an expected call of SearchAddresses.
+func (mr *MockETHAccountsMockRecorder) SearchAddresses(ctx, tenants, isDeleted, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockETHAccounts)(nil).Restore), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchAddresses", reflect.TypeOf((*MockETHAccounts)(nil).SearchAddresses), ctx, tenants, isDeleted, limit, offset)
 }
 
-// Purge mocks base method
-func (m *MockETHAccounts) Purge(ctx context.Context, addr string) error {
+// Update mocks base method.
+func (m *MockETHAccounts) Update(ctx context.Context, account *entities.ETHAccount) (*entities.ETHAccount, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Purge", ctx, addr)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Update", ctx, account)
+	ret0, _ := ret[0].(*entities.ETHAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Purge indicates an expected call of Purge
-func (mr *MockETHAccountsMockRecorder) Purge(ctx, addr interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockETHAccountsMockRecorder) Update(ctx, account interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockETHAccounts)(nil).Purge), ctx, addr)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockETHAccounts)(nil).Update), ctx, account)
 }
 
-// MockKeys is a mock of Keys interface
+// MockKeys is a mock of Keys interface.
 type MockKeys struct {
 	ctrl     *gomock.Controller
 	recorder *MockKeysMockRecorder
 }
 
-// MockKeysMockRecorder is the mock recorder for MockKeys
+// MockKeysMockRecorder is the mock recorder for MockKeys.
 type MockKeysMockRecorder struct {
 	mock *MockKeys
 }
 
-// NewMockKeys creates a new mock instance
+// NewMockKeys creates a new mock instance.
 func NewMockKeys(ctrl *gomock.Controller) *MockKeys {
 	mock := &MockKeys{ctrl: ctrl}
 	mock.recorder = &MockKeysMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockKeys) EXPECT() *MockKeysMockRecorder {
 	return m.recorder
 }
 
-// RunInTransaction mocks base method
-func (m *MockKeys) RunInTransaction(ctx context.Context, persistFunc func(database.Keys) error) error {
+// Add mocks base method.
+func (m *MockKeys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Add", ctx, key)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// RunInTransaction indicates an expected call of RunInTransaction
-func (mr *MockKeysMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+// Add indicates an expected call of Add.
+func (mr *MockKeysMockRecorder) Add(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockKeys)(nil).RunInTransaction), ctx, persistFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockKeys)(nil).Add), ctx, key)
 }
 
-// Get mocks base method
-func (m *MockKeys) Get(ctx context.Context, id string) (*entities.Key, error) {
+// Delete mocks base method.
+func (m *MockKeys) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Get", ctx, id)
-	ret0, _ := ret[0].(*entities.Key)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Get indicates an expected call of Get
-func (mr *MockKeysMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockKeysMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeys)(nil).Get), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeys)(nil).Delete), ctx, id)
 }
 
-// GetDeleted mocks base method
-func (m *MockKeys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+// Get mocks base method.
+func (m *MockKeys) Get(ctx context.Context, id string) (*entities.Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret := m.ctrl.Call(m, "Get", ctx, id)
 	ret0, _ := ret[0].(*entities.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetDeleted indicates an expected call of GetDeleted
-func (mr *MockKeysMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
+// Get indicates an expected call of Get.
+func (mr *MockKeysMockRecorder) Get(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockKeys)(nil).GetDeleted), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeys)(nil).Get), ctx, id)
 }
 
-// GetAll mocks base method
+// GetAll mocks base method.
 func (m *MockKeys) GetAll(ctx context.Context) ([]*entities.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAll", ctx)
@@ -351,13 +352,13 @@ func (m *MockKeys) GetAll(ctx context.Context) ([]*entities.Key, error) {
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll
+// GetAll indicates an expected call of GetAll.
 func (mr *MockKeysMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockKeys)(nil).GetAll), ctx)
 }
 
-// GetAllDeleted mocks base method
+// GetAllDeleted mocks base method.
 func (m *MockKeys) GetAllDeleted(ctx context.Context) ([]*entities.Key, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllDeleted", ctx)
@@ -366,137 +367,152 @@ func (m *MockKeys) GetAllDeleted(ctx context.Context) ([]*entities.Key, error) {
 	return ret0, ret1
 }
 
-// GetAllDeleted indicates an expected call of GetAllDeleted
+// GetAllDeleted indicates an expected call of GetAllDeleted.
 func (mr *MockKeysMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockKeys)(nil).GetAllDeleted), ctx)
 }
 
-// SearchIDs mocks base method
-func (m *MockKeys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
+// GetDeleted mocks base method.
+func (m *MockKeys) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchIDs", ctx, isDeleted, limit, offset)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchIDs indicates an expected call of SearchIDs
-func (mr *MockKeysMockRecorder) SearchIDs(ctx, isDeleted, limit, offset interface{}) *gomock.Call {
+// GetDeleted indicates an expected call of GetDeleted.
+func (mr *MockKeysMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockKeys)(nil).SearchIDs), ctx, isDeleted, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockKeys)(nil).GetDeleted), ctx, id)
 }
 
-// Add mocks base method
-func (m *MockKeys) Add(ctx context.Context, key *entities.Key) (*entities.Key, error) {
+// Purge mocks base method.
+func (m *MockKeys) Purge(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, key)
-	ret0, _ := ret[0].(*entities.Key)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Add indicates an expected call of Add
-func (mr *MockKeysMockRecorder) Add(ctx, key interface{}) *gomock.Call {
+// Purge indicates an expected call of Purge.
+func (mr *MockKeysMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockKeys)(nil).Add), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockKeys)(nil).Purge), ctx, id)
 }
 
-// Update mocks base method
-func (m *MockKeys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
+// Restore mocks base method.
+func (m *MockKeys) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, key)
-	ret0, _ := ret[0].(*entities.Key)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Update indicates an expected call of Update
-func (mr *MockKeysMockRecorder) Update(ctx, key interface{}) *gomock.Call {
+// Restore indicates an expected call of Restore.
+func (mr *MockKeysMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeys)(nil).Update), ctx, key)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockKeys)(nil).Restore), ctx, id)
 }
 
-// Delete mocks base method
-func (m *MockKeys) Delete(ctx context.Context, id string) error {
+// RunInTransaction mocks base method.
+func (m *MockKeys) RunInTransaction(ctx context.Context, persistFunc func(database.Keys) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete
-func (mr *MockKeysMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+// RunInTransaction indicates an expected call of RunInTransaction.
+func (mr *MockKeysMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeys)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockKeys)(nil).RunInTransaction), ctx, persistFunc)
 }
 
-// Restore mocks base method
-func (m *MockKeys) Restore(ctx context.Context, id string) error {
+// SearchIDs mocks base method.
+func (m *MockKeys) SearchIDs(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Restore", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SearchIDs", ctx, tenants, isDeleted, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Restore indicates an expected call of Restore
-func (mr *MockKeysMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+// SearchIDs indicates an expected call of SearchIDs.
+func (mr *MockKeysMockRecorder) SearchIDs(ctx, tenants, isDeleted, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockKeys)(nil).Restore), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockKeys)(nil).SearchIDs), ctx, tenants, isDeleted, limit, offset)
 }
 
-// Purge mocks base method
-func (m *MockKeys) Purge(ctx context.Context, id string) error {
+// Update mocks base method.
+func (m *MockKeys) Update(ctx context.Context, key *entities.Key) (*entities.Key, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Purge", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Update", ctx, key)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Purge indicates an expected call of Purge
-func (mr *MockKeysMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockKeysMockRecorder) Update(ctx, key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockKeys)(nil).Purge), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeys)(nil).Update), ctx, key)
 }
 
-// MockSecrets is a mock of Secrets interface
+// MockSecrets is a mock of Secrets interface.
 type MockSecrets struct {
 	ctrl     *gomock.Controller
 	recorder *MockSecretsMockRecorder
 }
 
-// MockSecretsMockRecorder is the mock recorder for MockSecrets
+// MockSecretsMockRecorder is the mock recorder for MockSecrets.
 type MockSecretsMockRecorder struct {
 	mock *MockSecrets
 }
 
-// NewMockSecrets creates a new mock instance
+// NewMockSecrets creates a new mock instance.
 func NewMockSecrets(ctrl *gomock.Controller) *MockSecrets {
 	mock := &MockSecrets{ctrl: ctrl}
 	mock.recorder = &MockSecretsMockRecorder{mock}
 	return mock
 }
 
-// EXPECT returns an object that allows the caller to indicate expected use
+// EXPECT returns an object that allows the caller to indicate expected use.
 func (m *MockSecrets) EXPECT() *MockSecretsMockRecorder {
 	return m.recorder
 }
 
-// RunInTransaction mocks base method
-func (m *MockSecrets) RunInTransaction(ctx context.Context, persistFunc func(database.Secrets) error) error {
+// Add mocks base method.
+func (m *MockSecrets) Add(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
+	ret := m.ctrl.Call(m, "Add", ctx, secret)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Add indicates an expected call of Add.
+func (mr *MockSecretsMockRecorder) Add(ctx, secret interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSecrets)(nil).Add), ctx, secret)
+}
+
+// Delete mocks base method.
+func (m *MockSecrets) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// RunInTransaction indicates an expected call of RunInTransaction
-func (mr *MockSecretsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
+// Delete indicates an expected call of Delete.
+func (mr *MockSecretsMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockSecrets)(nil).RunInTransaction), ctx, persistFunc)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSecrets)(nil).Delete), ctx, id)
 }
 
-// Get mocks base method
+// Get mocks base method.
 func (m *MockSecrets) Get(ctx context.Context, id, version string) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "Get", ctx, id, version)
@@ -505,58 +521,43 @@ func (m *MockSecrets) Get(ctx context.Context, id, version string) (*entities.Se
 	return ret0, ret1
 }
 
-// Get indicates an expected call of Get
+// Get indicates an expected call of Get.
 func (mr *MockSecretsMockRecorder) Get(ctx, id, version interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockSecrets)(nil).Get), ctx, id, version)
 }
 
-// GetLatestVersion mocks base method
-func (m *MockSecrets) GetLatestVersion(ctx context.Context, id string, isDeleted bool) (string, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetLatestVersion", ctx, id, isDeleted)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetLatestVersion indicates an expected call of GetLatestVersion
-func (mr *MockSecretsMockRecorder) GetLatestVersion(ctx, id, isDeleted interface{}) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestVersion", reflect.TypeOf((*MockSecrets)(nil).GetLatestVersion), ctx, id, isDeleted)
-}
-
-// ListVersions mocks base method
-func (m *MockSecrets) ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error) {
+// GetAll mocks base method.
+func (m *MockSecrets) GetAll(ctx context.Context) ([]*entities.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVersions", ctx, id, isDeleted)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetAll", ctx)
+	ret0, _ := ret[0].([]*entities.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVersions indicates an expected call of ListVersions
-func (mr *MockSecretsMockRecorder) ListVersions(ctx, id, isDeleted interface{}) *gomock.Call {
+// GetAll indicates an expected call of GetAll.
+func (mr *MockSecretsMockRecorder) GetAll(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockSecrets)(nil).ListVersions), ctx, id, isDeleted)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSecrets)(nil).GetAll), ctx)
 }
 
-// SearchIDs mocks base method
-func (m *MockSecrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
+// GetAllDeleted mocks base method.
+func (m *MockSecrets) GetAllDeleted(ctx context.Context) ([]*entities.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SearchIDs", ctx, isDeleted, limit, offset)
-	ret0, _ := ret[0].([]string)
+	ret := m.ctrl.Call(m, "GetAllDeleted", ctx)
+	ret0, _ := ret[0].([]*entities.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// SearchIDs indicates an expected call of SearchIDs
-func (mr *MockSecretsMockRecorder) SearchIDs(ctx, isDeleted, limit, offset interface{}) *gomock.Call {
+// GetAllDeleted indicates an expected call of GetAllDeleted.
+func (mr *MockSecretsMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockSecrets)(nil).SearchIDs), ctx, isDeleted, limit, offset)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockSecrets)(nil).GetAllDeleted), ctx)
 }
 
-// GetDeleted mocks base method
+// GetDeleted mocks base method.
 func (m *MockSecrets) GetDeleted(ctx context.Context, id string) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
@@ -565,110 +566,110 @@ func (m *MockSecrets) GetDeleted(ctx context.Context, id string) (*entities.Secr
 	return ret0, ret1
 }
 
-// GetDeleted indicates an expected call of GetDeleted
+// GetDeleted indicates an expected call of GetDeleted.
 func (mr *MockSecretsMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockSecrets)(nil).GetDeleted), ctx, id)
 }
 
-// GetAll mocks base method
-func (m *MockSecrets) GetAll(ctx context.Context) ([]*entities.Secret, error) {
+// GetLatestVersion mocks base method.
+func (m *MockSecrets) GetLatestVersion(ctx context.Context, id string, isDeleted bool) (string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAll", ctx)
-	ret0, _ := ret[0].([]*entities.Secret)
+	ret := m.ctrl.Call(m, "GetLatestVersion", ctx, id, isDeleted)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAll indicates an expected call of GetAll
-func (mr *MockSecretsMockRecorder) GetAll(ctx interface{}) *gomock.Call {
+// GetLatestVersion indicates an expected call of GetLatestVersion.
+func (mr *MockSecretsMockRecorder) GetLatestVersion(ctx, id, isDeleted interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAll", reflect.TypeOf((*MockSecrets)(nil).GetAll), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestVersion", reflect.TypeOf((*MockSecrets)(nil).GetLatestVersion), ctx, id, isDeleted)
 }
 
-// GetAllDeleted mocks base method
-func (m *MockSecrets) GetAllDeleted(ctx context.Context) ([]*entities.Secret, error) {
+// ListVersions mocks base method.
+func (m *MockSecrets) ListVersions(ctx context.Context, id string, isDeleted bool) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAllDeleted", ctx)
-	ret0, _ := ret[0].([]*entities.Secret)
+	ret := m.ctrl.Call(m, "ListVersions", ctx, id, isDeleted)
+	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetAllDeleted indicates an expected call of GetAllDeleted
-func (mr *MockSecretsMockRecorder) GetAllDeleted(ctx interface{}) *gomock.Call {
+// ListVersions indicates an expected call of ListVersions.
+func (mr *MockSecretsMockRecorder) ListVersions(ctx, id, isDeleted interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllDeleted", reflect.TypeOf((*MockSecrets)(nil).GetAllDeleted), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVersions", reflect.TypeOf((*MockSecrets)(nil).ListVersions), ctx, id, isDeleted)
 }
 
-// Add mocks base method
-func (m *MockSecrets) Add(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
+// Purge mocks base method.
+func (m *MockSecrets) Purge(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Add", ctx, secret)
-	ret0, _ := ret[0].(*entities.Secret)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Purge", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Add indicates an expected call of Add
-func (mr *MockSecretsMockRecorder) Add(ctx, secret interface{}) *gomock.Call {
+// Purge indicates an expected call of Purge.
+func (mr *MockSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Add", reflect.TypeOf((*MockSecrets)(nil).Add), ctx, secret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSecrets)(nil).Purge), ctx, id)
 }
 
-// Update mocks base method
-func (m *MockSecrets) Update(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
+// Restore mocks base method.
+func (m *MockSecrets) Restore(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", ctx, secret)
-	ret0, _ := ret[0].(*entities.Secret)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
 }
 
-// Update indicates an expected call of Update
-func (mr *MockSecretsMockRecorder) Update(ctx, secret interface{}) *gomock.Call {
+// Restore indicates an expected call of Restore.
+func (mr *MockSecretsMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSecrets)(nil).Update), ctx, secret)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockSecrets)(nil).Restore), ctx, id)
 }
 
-// Delete mocks base method
-func (m *MockSecrets) Delete(ctx context.Context, id string) error {
+// RunInTransaction mocks base method.
+func (m *MockSecrets) RunInTransaction(ctx context.Context, persistFunc func(database.Secrets) error) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret := m.ctrl.Call(m, "RunInTransaction", ctx, persistFunc)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// Delete indicates an expected call of Delete
-func (mr *MockSecretsMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+// RunInTransaction indicates an expected call of RunInTransaction.
+func (mr *MockSecretsMockRecorder) RunInTransaction(ctx, persistFunc interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockSecrets)(nil).Delete), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTransaction", reflect.TypeOf((*MockSecrets)(nil).RunInTransaction), ctx, persistFunc)
 }
 
-// Restore mocks base method
-func (m *MockSecrets) Restore(ctx context.Context, id string) error {
+// SearchIDs mocks base method.
+func (m *MockSecrets) SearchIDs(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Restore", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "SearchIDs", ctx, tenants, isDeleted, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Restore indicates an expected call of Restore
-func (mr *MockSecretsMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+// SearchIDs indicates an expected call of SearchIDs.
+func (mr *MockSecretsMockRecorder) SearchIDs(ctx, tenants, isDeleted, limit, offset interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockSecrets)(nil).Restore), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchIDs", reflect.TypeOf((*MockSecrets)(nil).SearchIDs), ctx, tenants, isDeleted, limit, offset)
 }
 
-// Purge mocks base method
-func (m *MockSecrets) Purge(ctx context.Context, id string) error {
+// Update mocks base method.
+func (m *MockSecrets) Update(ctx context.Context, secret *entities.Secret) (*entities.Secret, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Purge", ctx, id)
-	ret0, _ := ret[0].(error)
-	return ret0
+	ret := m.ctrl.Call(m, "Update", ctx, secret)
+	ret0, _ := ret[0].(*entities.Secret)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// Purge indicates an expected call of Purge
-func (mr *MockSecretsMockRecorder) Purge(ctx, id interface{}) *gomock.Call {
+// Update indicates an expected call of Update.
+func (mr *MockSecretsMockRecorder) Update(ctx, secret interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Purge", reflect.TypeOf((*MockSecrets)(nil).Purge), ctx, id)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockSecrets)(nil).Update), ctx, secret)
 }
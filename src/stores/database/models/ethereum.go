@@ -16,6 +16,9 @@ type ETHAccount struct {
 	PublicKey           []byte
 	CompressedPublicKey []byte
 	Tags                map[string]string
+	Tenant              string
+	Shared              bool
+	Exportable          bool
 	Disabled            bool
 	CreatedAt           time.Time `pg:"default:now()"`
 	UpdatedAt           time.Time `pg:"default:now()"`
@@ -29,6 +32,9 @@ func NewETHAccount(account *entities.ETHAccount) *ETHAccount {
 		PublicKey:           account.PublicKey,
 		CompressedPublicKey: account.CompressedPublicKey,
 		Tags:                account.Tags,
+		Tenant:              account.Metadata.Tenant,
+		Shared:              account.Metadata.Shared,
+		Exportable:          account.Metadata.Exportable,
 		Disabled:            account.Metadata.Disabled,
 		CreatedAt:           account.Metadata.CreatedAt,
 		UpdatedAt:           account.Metadata.UpdatedAt,
@@ -43,10 +49,13 @@ func (eth *ETHAccount) ToEntity() *entities.ETHAccount {
 		PublicKey:           eth.PublicKey,
 		CompressedPublicKey: eth.CompressedPublicKey,
 		Metadata: &entities.Metadata{
-			Disabled:  eth.Disabled,
-			CreatedAt: eth.CreatedAt,
-			UpdatedAt: eth.UpdatedAt,
-			DeletedAt: eth.DeletedAt,
+			Disabled:   eth.Disabled,
+			Tenant:     eth.Tenant,
+			Shared:     eth.Shared,
+			Exportable: eth.Exportable,
+			CreatedAt:  eth.CreatedAt,
+			UpdatedAt:  eth.UpdatedAt,
+			DeletedAt:  eth.DeletedAt,
 		},
 		Tags: eth.Tags,
 	}
@@ -16,6 +16,9 @@ type Key struct {
 	EllipticCurve    string
 	Tags             map[string]string
 	Annotations      *entities.Annotation
+	Tenant           string
+	Shared           bool
+	Exportable       bool
 	Disabled         bool
 	CreatedAt        time.Time `pg:"default:now()"`
 	UpdatedAt        time.Time `pg:"default:now()"`
@@ -30,6 +33,9 @@ func NewKey(key *entities.Key) *Key {
 		EllipticCurve:    string(key.Algo.EllipticCurve),
 		Tags:             key.Tags,
 		Annotations:      key.Annotations,
+		Tenant:           key.Metadata.Tenant,
+		Shared:           key.Metadata.Shared,
+		Exportable:       key.Metadata.Exportable,
 		Disabled:         key.Metadata.Disabled,
 		CreatedAt:        key.Metadata.CreatedAt,
 		UpdatedAt:        key.Metadata.UpdatedAt,
@@ -48,10 +54,13 @@ func (k *Key) ToEntity() *entities.Key {
 		Tags:        k.Tags,
 		Annotations: k.Annotations,
 		Metadata: &entities.Metadata{
-			Disabled:  k.Disabled,
-			CreatedAt: k.CreatedAt,
-			UpdatedAt: k.UpdatedAt,
-			DeletedAt: k.DeletedAt,
+			Disabled:   k.Disabled,
+			Tenant:     k.Tenant,
+			Shared:     k.Shared,
+			Exportable: k.Exportable,
+			CreatedAt:  k.CreatedAt,
+			UpdatedAt:  k.UpdatedAt,
+			DeletedAt:  k.DeletedAt,
 		},
 	}
 }
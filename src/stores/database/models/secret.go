@@ -13,6 +13,8 @@ type Secret struct {
 	Version   string `pg:",pk"`
 	StoreID   string `pg:",pk"`
 	Tags      map[string]string
+	Tenant    string
+	Shared    bool
 	Disabled  bool
 	CreatedAt time.Time `pg:"default:now()"`
 	UpdatedAt time.Time `pg:"default:now()"`
@@ -24,6 +26,8 @@ func NewSecret(secret *entities.Secret) *Secret {
 		ID:        secret.ID,
 		Version:   secret.Metadata.Version,
 		Tags:      secret.Tags,
+		Tenant:    secret.Metadata.Tenant,
+		Shared:    secret.Metadata.Shared,
 		Disabled:  secret.Metadata.Disabled,
 		CreatedAt: secret.Metadata.CreatedAt,
 		UpdatedAt: secret.Metadata.UpdatedAt,
@@ -38,6 +42,8 @@ func (s *Secret) ToEntity() *entities.Secret {
 		Metadata: &entities.Metadata{
 			Version:   s.Version,
 			Disabled:  s.Disabled,
+			Tenant:    s.Tenant,
+			Shared:    s.Shared,
 			CreatedAt: s.CreatedAt,
 			UpdatedAt: s.UpdatedAt,
 			DeletedAt: s.DeletedAt,
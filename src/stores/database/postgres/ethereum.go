@@ -100,8 +100,9 @@ func (ea *ETHAccounts) GetAllDeleted(ctx context.Context) ([]*entities.ETHAccoun
 	return accounts, nil
 }
 
-func (ea *ETHAccounts) SearchAddresses(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
-	ids, err := client.QuerySearchIDs(ctx, ea.client, "eth_accounts", "address", "store_id = ?", []interface{}{ea.storeID}, isDeleted, limit, offset)
+func (ea *ETHAccounts) SearchAddresses(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
+	whereCond, whereArgs := tenantsCondition("store_id = ?", []interface{}{ea.storeID}, tenants)
+	ids, err := client.QuerySearchIDs(ctx, ea.client, "eth_accounts", "address", whereCond, whereArgs, isDeleted, limit, offset)
 	if err != nil {
 		errMessage := "failed to list of ethereum addresses"
 		ea.logger.WithError(err).Error(errMessage)
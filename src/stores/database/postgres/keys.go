@@ -100,8 +100,9 @@ func (k *Keys) GetAllDeleted(ctx context.Context) ([]*entities.Key, error) {
 	return keys, nil
 }
 
-func (k *Keys) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
-	ids, err := client.QuerySearchIDs(ctx, k.client, "keys", "id", "store_id = ?", []interface{}{k.storeID}, isDeleted, limit, offset)
+func (k *Keys) SearchIDs(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
+	whereCond, whereArgs := tenantsCondition("store_id = ?", []interface{}{k.storeID}, tenants)
+	ids, err := client.QuerySearchIDs(ctx, k.client, "keys", "id", whereCond, whereArgs, isDeleted, limit, offset)
 	if err != nil {
 		errMessage := "failed to list keys ids"
 		k.logger.WithError(err).Error(errMessage)
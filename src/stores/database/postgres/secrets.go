@@ -96,8 +96,9 @@ func (s *Secrets) GetLatestVersion(ctx context.Context, id string, isDeleted boo
 	return version, nil
 }
 
-func (s *Secrets) SearchIDs(ctx context.Context, isDeleted bool, limit, offset uint64) ([]string, error) {
-	ids, err := client.QuerySearchIDs(ctx, s.client, "secrets", "id", "store_id = ?", []interface{}{s.storeID}, isDeleted, limit, offset)
+func (s *Secrets) SearchIDs(ctx context.Context, tenants []string, isDeleted bool, limit, offset uint64) ([]string, error) {
+	whereCond, whereArgs := tenantsCondition("store_id = ?", []interface{}{s.storeID}, tenants)
+	ids, err := client.QuerySearchIDs(ctx, s.client, "secrets", "id", whereCond, whereArgs, isDeleted, limit, offset)
 	if err != nil {
 		errMessage := "failed to list secret ids"
 		s.logger.WithError(err).Error(errMessage)
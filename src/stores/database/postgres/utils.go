@@ -0,0 +1,12 @@
+package postgres
+
+import "github.com/go-pg/pg/v10"
+
+// tenantsCondition restricts a where condition to the items accessible to the given tenants, nil tenants meaning no restriction
+func tenantsCondition(whereCond string, whereArgs []interface{}, tenants []string) (string, []interface{}) {
+	if tenants == nil {
+		return whereCond, whereArgs
+	}
+
+	return whereCond + " AND (tenant IS NULL OR tenant = '' OR shared OR tenant IN (?))", append(whereArgs, pg.In(tenants))
+}
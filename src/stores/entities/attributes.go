@@ -29,6 +29,12 @@ type Attributes struct {
 
 	// Tags attached to a stored item
 	Tags map[string]string
+
+	// Shared whether the item is accessible to all tenants allowed on the store
+	Shared bool
+
+	// Exportable whether the private key of the item can be exported
+	Exportable bool
 }
 
 type Recovery struct {
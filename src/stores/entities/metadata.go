@@ -3,10 +3,13 @@ package entities
 import "time"
 
 type Metadata struct {
-	Version   string
-	Disabled  bool
-	ExpireAt  time.Time
-	CreatedAt time.Time
-	UpdatedAt time.Time
-	DeletedAt time.Time
+	Version    string
+	Disabled   bool
+	ExpireAt   time.Time
+	CreatedAt  time.Time
+	UpdatedAt  time.Time
+	DeletedAt  time.Time
+	Tenant     string
+	Shared     bool
+	Exportable bool
 }
@@ -0,0 +1,27 @@
+package entities
+
+type HashAlgorithm string
+type SignatureEncoding string
+
+const (
+	NoHash    HashAlgorithm = "none"
+	Sha256    HashAlgorithm = "sha256"
+	Keccak256 HashAlgorithm = "keccak256"
+	Sha512    HashAlgorithm = "sha512"
+
+	// RawEncoding is the R || S signature
+	RawEncoding SignatureEncoding = "raw"
+	// RSVEncoding is the R || S || V signature where V is the recovery ID (0 or 1)
+	RSVEncoding SignatureEncoding = "rsv"
+	// DEREncoding is the ASN.1 DER ECDSA-Sig-Value
+	DEREncoding SignatureEncoding = "der"
+	// JWSEncoding is the R || S signature encoded in base64url without padding (RFC 7515)
+	JWSEncoding SignatureEncoding = "jws"
+)
+
+// SignOptions are the options of signing arbitrary data, the zero value signs the data as is and returns the raw signature
+type SignOptions struct {
+	Hash     HashAlgorithm
+	Encoding SignatureEncoding
+	LowS     bool
+}
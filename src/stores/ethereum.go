@@ -70,4 +70,7 @@ type EthStore interface {
 
 	// Decrypt decrypts a single block of encrypted data.
 	Decrypt(ctx context.Context, addr common.Address, data []byte) ([]byte, error)
+
+	// Export exports an Ethereum account as a keystore v3 JSON encrypted with the passphrase
+	Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error)
 }
@@ -47,4 +47,15 @@ type KeyStore interface {
 
 	// Decrypt decrypts a single block of encrypted data.
 	Decrypt(ctx context.Context, id string, data []byte) ([]byte, error)
+
+	// Export exports the private part of a key as an unencrypted PKCS#8 DER document
+	Export(ctx context.Context, id string, algo *entities.Algorithm) ([]byte, error)
+}
+
+// KeyConnector is the KeyStore of a store given to the users, it hashes and encodes signatures on top of the backend
+type KeyConnector interface {
+	KeyStore
+
+	// SignWithOptions hashes the data, signs it and encodes the signature following the options
+	SignWithOptions(ctx context.Context, id string, data []byte, opts *entities.SignOptions) ([]byte, error)
 }
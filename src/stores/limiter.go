@@ -0,0 +1,14 @@
+package stores
+
+import (
+	"context"
+)
+
+//go:generate mockgen -source=limiter.go -destination=mock/limiter.go -package=mock
+
+// Limiter limits the rate and the concurrency of the operations performed by a user on the backend of a store
+type Limiter interface {
+	// Acquire consumes a token from the rate limits of the user, its tenant, the store and the given key, then waits for a free slot on the store backend.
+	// The returned function must be called to release the slot once the operation is performed
+	Acquire(ctx context.Context, key string) (release func(), err error)
+}
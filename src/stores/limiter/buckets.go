@@ -0,0 +1,18 @@
+package limiter
+
+import (
+	"context"
+	"time"
+)
+
+//go:generate mockgen -source=buckets.go -destination=mock/buckets.go -package=mock
+
+// Buckets holds the token buckets of the rate limits
+type Buckets interface {
+	// Take consumes a token from the bucket identified by key.
+	// If the bucket is empty no token is consumed and the duration to wait for the next token is returned
+	Take(ctx context.Context, key string, limit *Limit) (time.Duration, error)
+
+	// Refund gives back a token taken from the bucket identified by key, up to the burst
+	Refund(ctx context.Context, key string, limit *Limit) error
+}
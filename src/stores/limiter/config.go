@@ -0,0 +1,40 @@
+package limiter
+
+const (
+	MemoryBackend   = "memory"
+	PostgresBackend = "postgres"
+)
+
+// Limit is a token bucket refilled at Rate tokens per second and holding at most Burst tokens
+type Limit struct {
+	// Rate is the number of operations allowed per second, the limit is disabled if 0
+	Rate float64
+
+	// Burst is the number of operations that can be performed at once
+	Burst int
+}
+
+type Config struct {
+	// Backend stores the rate limit counters, either in process ('memory') or shared between instances ('postgres')
+	Backend string
+
+	User   Limit
+	Tenant Limit
+	Store  Limit
+	Key    Limit
+
+	// StoreConcurrency is the maximum number of concurrent operations performed on the backend of a store, unlimited if 0
+	StoreConcurrency int
+}
+
+func (l *Limit) enabled() bool {
+	return l.Rate > 0
+}
+
+func (l *Limit) burst() float64 {
+	if l.Burst < 1 {
+		return 1
+	}
+
+	return float64(l.Burst)
+}
@@ -0,0 +1,131 @@
+package limiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/stores"
+)
+
+// scope is the scope of a rate limit
+type scope string
+
+const (
+	keyScope    scope = "key"
+	userScope   scope = "user"
+	tenantScope scope = "tenant"
+	storeScope  scope = "store"
+)
+
+type scopedLimit struct {
+	scope scope
+	key   string
+	limit *Limit
+}
+
+// RateLimiter enforces the rate limits of the users, tenants, stores and keys and the concurrency limit of each store
+type RateLimiter struct {
+	cfg     *Config
+	buckets Buckets
+	logger  log.Logger
+
+	mux        sync.Mutex
+	semaphores map[string]chan struct{}
+}
+
+func New(cfg *Config, buckets Buckets, logger log.Logger) *RateLimiter {
+	return &RateLimiter{
+		cfg:        cfg,
+		buckets:    buckets,
+		logger:     logger,
+		semaphores: make(map[string]chan struct{}),
+	}
+}
+
+// For returns the limiter of the operations performed by the user on the given store
+func (l *RateLimiter) For(storeName string, userInfo *authtypes.UserInfo) stores.Limiter {
+	return &storeLimiter{
+		limiter:   l,
+		storeName: storeName,
+		userInfo:  userInfo,
+	}
+}
+
+func (l *RateLimiter) semaphore(storeName string) chan struct{} {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+
+	sem, ok := l.semaphores[storeName]
+	if !ok {
+		sem = make(chan struct{}, l.cfg.StoreConcurrency)
+		l.semaphores[storeName] = sem
+	}
+
+	return sem
+}
+
+type storeLimiter struct {
+	limiter   *RateLimiter
+	storeName string
+	userInfo  *authtypes.UserInfo
+}
+
+var _ stores.Limiter = &storeLimiter{}
+
+func (s *storeLimiter) Acquire(ctx context.Context, key string) (func(), error) {
+	cfg := s.limiter.cfg
+
+	// Most specific limits are checked first
+	limits := []scopedLimit{
+		{keyScope, fmt.Sprintf("key:%s:%s", s.storeName, key), &cfg.Key},
+		{userScope, fmt.Sprintf("user:%s:%s", s.userInfo.Tenant, s.userInfo.Username), &cfg.User},
+		{tenantScope, fmt.Sprintf("tenant:%s", s.userInfo.Tenant), &cfg.Tenant},
+		{storeScope, fmt.Sprintf("store:%s", s.storeName), &cfg.Store},
+	}
+
+	var taken []scopedLimit
+	for _, l := range limits {
+		if !l.limit.enabled() || (l.scope == tenantScope && s.userInfo.Tenant == "") {
+			continue
+		}
+
+		retryAfter, err := s.limiter.buckets.Take(ctx, l.key, l.limit)
+		if err != nil {
+			s.refund(ctx, taken)
+			return nil, err
+		}
+
+		if retryAfter > 0 {
+			s.limiter.logger.Warn("rate limit exceeded", "limit", l.scope, "store", s.storeName, "key", key, "username", s.userInfo.Username, "tenant", s.userInfo.Tenant)
+			s.refund(ctx, taken)
+			return nil, errors.TooManyRequestsError(retryAfter, "%s rate limit exceeded", l.scope)
+		}
+
+		taken = append(taken, l)
+	}
+
+	if cfg.StoreConcurrency <= 0 {
+		return func() {}, nil
+	}
+
+	sem := s.limiter.semaphore(s.storeName)
+	select {
+	case sem <- struct{}{}:
+		return func() { <-sem }, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// refund gives back the tokens taken for an operation rejected by a broader limit, so it only counts against the limit rejecting it
+func (s *storeLimiter) refund(ctx context.Context, taken []scopedLimit) {
+	for _, l := range taken {
+		if err := s.limiter.buckets.Refund(ctx, l.key, l.limit); err != nil {
+			s.limiter.logger.WithError(err).Warn("failed to refund rate limit token", "limit", l.scope, "store", s.storeName)
+		}
+	}
+}
@@ -0,0 +1,102 @@
+package limiter_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	authtypes "github.com/consensys/quorum-key-manager/src/auth/types"
+	"github.com/consensys/quorum-key-manager/src/infra/log/testutils"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateLimiter(t *testing.T) {
+	ctx := context.Background()
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	buckets := mock.NewMockBuckets(ctrl)
+	logger := testutils.NewMockLogger(ctrl)
+	userInfo := &authtypes.UserInfo{Username: "username", Tenant: "tenantOne"}
+	cfg := &limiter.Config{
+		User:   limiter.Limit{Rate: 10, Burst: 10},
+		Tenant: limiter.Limit{Rate: 100, Burst: 100},
+		Key:    limiter.Limit{Rate: 1},
+	}
+
+	storeLimiter := limiter.New(cfg, buckets, logger).For("my-store", userInfo)
+
+	t.Run("should acquire successfully if all enabled limits allow it", func(t *testing.T) {
+		buckets.EXPECT().Take(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(time.Duration(0), nil)
+		buckets.EXPECT().Take(gomock.Any(), "user:tenantOne:username", &cfg.User).Return(time.Duration(0), nil)
+		buckets.EXPECT().Take(gomock.Any(), "tenant:tenantOne", &cfg.Tenant).Return(time.Duration(0), nil)
+
+		release, err := storeLimiter.Acquire(ctx, "my-key")
+
+		require.NoError(t, err)
+		release()
+	})
+
+	t.Run("should fail with TooManyRequestsError if a limit is exceeded", func(t *testing.T) {
+		buckets.EXPECT().Take(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(time.Duration(0), nil)
+		buckets.EXPECT().Take(gomock.Any(), "user:tenantOne:username", &cfg.User).Return(2*time.Second, nil)
+		buckets.EXPECT().Refund(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(nil)
+
+		_, err := storeLimiter.Acquire(ctx, "my-key")
+
+		require.Error(t, err)
+		assert.True(t, errors.IsTooManyRequestsError(err))
+		assert.Equal(t, 2*time.Second, errors.FromError(err).GetRetryAfter())
+	})
+
+	t.Run("should refund the tokens taken from narrower limits if a broader limit is exceeded", func(t *testing.T) {
+		buckets.EXPECT().Take(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(time.Duration(0), nil)
+		buckets.EXPECT().Take(gomock.Any(), "user:tenantOne:username", &cfg.User).Return(time.Duration(0), nil)
+		buckets.EXPECT().Take(gomock.Any(), "tenant:tenantOne", &cfg.Tenant).Return(time.Second, nil)
+		buckets.EXPECT().Refund(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(nil)
+		buckets.EXPECT().Refund(gomock.Any(), "user:tenantOne:username", &cfg.User).Return(fmt.Errorf("error"))
+
+		_, err := storeLimiter.Acquire(ctx, "my-key")
+
+		assert.True(t, errors.IsTooManyRequestsError(err))
+	})
+
+	t.Run("should fail with same error if buckets fail", func(t *testing.T) {
+		expectedErr := fmt.Errorf("error")
+		buckets.EXPECT().Take(gomock.Any(), "key:my-store:my-key", &cfg.Key).Return(time.Duration(0), expectedErr)
+
+		_, err := storeLimiter.Acquire(ctx, "my-key")
+
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestRateLimiterConcurrency(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	logger := testutils.NewMockLogger(ctrl)
+	storeLimiter := limiter.New(&limiter.Config{StoreConcurrency: 1}, limiter.NewMemoryBuckets(), logger).For("my-store", &authtypes.UserInfo{})
+
+	t.Run("should wait for a free slot on the store", func(t *testing.T) {
+		release, err := storeLimiter.Acquire(context.Background(), "my-key")
+		require.NoError(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		_, err = storeLimiter.Acquire(ctx, "my-key")
+		assert.Equal(t, context.DeadlineExceeded, err)
+
+		release()
+
+		release, err = storeLimiter.Acquire(context.Background(), "my-key")
+		require.NoError(t, err)
+		release()
+	})
+}
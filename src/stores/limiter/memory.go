@@ -0,0 +1,94 @@
+package limiter
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// sweepInterval is the interval between two evictions of the idle buckets
+const sweepInterval = time.Minute
+
+type bucket struct {
+	tokens    float64
+	updatedAt time.Time
+	// fullAt is the time at which the bucket is refilled up to the burst, so it can be evicted
+	fullAt time.Time
+}
+
+func (b *bucket) refill(now time.Time, limit *Limit) {
+	b.tokens = math.Min(limit.burst(), b.tokens+now.Sub(b.updatedAt).Seconds()*limit.Rate)
+	b.updatedAt = now
+	b.fullAt = now.Add(time.Duration((limit.burst() - b.tokens) / limit.Rate * float64(time.Second)))
+}
+
+// MemoryBuckets keeps the token buckets in process, for single node deployments.
+// Buckets refilled up to their burst are evicted, as they are identical to new buckets
+type MemoryBuckets struct {
+	mux     sync.Mutex
+	buckets map[string]*bucket
+	sweptAt time.Time
+	now     func() time.Time
+}
+
+var _ Buckets = &MemoryBuckets{}
+
+func NewMemoryBuckets() *MemoryBuckets {
+	return &MemoryBuckets{
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+func (m *MemoryBuckets) Take(_ context.Context, key string, limit *Limit) (time.Duration, error) {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	now := m.now()
+	m.sweep(now)
+
+	b, ok := m.buckets[key]
+	if !ok {
+		b = &bucket{tokens: limit.burst(), updatedAt: now}
+		m.buckets[key] = b
+	}
+
+	b.refill(now, limit)
+	if b.tokens < 1 {
+		return time.Duration((1 - b.tokens) / limit.Rate * float64(time.Second)), nil
+	}
+
+	b.tokens--
+	b.refill(now, limit)
+	return 0, nil
+}
+
+func (m *MemoryBuckets) Refund(_ context.Context, key string, limit *Limit) error {
+	m.mux.Lock()
+	defer m.mux.Unlock()
+
+	// Evicted buckets are already full
+	b, ok := m.buckets[key]
+	if !ok {
+		return nil
+	}
+
+	b.tokens++
+	b.refill(m.now(), limit)
+	return nil
+}
+
+// sweep evicts the full buckets, at most once per sweep interval
+func (m *MemoryBuckets) sweep(now time.Time) {
+	if now.Sub(m.sweptAt) < sweepInterval {
+		return
+	}
+	m.sweptAt = now
+
+	for key, b := range m.buckets {
+		if !now.Before(b.fullAt) {
+			delete(m.buckets, key)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package limiter
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryBuckets(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+
+	buckets := NewMemoryBuckets()
+	buckets.now = func() time.Time { return now }
+
+	limit := &Limit{Rate: 2, Burst: 2}
+
+	t.Run("should consume tokens up to the burst", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			retryAfter, err := buckets.Take(ctx, "key-burst", limit)
+			require.NoError(t, err)
+			assert.Zero(t, retryAfter)
+		}
+
+		retryAfter, err := buckets.Take(ctx, "key-burst", limit)
+		require.NoError(t, err)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+	})
+
+	t.Run("should refill tokens at the configured rate", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, _ = buckets.Take(ctx, "key-refill", limit)
+		}
+
+		now = now.Add(500 * time.Millisecond)
+
+		retryAfter, err := buckets.Take(ctx, "key-refill", limit)
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+
+		retryAfter, err = buckets.Take(ctx, "key-refill", limit)
+		require.NoError(t, err)
+		assert.Equal(t, 500*time.Millisecond, retryAfter)
+	})
+
+	t.Run("should not refill tokens above the burst", func(t *testing.T) {
+		_, _ = buckets.Take(ctx, "key-max", limit)
+
+		now = now.Add(time.Hour)
+
+		for i := 0; i < 2; i++ {
+			retryAfter, err := buckets.Take(ctx, "key-max", limit)
+			require.NoError(t, err)
+			assert.Zero(t, retryAfter)
+		}
+
+		retryAfter, err := buckets.Take(ctx, "key-max", limit)
+		require.NoError(t, err)
+		assert.NotZero(t, retryAfter)
+	})
+	t.Run("should give back refunded tokens up to the burst", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, _ = buckets.Take(ctx, "key-refund", limit)
+		}
+
+		require.NoError(t, buckets.Refund(ctx, "key-refund", limit))
+		retryAfter, err := buckets.Take(ctx, "key-refund", limit)
+		require.NoError(t, err)
+		assert.Zero(t, retryAfter)
+
+		require.NoError(t, buckets.Refund(ctx, "key-refund", limit))
+		require.NoError(t, buckets.Refund(ctx, "key-refund", limit))
+		for i := 0; i < 2; i++ {
+			retryAfter, err = buckets.Take(ctx, "key-refund", limit)
+			require.NoError(t, err)
+			assert.Zero(t, retryAfter)
+		}
+		retryAfter, err = buckets.Take(ctx, "key-refund", limit)
+		require.NoError(t, err)
+		assert.NotZero(t, retryAfter)
+	})
+
+	t.Run("should evict the buckets refilled up to the burst", func(t *testing.T) {
+		_, _ = buckets.Take(ctx, "key-idle", limit)
+		_, _ = buckets.Take(ctx, "key-busy", limit)
+		_, _ = buckets.Take(ctx, "key-busy", limit)
+		require.Contains(t, buckets.buckets, "key-idle")
+
+		now = now.Add(sweepInterval)
+		_, _ = buckets.Take(ctx, "key-busy", limit)
+
+		assert.NotContains(t, buckets.buckets, "key-idle")
+		assert.Contains(t, buckets.buckets, "key-busy")
+	})
+}
@@ -0,0 +1,66 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: buckets.go
+
+// Package mock is a generated GoMock package.
+package mock
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	limiter "github.com/consensys/quorum-key-manager/src/stores/limiter"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBuckets is a mock of Buckets interface.
+type MockBuckets struct {
+	ctrl     *gomock.Controller
+	recorder *MockBucketsMockRecorder
+}
+
+// MockBucketsMockRecorder is the mock recorder for MockBuckets.
+type MockBucketsMockRecorder struct {
+	mock *MockBuckets
+}
+
+// NewMockBuckets creates a new mock instance.
+func NewMockBuckets(ctrl *gomock.Controller) *MockBuckets {
+	mock := &MockBuckets{ctrl: ctrl}
+	mock.recorder = &MockBucketsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBuckets) EXPECT() *MockBucketsMockRecorder {
+	return m.recorder
+}
+
+// Refund mocks base method.
+func (m *MockBuckets) Refund(ctx context.Context, key string, limit *limiter.Limit) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Refund", ctx, key, limit)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Refund indicates an expected call of Refund.
+func (mr *MockBucketsMockRecorder) Refund(ctx, key, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Refund", reflect.TypeOf((*MockBuckets)(nil).Refund), ctx, key, limit)
+}
+
+// Take mocks base method.
+func (m *MockBuckets) Take(ctx context.Context, key string, limit *limiter.Limit) (time.Duration, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Take", ctx, key, limit)
+	ret0, _ := ret[0].(time.Duration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Take indicates an expected call of Take.
+func (mr *MockBucketsMockRecorder) Take(ctx, key, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Take", reflect.TypeOf((*MockBuckets)(nil).Take), ctx, key, limit)
+}
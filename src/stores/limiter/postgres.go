@@ -0,0 +1,76 @@
+package limiter
+
+import (
+	"context"
+	"time"
+
+	"github.com/consensys/quorum-key-manager/pkg/errors"
+	"github.com/consensys/quorum-key-manager/src/infra/log"
+	"github.com/consensys/quorum-key-manager/src/infra/postgres"
+)
+
+// The token is only consumed if the refilled bucket holds at least one, otherwise no row is returned
+const takeTokenQuery = `
+INSERT INTO rate_limits AS rl (key, tokens, updated_at) VALUES (?0, ?1 - 1, now())
+ON CONFLICT (key) DO UPDATE SET
+	tokens = LEAST(?1, rl.tokens + EXTRACT(EPOCH FROM now() - rl.updated_at) * ?2) - 1,
+	updated_at = now()
+WHERE LEAST(?1, rl.tokens + EXTRACT(EPOCH FROM now() - rl.updated_at) * ?2) >= 1
+RETURNING tokens`
+
+const getTokensQuery = `
+SELECT LEAST(?1, tokens + EXTRACT(EPOCH FROM now() - updated_at) * ?2) FROM rate_limits WHERE key = ?0`
+
+// The refunded token is added to the bucket as it was on its last update, so it is not refilled twice
+const refundTokenQuery = `
+UPDATE rate_limits SET tokens = LEAST(?1, tokens + 1) WHERE key = ?0
+RETURNING tokens`
+
+// PostgresBuckets shares the token buckets between the instances of the application
+type PostgresBuckets struct {
+	client postgres.Client
+	logger log.Logger
+}
+
+var _ Buckets = &PostgresBuckets{}
+
+func NewPostgresBuckets(client postgres.Client, logger log.Logger) *PostgresBuckets {
+	return &PostgresBuckets{
+		client: client,
+		logger: logger,
+	}
+}
+
+func (p *PostgresBuckets) Take(ctx context.Context, key string, limit *Limit) (time.Duration, error) {
+	var tokens float64
+	err := p.client.QueryOne(ctx, &tokens, takeTokenQuery, key, limit.burst(), limit.Rate)
+	if err == nil {
+		return 0, nil
+	}
+	if !errors.IsNotFoundError(err) {
+		errMessage := "failed to take rate limit token"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	err = p.client.QueryOne(ctx, &tokens, getTokensQuery, key, limit.burst(), limit.Rate)
+	if err != nil {
+		errMessage := "failed to get rate limit tokens"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return 0, errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return time.Duration((1 - tokens) / limit.Rate * float64(time.Second)), nil
+}
+
+func (p *PostgresBuckets) Refund(ctx context.Context, key string, limit *Limit) error {
+	var tokens float64
+	err := p.client.QueryOne(ctx, &tokens, refundTokenQuery, key, limit.burst())
+	if err != nil && !errors.IsNotFoundError(err) {
+		errMessage := "failed to refund rate limit token"
+		p.logger.With("key", key).WithError(err).Error(errMessage)
+		return errors.FromError(err).SetMessage(errMessage)
+	}
+
+	return nil
+}
@@ -11,8 +11,8 @@ import (
 )
 
 type LocalEthSpecs struct {
-	Keystore manifest.Kind
-	Specs    interface{}
+	Keystore manifest.Kind `validate:"required"`
+	Specs    interface{}   `validate:"required"`
 }
 
 func NewLocalEth(specs *LocalEthSpecs, db database.Secrets, logger log.Logger) (stores.KeyStore, error) {
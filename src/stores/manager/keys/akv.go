@@ -9,9 +9,9 @@ import (
 
 // KeySpecs is the specs format for an Azure Key Vault key store
 type AkvKeySpecs struct {
-	VaultName           string `json:"vaultName"`
+	VaultName           string `json:"vaultName" validate:"required"`
 	SubscriptionID      string `json:"subscriptionID"`
-	TenantID            string `json:"tenantID"`
+	TenantID            string `json:"tenantID" validate:"required"`
 	AuxiliaryTenantIDs  string `json:"auxiliaryTenantIDs"`
 	ClientID            string `json:"clientID"`
 	ClientSecret        string `json:"clientSecret"`
@@ -9,9 +9,9 @@ import (
 
 // KeySpecs is the specs format for an AWS Key Vault key store
 type AwsKeySpecs struct {
-	Region    string `json:"region"`
-	AccessID  string `json:"accessID"`
-	SecretKey string `json:"secretKey"`
+	Region    string `json:"region" validate:"required"`
+	AccessID  string `json:"accessID" validate:"required"`
+	SecretKey string `json:"secretKey" validate:"required"`
 	Debug     bool   `json:"debug"`
 }
 
@@ -17,8 +17,8 @@ const maxRetries = 3
 
 // HashicorpKeySpecs is the specs format for a Hashicorp Vault key store
 type HashicorpKeySpecs struct {
-	MountPoint string `json:"mountPoint"`
-	Address    string `json:"address"`
+	MountPoint string `json:"mountPoint" validate:"required"`
+	Address    string `json:"address" validate:"required"`
 	Token      string `json:"token"`
 	TokenPath  string `json:"tokenPath"`
 	Namespace  string `json:"namespace"`
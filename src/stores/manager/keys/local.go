@@ -13,8 +13,8 @@ import (
 )
 
 type LocalKeySpecs struct {
-	SecretStore manifest.Kind
-	Specs       interface{}
+	SecretStore manifest.Kind `validate:"required"`
+	Specs       interface{}   `validate:"required"`
 }
 
 func NewLocalKeyStore(specs *LocalKeySpecs, db database.Secrets, logger log.Logger) (*localkeys.Store, error) {
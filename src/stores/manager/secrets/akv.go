@@ -9,9 +9,9 @@ import (
 
 // SecretSpecs is the specs format for an Azure Key Vault secret store
 type AkvSecretSpecs struct {
-	VaultName           string `json:"vaultName"`
+	VaultName           string `json:"vaultName" validate:"required"`
 	SubscriptionID      string `json:"subscriptionID"`
-	TenantID            string `json:"tenantID"`
+	TenantID            string `json:"tenantID" validate:"required"`
 	AuxiliaryTenantIDs  string `json:"auxiliaryTenantIDs"`
 	ClientID            string `json:"clientID"`
 	ClientSecret        string `json:"clientSecret"`
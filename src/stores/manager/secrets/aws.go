@@ -9,9 +9,9 @@ import (
 
 // SecretSpecs is the specs format for an aws secrets manager (aws secretsmanager service)
 type AwsSecretSpecs struct {
-	Region    string `json:"region"`
-	AccessID  string `json:"accessID"`
-	SecretKey string `json:"secretKey"`
+	Region    string `json:"region" validate:"required"`
+	AccessID  string `json:"accessID" validate:"required"`
+	SecretKey string `json:"secretKey" validate:"required"`
 	Debug     bool   `json:"debug"`
 }
 
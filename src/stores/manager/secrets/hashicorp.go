@@ -14,8 +14,8 @@ import (
 
 // HashicorpSecretSpecs is the specs format for a Hashicorp Vault secret store
 type HashicorpSecretSpecs struct {
-	MountPoint string `json:"mountPoint"`
-	Address    string `json:"address"`
+	MountPoint string `json:"mountPoint" validate:"required"`
+	Address    string `json:"address" validate:"required"`
 	Token      string `json:"token"`
 	TokenPath  string `json:"tokenPath"`
 	Namespace  string `json:"namespace"`
@@ -14,6 +14,7 @@ import (
 	manifestsmanager "github.com/consensys/quorum-key-manager/src/manifests/manager"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 )
 
 const ID = "StoreManager"
@@ -36,14 +37,14 @@ type BaseManager struct {
 
 var _ stores.Manager = &BaseManager{}
 
-func New(manifests manifestsmanager.Manager, authManager auth.Manager, db database.Database, logger log.Logger) *BaseManager {
+func New(manifests manifestsmanager.Manager, authManager auth.Manager, db database.Database, rateLimiter *limiter.RateLimiter, logger log.Logger) *BaseManager {
 	return &BaseManager{
 		manifests: manifests,
 		mnfsts:    make(chan []manifestsmanager.Message),
 		logger:    logger,
 		db:        db,
 		utils:     utils.NewConnector(logger),
-		stores:    storesconnector.NewConnector(authManager, db, logger),
+		stores:    storesconnector.NewConnector(authManager, db, rateLimiter, logger),
 	}
 }
 
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/consensys/quorum-key-manager/src/stores/database/mock"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 
 	"github.com/golang/mock/gomock"
 
@@ -96,7 +97,7 @@ func TestManagerService(t *testing.T) {
 	err = manifests.Start(context.TODO())
 	require.NoError(t, err, "Start manifests manager must not error")
 
-	mngr := New(manifests, mockAuthMngr, mockDB, mockLogger)
+	mngr := New(manifests, mockAuthMngr, mockDB, limiter.New(&limiter.Config{}, limiter.NewMemoryBuckets(), mockLogger), mockLogger)
 	err = mngr.Start(context.TODO())
 	require.NoError(t, err, "Start manager manager must not error")
 
@@ -306,3 +306,18 @@ func (mr *MockEthStoreMockRecorder) Decrypt(ctx, addr, data interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockEthStore)(nil).Decrypt), ctx, addr, data)
 }
+
+// Export mocks base method
+func (m *MockEthStore) Export(ctx context.Context, addr common.Address, passphrase string) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, addr, passphrase)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockEthStoreMockRecorder) Export(ctx, addr, passphrase interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockEthStore)(nil).Export), ctx, addr, passphrase)
+}
@@ -225,3 +225,263 @@ func (mr *MockKeyStoreMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyStore)(nil).Decrypt), ctx, id, data)
 }
+
+// Export mocks base method
+func (m *MockKeyStore) Export(ctx context.Context, id string, algo *entities.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockKeyStoreMockRecorder) Export(ctx, id, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockKeyStore)(nil).Export), ctx, id, algo)
+}
+
+// MockKeyConnector is a mock of KeyConnector interface
+type MockKeyConnector struct {
+	ctrl     *gomock.Controller
+	recorder *MockKeyConnectorMockRecorder
+}
+
+// MockKeyConnectorMockRecorder is the mock recorder for MockKeyConnector
+type MockKeyConnectorMockRecorder struct {
+	mock *MockKeyConnector
+}
+
+// NewMockKeyConnector creates a new mock instance
+func NewMockKeyConnector(ctrl *gomock.Controller) *MockKeyConnector {
+	mock := &MockKeyConnector{ctrl: ctrl}
+	mock.recorder = &MockKeyConnectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use
+func (m *MockKeyConnector) EXPECT() *MockKeyConnectorMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method
+func (m *MockKeyConnector) Create(ctx context.Context, id string, alg *entities.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, id, alg, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create
+func (mr *MockKeyConnectorMockRecorder) Create(ctx, id, alg, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockKeyConnector)(nil).Create), ctx, id, alg, attr)
+}
+
+// Import mocks base method
+func (m *MockKeyConnector) Import(ctx context.Context, id string, privKey []byte, alg *entities.Algorithm, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Import", ctx, id, privKey, alg, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Import indicates an expected call of Import
+func (mr *MockKeyConnectorMockRecorder) Import(ctx, id, privKey, alg, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Import", reflect.TypeOf((*MockKeyConnector)(nil).Import), ctx, id, privKey, alg, attr)
+}
+
+// Get mocks base method
+func (m *MockKeyConnector) Get(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Get", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Get indicates an expected call of Get
+func (mr *MockKeyConnectorMockRecorder) Get(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Get", reflect.TypeOf((*MockKeyConnector)(nil).Get), ctx, id)
+}
+
+// List mocks base method
+func (m *MockKeyConnector) List(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "List", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// List indicates an expected call of List
+func (mr *MockKeyConnectorMockRecorder) List(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockKeyConnector)(nil).List), ctx, limit, offset)
+}
+
+// Update mocks base method
+func (m *MockKeyConnector) Update(ctx context.Context, id string, attr *entities.Attributes) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Update", ctx, id, attr)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Update indicates an expected call of Update
+func (mr *MockKeyConnectorMockRecorder) Update(ctx, id, attr interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockKeyConnector)(nil).Update), ctx, id, attr)
+}
+
+// Delete mocks base method
+func (m *MockKeyConnector) Delete(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete
+func (mr *MockKeyConnectorMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockKeyConnector)(nil).Delete), ctx, id)
+}
+
+// GetDeleted mocks base method
+func (m *MockKeyConnector) GetDeleted(ctx context.Context, id string) (*entities.Key, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeleted", ctx, id)
+	ret0, _ := ret[0].(*entities.Key)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeleted indicates an expected call of GetDeleted
+func (mr *MockKeyConnectorMockRecorder) GetDeleted(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeleted", reflect.TypeOf((*MockKeyConnector)(nil).GetDeleted), ctx, id)
+}
+
+// ListDeleted mocks base method
+func (m *MockKeyConnector) ListDeleted(ctx context.Context, limit, offset uint64) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeleted", ctx, limit, offset)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeleted indicates an expected call of ListDeleted
+func (mr *MockKeyConnectorMockRecorder) ListDeleted(ctx, limit, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeleted", reflect.TypeOf((*MockKeyConnector)(nil).ListDeleted), ctx, limit, offset)
+}
+
+// Restore mocks base method
+func (m *MockKeyConnector) Restore(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Restore", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Restore indicates an expected call of Restore
+func (mr *MockKeyConnectorMockRecorder) Restore(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Restore", reflect.TypeOf((*MockKeyConnector)(nil).Restore), ctx, id)
+}
+
+// Destroy mocks base method
+func (m *MockKeyConnector) Destroy(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Destroy", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Destroy indicates an expected call of Destroy
+func (mr *MockKeyConnectorMockRecorder) Destroy(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Destroy", reflect.TypeOf((*MockKeyConnector)(nil).Destroy), ctx, id)
+}
+
+// Sign mocks base method
+func (m *MockKeyConnector) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sign", ctx, id, data, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sign indicates an expected call of Sign
+func (mr *MockKeyConnectorMockRecorder) Sign(ctx, id, data, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sign", reflect.TypeOf((*MockKeyConnector)(nil).Sign), ctx, id, data, algo)
+}
+
+// Encrypt mocks base method
+func (m *MockKeyConnector) Encrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Encrypt", ctx, id, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Encrypt indicates an expected call of Encrypt
+func (mr *MockKeyConnectorMockRecorder) Encrypt(ctx, id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Encrypt", reflect.TypeOf((*MockKeyConnector)(nil).Encrypt), ctx, id, data)
+}
+
+// Decrypt mocks base method
+func (m *MockKeyConnector) Decrypt(ctx context.Context, id string, data []byte) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Decrypt", ctx, id, data)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Decrypt indicates an expected call of Decrypt
+func (mr *MockKeyConnectorMockRecorder) Decrypt(ctx, id, data interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Decrypt", reflect.TypeOf((*MockKeyConnector)(nil).Decrypt), ctx, id, data)
+}
+
+// SignWithOptions mocks base method
+func (m *MockKeyConnector) SignWithOptions(ctx context.Context, id string, data []byte, opts *entities.SignOptions) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SignWithOptions", ctx, id, data, opts)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SignWithOptions indicates an expected call of SignWithOptions
+func (mr *MockKeyConnectorMockRecorder) SignWithOptions(ctx, id, data, opts interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SignWithOptions", reflect.TypeOf((*MockKeyConnector)(nil).SignWithOptions), ctx, id, data, opts)
+}
+
+// Export mocks base method
+func (m *MockKeyConnector) Export(ctx context.Context, id string, algo *entities.Algorithm) ([]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Export", ctx, id, algo)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Export indicates an expected call of Export
+func (mr *MockKeyConnectorMockRecorder) Export(ctx, id, algo interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Export", reflect.TypeOf((*MockKeyConnector)(nil).Export), ctx, id, algo)
+}
@@ -67,10 +67,10 @@ func (mr *MockStoresMockRecorder) GetSecretStore(ctx, storeName, userInfo interf
 }
 
 // GetKeyStore mocks base method
-func (m *MockStores) GetKeyStore(ctx context.Context, storeName string, userInfo *types.UserInfo) (stores.KeyStore, error) {
+func (m *MockStores) GetKeyStore(ctx context.Context, storeName string, userInfo *types.UserInfo) (stores.KeyConnector, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetKeyStore", ctx, storeName, userInfo)
-	ret0, _ := ret[0].(stores.KeyStore)
+	ret0, _ := ret[0].(stores.KeyConnector)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
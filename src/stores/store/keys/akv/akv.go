@@ -247,3 +247,9 @@ func (s *Store) Encrypt(_ context.Context, id string, data []byte) ([]byte, erro
 func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, error) {
 	return nil, errors.ErrNotImplemented
 }
+
+func (s *Store) Export(_ context.Context, _ string, _ *entities.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("AKV keys can not be exported, only keys of local key stores are exportable")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
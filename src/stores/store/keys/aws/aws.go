@@ -259,6 +259,12 @@ func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, erro
 	return nil, errors.ErrNotImplemented
 }
 
+func (s *Store) Export(_ context.Context, _ string, _ *entities.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("AWS keys can not be exported, only keys of local key stores are exportable")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) getAWSKeyID(ctx context.Context, id string) (string, error) {
 	outDescribe, err := s.client.DescribeKey(ctx, alias(id))
 	if err != nil {
@@ -207,6 +207,12 @@ func (s *Store) Decrypt(ctx context.Context, id string, data []byte) ([]byte, er
 	return nil, errors.ErrNotImplemented
 }
 
+func (s *Store) Export(_ context.Context, _ string, _ *entities.Algorithm) ([]byte, error) {
+	err := errors.NotSupportedError("Hashicorp keys can not be exported, only keys of local key stores are exportable")
+	s.logger.Warn(err.Error())
+	return nil, err
+}
+
 func (s *Store) pathKeys(suffix string) string {
 	return path.Join(s.mountPoint, urlPath, suffix)
 }
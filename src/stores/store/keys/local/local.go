@@ -10,6 +10,7 @@ import (
 
 	babyjubjub "github.com/consensys/gnark-crypto/ecc/bn254/twistededwards/eddsa"
 	"github.com/consensys/gnark-crypto/hash"
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/infra/log"
 	"github.com/consensys/quorum-key-manager/src/stores"
@@ -204,18 +205,11 @@ func (s *Store) Destroy(ctx context.Context, id string) error {
 func (s *Store) Sign(ctx context.Context, id string, data []byte, algo *entities.Algorithm) ([]byte, error) {
 	logger := s.logger.With("id", id)
 
-	secret, err := s.secretStore.Get(ctx, id, "")
+	privkey, err := s.privateKey(ctx, id)
 	if err != nil {
 		return nil, err
 	}
 
-	privkey, err := base64.StdEncoding.DecodeString(secret.Value)
-	if err != nil {
-		errMessage := "failed to decode private key secret"
-		logger.Error(errMessage)
-		return nil, errors.DependencyFailureError(errMessage)
-	}
-
 	switch {
 	case algo.Type == entities.Eddsa && algo.EllipticCurve == entities.Babyjubjub:
 		return s.signEDDSA(privkey, data)
@@ -240,6 +234,58 @@ func (s *Store) Decrypt(_ context.Context, id string, data []byte) ([]byte, erro
 	return nil, errors.ErrNotImplemented
 }
 
+func (s *Store) Export(ctx context.Context, id string, algo *entities.Algorithm) ([]byte, error) {
+	logger := s.logger.With("id", id)
+
+	privKey, err := s.privateKey(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case algo.Type == entities.Ecdsa && algo.EllipticCurve == entities.Secp256k1:
+		ecdsaKey, err := crypto.ToECDSA(privKey)
+		if err != nil {
+			errMessage := "failed to parse Secp256k1 private key"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.DependencyFailureError(errMessage)
+		}
+
+		der, err := pkgcrypto.MarshalECDSAPKCS8(ecdsaKey)
+		if err != nil {
+			errMessage := "failed to encode private key as PKCS#8"
+			logger.WithError(err).Error(errMessage)
+			return nil, errors.EncodingError(errMessage)
+		}
+
+		return der, nil
+	case algo.Type == entities.Eddsa && algo.EllipticCurve == entities.Babyjubjub:
+		errMessage := "Babyjubjub keys can not be exported, PKCS#8 has no identifier for this curve"
+		logger.Error(errMessage)
+		return nil, errors.NotSupportedError(errMessage)
+	default:
+		errMessage := "signing algorithm and curve combination not supported for export"
+		logger.With("algorithm", algo.Type, "curve", algo.EllipticCurve).Error(errMessage)
+		return nil, errors.InvalidParameterError(errMessage)
+	}
+}
+
+func (s *Store) privateKey(ctx context.Context, id string) ([]byte, error) {
+	secret, err := s.secretStore.Get(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	privKey, err := base64.StdEncoding.DecodeString(secret.Value)
+	if err != nil {
+		errMessage := "failed to decode private key secret"
+		s.logger.With("id", id).Error(errMessage)
+		return nil, errors.DependencyFailureError(errMessage)
+	}
+
+	return privKey, nil
+}
+
 func (s *Store) signECDSA(privKey, data []byte) ([]byte, error) {
 	if len(data) != crypto.DigestLength {
 		errMessage := fmt.Sprintf("data is required to be exactly %d bytes (%d)", crypto.DigestLength, len(data))
@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"testing"
 
+	pkgcrypto "github.com/consensys/quorum-key-manager/pkg/crypto"
 	"github.com/consensys/quorum-key-manager/pkg/errors"
 	"github.com/consensys/quorum-key-manager/src/stores"
 	"github.com/consensys/quorum-key-manager/src/stores/database"
@@ -370,3 +371,49 @@ func (s *localKeyStoreTestSuite) TestDecrypt() {
 		assert.Equal(s.T(), errors.ErrNotImplemented, err)
 	})
 }
+
+func (s *localKeyStoreTestSuite) TestExport() {
+	ctx := context.Background()
+
+	s.Run("should export an ECDSA/Secp256k1 key as PKCS#8 successfully", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyECDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		der, err := s.keyStore.Export(ctx, id, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+		assert.NoError(s.T(), err)
+
+		privKey, err := pkgcrypto.ParseECDSAPKCS8(der)
+		assert.NoError(s.T(), err)
+		assert.Equal(s.T(), privKeyECDSA, hexutil.Encode(crypto.FromECDSA(privKey)))
+	})
+
+	s.Run("should fail with NotSupportedError for EDDSA/Babyjubjub keys", func() {
+		secret := testutils.FakeSecret()
+		secret.Value = base64.StdEncoding.EncodeToString(hexutil.MustDecode(privKeyEDDSA))
+
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(secret, nil)
+
+		_, err := s.keyStore.Export(ctx, id, &entities.Algorithm{
+			Type:          entities.Eddsa,
+			EllipticCurve: entities.Babyjubjub,
+		})
+
+		assert.True(s.T(), errors.IsNotSupportedError(err))
+	})
+
+	s.Run("should fail with same error if Get fails", func() {
+		s.mockSecretStore.EXPECT().Get(ctx, id, "").Return(nil, expectedErr)
+
+		_, err := s.keyStore.Export(ctx, id, &entities.Algorithm{
+			Type:          entities.Ecdsa,
+			EllipticCurve: entities.Secp256k1,
+		})
+
+		assert.Equal(s.T(), expectedErr, err)
+	})
+}
@@ -18,7 +18,7 @@ type Stores interface {
 	GetSecretStore(ctx context.Context, storeName string, userInfo *auth.UserInfo) (SecretStore, error)
 
 	// GetKeyStore get key store by name
-	GetKeyStore(ctx context.Context, storeName string, userInfo *auth.UserInfo) (KeyStore, error)
+	GetKeyStore(ctx context.Context, storeName string, userInfo *auth.UserInfo) (KeyConnector, error)
 
 	// GetEthStore get ethereum store by name
 	GetEthStore(ctx context.Context, storeName string, userInfo *auth.UserInfo) (EthStore, error)
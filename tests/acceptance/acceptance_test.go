@@ -19,6 +19,7 @@ import (
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/keys"
 	"github.com/consensys/quorum-key-manager/src/stores/connectors/secrets"
 	"github.com/consensys/quorum-key-manager/src/stores/database/postgres"
+	"github.com/consensys/quorum-key-manager/src/stores/limiter"
 	hashicorpkey "github.com/consensys/quorum-key-manager/src/stores/store/keys/hashicorp"
 	"github.com/consensys/quorum-key-manager/src/stores/store/keys/local"
 	hashicorpsecret "github.com/consensys/quorum-key-manager/src/stores/store/secrets/hashicorp"
@@ -95,6 +96,7 @@ func (s *storeTestSuite) TestKeyManager_Keys() {
 
 	db := postgres.New(s.env.logger.WithComponent("Keys-DB"), s.env.postgresClient)
 	auth := authorizator.New(types.ListPermissions(), "", s.env.logger)
+	rateLimiter := limiter.New(&limiter.Config{}, limiter.NewMemoryBuckets(), s.env.logger)
 	utilsConnector := utils.NewConnector(s.env.logger)
 
 	// Hashicorp
@@ -103,7 +105,7 @@ func (s *storeTestSuite) TestKeyManager_Keys() {
 	testSuite := new(keysTestSuite)
 	testSuite.env = s.env
 	testSuite.db = db.Keys(storeName)
-	testSuite.store = keys.NewConnector(hashicorpkey.New(s.env.hashicorpClient, HashicorpKeyMountPoint, logger), db.Keys(storeName), auth, logger)
+	testSuite.store = keys.NewConnector(hashicorpkey.New(s.env.hashicorpClient, HashicorpKeyMountPoint, logger), db.Keys(storeName), auth, rateLimiter.For(storeName, types.WildcardUser), logger)
 	testSuite.utils = utilsConnector
 	suite.Run(s.T(), testSuite)
 
@@ -115,7 +117,7 @@ func (s *storeTestSuite) TestKeyManager_Keys() {
 	testSuite.db = db.Keys(storeName)
 	secretsDB := db.Secrets(storeName)
 	hashicorpSecretStore := hashicorpsecret.New(s.env.hashicorpClient, secretsDB, HashicorpSecretMountPoint, logger)
-	testSuite.store = keys.NewConnector(local.New(hashicorpSecretStore, secretsDB, logger), db.Keys(storeName), auth, logger)
+	testSuite.store = keys.NewConnector(local.New(hashicorpSecretStore, secretsDB, logger), db.Keys(storeName), auth, rateLimiter.For(storeName, types.WildcardUser), logger)
 	testSuite.utils = utilsConnector
 	suite.Run(s.T(), testSuite)
 }
@@ -128,6 +130,7 @@ func (s *storeTestSuite) TestKeyManagerStore_Eth() {
 
 	db := postgres.New(s.env.logger.WithComponent("Eth-DB"), s.env.postgresClient)
 	auth := authorizator.New(types.ListPermissions(), "", s.env.logger)
+	rateLimiter := limiter.New(&limiter.Config{}, limiter.NewMemoryBuckets(), s.env.logger)
 	utilsConnector := utils.NewConnector(s.env.logger)
 
 	// Hashicorp
@@ -136,7 +139,7 @@ func (s *storeTestSuite) TestKeyManagerStore_Eth() {
 	hashicorpStore := hashicorpkey.New(s.env.hashicorpClient, HashicorpKeyMountPoint, logger)
 	testSuite := new(ethTestSuite)
 	testSuite.env = s.env
-	testSuite.store = eth.NewConnector(hashicorpStore, db.ETHAccounts(storeName), auth, logger)
+	testSuite.store = eth.NewConnector(hashicorpStore, db.ETHAccounts(storeName), auth, rateLimiter.For(storeName, types.WildcardUser), logger)
 	testSuite.utils = utilsConnector
 	testSuite.db = db.ETHAccounts(storeName)
 	suite.Run(s.T(), testSuite)
@@ -149,7 +152,7 @@ func (s *storeTestSuite) TestKeyManagerStore_Eth() {
 	localStore := local.New(hashicorpSecretStore, secretsDB, logger)
 	testSuite = new(ethTestSuite)
 	testSuite.env = s.env
-	testSuite.store = eth.NewConnector(localStore, db.ETHAccounts(storeName), auth, logger)
+	testSuite.store = eth.NewConnector(localStore, db.ETHAccounts(storeName), auth, rateLimiter.For(storeName, types.WildcardUser), logger)
 	testSuite.utils = utilsConnector
 	testSuite.db = db.ETHAccounts(storeName)
 	suite.Run(s.T(), testSuite)
@@ -25,7 +25,11 @@ func (s *aliasStoreTestSuite) fakeAlias() aliasent.Alias {
 	return aliasent.Alias{
 		RegistryName: aliasent.RegistryName("JPM-" + randID),
 		Key:          aliasent.AliasKey("Goldman Sachs-" + randID),
-		Value:        `["ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=","2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="]`,
+		Value: aliasent.AliasValue{
+			Kind:   aliasent.KindTesseraKey,
+			Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="},
+		},
+		Version: 1,
 	}
 }
 
@@ -71,7 +75,11 @@ func (s *aliasStoreTestSuite) TestUpdateAlias() {
 		require.Equal(s.T(), in, *out)
 
 		updated := in
-		updated.Value = `["SOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=","3T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="]`
+		updated.Value = aliasent.AliasValue{
+			Kind:   aliasent.KindTesseraKey,
+			Values: []string{"SOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "3T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="},
+		}
+		updated.Version = 2
 
 		out, err = s.srv.UpdateAlias(s.env.ctx, in.RegistryName, updated)
 		require.NoError(s.T(), err)
@@ -79,6 +87,15 @@ func (s *aliasStoreTestSuite) TestUpdateAlias() {
 		got, err := s.srv.GetAlias(s.env.ctx, in.RegistryName, in.Key)
 		require.NoError(s.T(), err)
 		require.Equal(s.T(), &updated, got)
+
+		first, err := s.srv.GetAliasVersion(s.env.ctx, in.RegistryName, in.Key, 1)
+		require.NoError(s.T(), err)
+		require.Equal(s.T(), in.Value, first.Value)
+
+		versions, err := s.srv.ListAliasVersions(s.env.ctx, in.RegistryName, in.Key)
+		require.NoError(s.T(), err)
+		require.Len(s.T(), versions, 2)
+		require.Equal(s.T(), updated.Value, versions[1].Value)
 	})
 }
 
@@ -106,7 +123,7 @@ func (s *aliasStoreTestSuite) TestDeleteAlias() {
 func (s *aliasStoreTestSuite) TestListAlias() {
 	s.Run("non existing alias", func() {
 		in := s.fakeAlias()
-		als, err := s.srv.ListAliases(s.env.ctx, in.RegistryName)
+		als, err := s.srv.ListAliases(s.env.ctx, in.RegistryName, "", 0, 0)
 		require.NoError(s.T(), err)
 		require.Len(s.T(), als, 0)
 	})
@@ -119,12 +136,12 @@ func (s *aliasStoreTestSuite) TestListAlias() {
 
 		newAlias := in
 		newAlias.Key = `Crédit Mutuel`
-		newAlias.Value = `[ SOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc= ]`
+		newAlias.Value = aliasent.AliasValue{Kind: aliasent.KindTesseraKey, Values: []string{"SOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc="}}
 		out, err = s.srv.CreateAlias(s.env.ctx, in.RegistryName, newAlias)
 		require.NoError(s.T(), err)
 		require.Equal(s.T(), newAlias, *out)
 
-		als, err := s.srv.ListAliases(s.env.ctx, in.RegistryName)
+		als, err := s.srv.ListAliases(s.env.ctx, in.RegistryName, "", 0, 0)
 		require.NoError(s.T(), err)
 		require.NotEmpty(s.T(), als)
 		require.Len(s.T(), als, 2)
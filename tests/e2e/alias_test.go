@@ -58,10 +58,16 @@ func (s *aliasTestSuite) fakeAlias() testAlias {
 	randInt := s.rand.Intn(1 << 32)
 	randID := strconv.Itoa(randInt)
 	return testAlias{
-		reg:    types.RegistryName("JPM-" + randID),
-		key:    types.AliasKey("GoldmanSachs-" + randID),
-		val:    `["ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=","2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="]`,
-		newVal: `["ZOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=","2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="]`,
+		reg: types.RegistryName("JPM-" + randID),
+		key: types.AliasKey("GoldmanSachs-" + randID),
+		val: types.AliasValue{
+			Kind:   "tessera",
+			Values: []string{"ROAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="},
+		},
+		newVal: types.AliasValue{
+			Kind:   "tessera",
+			Values: []string{"ZOAZBWtSacxXQrOe3FGAqJDyJjFePR5ce4TSIzmJ0Bc=", "2T7xkjblN568N1QmPeElTjoeoNT4tkWYOJYxSMDO5i0="},
+		},
 	}
 }
 
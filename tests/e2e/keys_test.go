@@ -4,8 +4,11 @@ package e2e
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"encoding/asn1"
 	"encoding/base64"
 	"fmt"
+	"math/big"
 	"net/http"
 	"os"
 	"sync"
@@ -615,6 +618,46 @@ func (s *keysTestSuite) TestSignVerify() {
 		require.NoError(s.T(), err)
 	})
 
+	s.RunT("should hash a payload and sign it as a low-S DER signature successfully: Secp256k1/ECDSA", func() {
+		keyID := fmt.Sprintf("my-key-sign-der-%d", common.RandInt(1000))
+		request := &types.CreateKeyRequest{
+			Curve:            "secp256k1",
+			SigningAlgorithm: "ecdsa",
+		}
+
+		key, err := s.keyManagerClient.CreateKey(s.ctx, s.storeName, keyID, request)
+		// Ignoring not supported errors
+		if err != nil {
+			httpError, ok := err.(*client.ResponseError)
+			require.True(s.T(), ok)
+			assert.Equal(s.T(), http.StatusNotImplemented, httpError.StatusCode)
+			return
+		}
+		defer s.queueToDelete(key)
+
+		requestSign := &types.SignBase64PayloadRequest{
+			Data:          data,
+			HashAlgorithm: "keccak256",
+			Encoding:      "der",
+			LowS:          true,
+		}
+		signature, err := s.keyManagerClient.SignKey(s.ctx, s.storeName, key.ID, requestSign)
+		require.NoError(s.T(), err)
+
+		sigB, err := base64.StdEncoding.DecodeString(signature)
+		require.NoError(s.T(), err)
+		sig := struct{ R, S *big.Int }{}
+		_, err = asn1.Unmarshal(sigB, &sig)
+		require.NoError(s.T(), err)
+		pubKeyB, err := base64.StdEncoding.DecodeString(key.PublicKey)
+		require.NoError(s.T(), err)
+		pubKey, err := crypto.UnmarshalPubkey(pubKeyB)
+		require.NoError(s.T(), err)
+
+		assert.True(s.T(), sig.S.Cmp(new(big.Int).Rsh(crypto.S256().Params().N, 1)) <= 0)
+		assert.True(s.T(), ecdsa.Verify(pubKey, hashedPayload, sig.R, sig.S))
+	})
+
 	s.RunT("should sign and verify a new payload successfully: Babyjubjub/EDDSA", func() {
 		keyID := fmt.Sprintf("my-key-sign-eddsa-%d", common.RandInt(1000))
 		request := &types.CreateKeyRequest{